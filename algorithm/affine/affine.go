@@ -0,0 +1,227 @@
+// Package affine provides affine transformations of planar coordinates,
+// such as translation, rotation, scaling, skewing and reflection.
+package affine
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// ErrNoninvertible the transformation has no inverse, its determinant is 0.
+var ErrNoninvertible = fmt.Errorf("Affine transformation is not invertible")
+
+// ErrDegenerateControlPoints the control points are collinear and do not determine a transformation.
+var ErrDegenerateControlPoints = fmt.Errorf("Control points are collinear")
+
+// AffineTransformation Represents an affine transformation of the plane,
+// as the matrix
+//
+//	| M00 M01 M02 |
+//	| M10 M11 M12 |
+//	|  0   0   1  |
+//
+// a point (x, y) is transformed to (M00*x + M01*y + M02, M10*x + M11*y + M12).
+// Transformations are values, the methods composing them return new transformations.
+type AffineTransformation struct {
+	M00, M01, M02 float64
+	M10, M11, M12 float64
+}
+
+// Identity returns the identity transformation.
+func Identity() AffineTransformation {
+	return AffineTransformation{M00: 1, M11: 1}
+}
+
+// Translation returns the transformation translating by dx, dy.
+func Translation(dx, dy float64) AffineTransformation {
+	return AffineTransformation{M00: 1, M02: dx, M11: 1, M12: dy}
+}
+
+// Rotation returns the transformation rotating counter-clockwise by theta radians about the origin.
+func Rotation(theta float64) AffineTransformation {
+	sin, cos := math.Sincos(theta)
+	return AffineTransformation{M00: cos, M01: -sin, M10: sin, M11: cos}
+}
+
+// RotationAround returns the transformation rotating counter-clockwise by theta radians about the point x, y.
+func RotationAround(theta, x, y float64) AffineTransformation {
+	return Translation(-x, -y).Compose(Rotation(theta)).Compose(Translation(x, y))
+}
+
+// Scaling returns the transformation scaling by sx, sy about the origin.
+func Scaling(sx, sy float64) AffineTransformation {
+	return AffineTransformation{M00: sx, M11: sy}
+}
+
+// ScalingAround returns the transformation scaling by sx, sy about the point x, y.
+func ScalingAround(sx, sy, x, y float64) AffineTransformation {
+	return Translation(-x, -y).Compose(Scaling(sx, sy)).Compose(Translation(x, y))
+}
+
+// Skewing returns the transformation skewing x by shx*y and y by shy*x.
+func Skewing(shx, shy float64) AffineTransformation {
+	return AffineTransformation{M00: 1, M01: shx, M10: shy, M11: 1}
+}
+
+// Reflection returns the transformation reflecting about the line through x0, y0 and x1, y1.
+// If the two points are equal the reflection is about the point.
+func Reflection(x0, y0, x1, y1 float64) AffineTransformation {
+	dx, dy := x1-x0, y1-y0
+	d2 := dx*dx + dy*dy
+	if d2 == 0 {
+		return ScalingAround(-1, -1, x0, y0)
+	}
+	reflect := AffineTransformation{
+		M00: (dx*dx - dy*dy) / d2, M01: 2 * dx * dy / d2,
+		M10: 2 * dx * dy / d2, M11: (dy*dy - dx*dx) / d2,
+	}
+	return Translation(-x0, -y0).Compose(reflect).Compose(Translation(x0, y0))
+}
+
+// FromControlPoints returns the transformation mapping the three source points to the three destination points.
+func FromControlPoints(src, dst [3]matrix.Matrix) (AffineTransformation, error) {
+	for i := range src {
+		if len(src[i]) < 2 || len(dst[i]) < 2 {
+			return AffineTransformation{}, ErrDegenerateControlPoints
+		}
+	}
+	det := src[0][0]*(src[1][1]-src[2][1]) - src[0][1]*(src[1][0]-src[2][0]) +
+		(src[1][0]*src[2][1] - src[2][0]*src[1][1])
+	if det == 0 {
+		return AffineTransformation{}, ErrDegenerateControlPoints
+	}
+	// solve returns the coefficients a, b, c of a*x + b*y + c = v at the source points, by Cramer's rule.
+	solve := func(v0, v1, v2 float64) (a, b, c float64) {
+		a = (v0*(src[1][1]-src[2][1]) - src[0][1]*(v1-v2) + (v1*src[2][1] - v2*src[1][1])) / det
+		b = (src[0][0]*(v1-v2) - v0*(src[1][0]-src[2][0]) + (src[1][0]*v2 - src[2][0]*v1)) / det
+		c = (src[0][0]*(src[1][1]*v2-src[2][1]*v1) - src[0][1]*(src[1][0]*v2-src[2][0]*v1) +
+			v0*(src[1][0]*src[2][1]-src[2][0]*src[1][1])) / det
+		return
+	}
+	t := AffineTransformation{}
+	t.M00, t.M01, t.M02 = solve(dst[0][0], dst[1][0], dst[2][0])
+	t.M10, t.M11, t.M12 = solve(dst[0][1], dst[1][1], dst[2][1])
+	return t, nil
+}
+
+// Compose returns the transformation applying t and then other.
+func (t AffineTransformation) Compose(other AffineTransformation) AffineTransformation {
+	return AffineTransformation{
+		M00: other.M00*t.M00 + other.M01*t.M10,
+		M01: other.M00*t.M01 + other.M01*t.M11,
+		M02: other.M00*t.M02 + other.M01*t.M12 + other.M02,
+		M10: other.M10*t.M00 + other.M11*t.M10,
+		M11: other.M10*t.M01 + other.M11*t.M11,
+		M12: other.M10*t.M02 + other.M11*t.M12 + other.M12,
+	}
+}
+
+// Translate returns the transformation applying t and then a translation.
+func (t AffineTransformation) Translate(dx, dy float64) AffineTransformation {
+	return t.Compose(Translation(dx, dy))
+}
+
+// Rotate returns the transformation applying t and then a rotation about the origin.
+func (t AffineTransformation) Rotate(theta float64) AffineTransformation {
+	return t.Compose(Rotation(theta))
+}
+
+// RotateAround returns the transformation applying t and then a rotation about the point x, y.
+func (t AffineTransformation) RotateAround(theta, x, y float64) AffineTransformation {
+	return t.Compose(RotationAround(theta, x, y))
+}
+
+// Scale returns the transformation applying t and then a scaling about the origin.
+func (t AffineTransformation) Scale(sx, sy float64) AffineTransformation {
+	return t.Compose(Scaling(sx, sy))
+}
+
+// Skew returns the transformation applying t and then a skewing.
+func (t AffineTransformation) Skew(shx, shy float64) AffineTransformation {
+	return t.Compose(Skewing(shx, shy))
+}
+
+// Reflect returns the transformation applying t and then a reflection about the line through x0, y0 and x1, y1.
+func (t AffineTransformation) Reflect(x0, y0, x1, y1 float64) AffineTransformation {
+	return t.Compose(Reflection(x0, y0, x1, y1))
+}
+
+// Determinant returns the determinant of the transformation matrix.
+func (t AffineTransformation) Determinant() float64 {
+	return t.M00*t.M11 - t.M01*t.M10
+}
+
+// IsIdentity returns true if the transformation is the identity.
+func (t AffineTransformation) IsIdentity() bool {
+	return t == Identity()
+}
+
+// IsSimilarity returns true if the transformation preserves shapes, that is its linear part is
+// a rotation, a uniform scaling and possibly a reflection, so circles are transformed to circles.
+func (t AffineTransformation) IsSimilarity() bool {
+	return t.Determinant() != 0 &&
+		(t.M00 == t.M11 && t.M01 == -t.M10 || t.M00 == -t.M11 && t.M01 == t.M10)
+}
+
+// Inverse returns the inverse transformation, ErrNoninvertible if the determinant is 0.
+func (t AffineTransformation) Inverse() (AffineTransformation, error) {
+	det := t.Determinant()
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return AffineTransformation{}, ErrNoninvertible
+	}
+	return AffineTransformation{
+		M00: t.M11 / det,
+		M01: -t.M01 / det,
+		M02: (t.M01*t.M12 - t.M11*t.M02) / det,
+		M10: -t.M10 / det,
+		M11: t.M00 / det,
+		M12: (t.M10*t.M02 - t.M00*t.M12) / det,
+	}, nil
+}
+
+// Transform returns the transformed point, other ordinates than x and y are kept.
+func (t AffineTransformation) Transform(p matrix.Matrix) matrix.Matrix {
+	result := append(matrix.Matrix{}, p...)
+	if len(p) < 2 {
+		return result
+	}
+	result[0] = t.M00*p[0] + t.M01*p[1] + t.M02
+	result[1] = t.M10*p[0] + t.M11*p[1] + t.M12
+	return result
+}
+
+// TransformSteric returns a copy of the steric with all its coordinates transformed.
+func (t AffineTransformation) TransformSteric(steric matrix.Steric) matrix.Steric {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		return t.Transform(s)
+	case matrix.LineMatrix:
+		result := make(matrix.LineMatrix, len(s))
+		for i, v := range s {
+			result[i] = t.Transform(v)
+		}
+		return result
+	case matrix.PolygonMatrix:
+		result := make(matrix.PolygonMatrix, len(s))
+		for i, v := range s {
+			result[i] = t.TransformSteric(matrix.LineMatrix(v)).(matrix.LineMatrix)
+		}
+		return result
+	case matrix.MultiPolygonMatrix:
+		result := make(matrix.MultiPolygonMatrix, len(s))
+		for i, v := range s {
+			result[i] = t.TransformSteric(matrix.PolygonMatrix(v)).(matrix.PolygonMatrix)
+		}
+		return result
+	case matrix.Collection:
+		result := make(matrix.Collection, len(s))
+		for i, v := range s {
+			result[i] = t.TransformSteric(v)
+		}
+		return result
+	default:
+		return steric
+	}
+}
@@ -0,0 +1,120 @@
+package affine
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestAffineTransformation_Transform(t *testing.T) {
+	tests := []struct {
+		name  string
+		trans AffineTransformation
+		p     matrix.Matrix
+		want  matrix.Matrix
+	}{
+		{name: "identity", trans: Identity(), p: matrix.Matrix{1, 2}, want: matrix.Matrix{1, 2}},
+		{name: "translate", trans: Translation(10, -5), p: matrix.Matrix{1, 2}, want: matrix.Matrix{11, -3}},
+		{name: "rotate", trans: Rotation(math.Pi / 2), p: matrix.Matrix{1, 0}, want: matrix.Matrix{0, 1}},
+		{name: "rotate around", trans: RotationAround(math.Pi, 1, 1), p: matrix.Matrix{2, 1}, want: matrix.Matrix{0, 1}},
+		{name: "scale", trans: Scaling(2, 3), p: matrix.Matrix{1, 2}, want: matrix.Matrix{2, 6}},
+		{name: "scale around", trans: ScalingAround(2, 2, 1, 1), p: matrix.Matrix{2, 2}, want: matrix.Matrix{3, 3}},
+		{name: "skew", trans: Skewing(1, 0), p: matrix.Matrix{1, 2}, want: matrix.Matrix{3, 2}},
+		{name: "reflect x axis", trans: Reflection(0, 0, 1, 0), p: matrix.Matrix{1, 2}, want: matrix.Matrix{1, -2}},
+		{name: "reflect diagonal", trans: Reflection(0, 1, 1, 2), p: matrix.Matrix{1, 0}, want: matrix.Matrix{-1, 2}},
+		{name: "reflect point", trans: Reflection(1, 1, 1, 1), p: matrix.Matrix{2, 3}, want: matrix.Matrix{0, -1}},
+		{name: "compose", trans: Identity().Scale(2, 2).Translate(1, 0).Rotate(math.Pi / 2), p: matrix.Matrix{1, 1},
+			want: matrix.Matrix{-2, 3}},
+		{name: "z kept", trans: Translation(1, 1), p: matrix.Matrix{1, 1, 5}, want: matrix.Matrix{2, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.trans.Transform(tt.p); !got.EqualsExact(tt.want, 1e-12) {
+				t.Errorf("Transform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAffineTransformation_Inverse(t *testing.T) {
+	tests := []struct {
+		name    string
+		trans   AffineTransformation
+		wantErr error
+	}{
+		{name: "compose", trans: Rotation(0.3).Scale(2, 0.5).Translate(3, 4).Skew(0.2, 0.1)},
+		{name: "reflect", trans: Reflection(1, 2, 3, 5)},
+		{name: "collapse", trans: Scaling(1, 0), wantErr: ErrNoninvertible},
+	}
+	p := matrix.Matrix{3.5, -1.25}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, err := tt.trans.Inverse()
+			if err != tt.wantErr {
+				t.Fatalf("Inverse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := inv.Transform(tt.trans.Transform(p)); !got.EqualsExact(p, 1e-12) {
+				t.Errorf("Inverse() Transform = %v, want %v", got, p)
+			}
+		})
+	}
+}
+
+func TestFromControlPoints(t *testing.T) {
+	want := Rotation(0.5).Scale(2, 3).Translate(100, 200)
+	src := [3]matrix.Matrix{{0, 0}, {10, 0}, {0, 10}}
+	dst := [3]matrix.Matrix{}
+	for i, v := range src {
+		dst[i] = want.Transform(v)
+	}
+	got, err := FromControlPoints(src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := matrix.Matrix{3, 7}
+	if !got.Transform(p).EqualsExact(want.Transform(p), 1e-9) {
+		t.Errorf("FromControlPoints() = %v, want %v", got, want)
+	}
+	if _, err := FromControlPoints([3]matrix.Matrix{{0, 0}, {1, 1}, {2, 2}}, dst); err != ErrDegenerateControlPoints {
+		t.Errorf("FromControlPoints() error = %v, want %v", err, ErrDegenerateControlPoints)
+	}
+}
+
+func TestAffineTransformation_TransformSteric(t *testing.T) {
+	poly := matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}
+	got := Translation(1, 2).TransformSteric(matrix.Collection{matrix.Matrix{0, 0}, poly})
+	want := matrix.Collection{matrix.Matrix{1, 2}, matrix.PolygonMatrix{{{1, 2}, {2, 2}, {2, 3}, {1, 2}}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TransformSteric() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(poly, matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}) {
+		t.Errorf("TransformSteric() changed the input %v", poly)
+	}
+}
+
+func TestAffineTransformation_IsSimilarity(t *testing.T) {
+	tests := []struct {
+		name  string
+		trans AffineTransformation
+		want  bool
+	}{
+		{name: "identity", trans: Identity(), want: true},
+		{name: "rotate scale translate", trans: Rotation(0.7).Scale(3, 3).Translate(5, 1), want: true},
+		{name: "reflect", trans: Reflection(0, 0, 1, 2).Rotate(0.2), want: true},
+		{name: "scale", trans: Scaling(2, 1), want: false},
+		{name: "skew", trans: Skewing(1, 0), want: false},
+		{name: "collapse", trans: Scaling(0, 0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.trans.IsSimilarity(); got != tt.want {
+				t.Errorf("IsSimilarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,6 +36,9 @@ var ErrWrongFractionRange = fmt.Errorf("Fraction is not in range (0.0 - 1.0]")
 // ErrWrongTolerance ...
 var ErrWrongTolerance = fmt.Errorf("Tolerance must be non-negative")
 
+// ErrNotFinite ...
+var ErrNotFinite = fmt.Errorf("Coordinates must be finite")
+
 // ErrWrongExponent ...
 var ErrWrongExponent = fmt.Errorf("Exponent out of bounds")
 
@@ -48,6 +51,26 @@ var ErrWrongLink = fmt.Errorf("Cannot link lines")
 // ErrBoundBeNil ...
 var ErrBoundBeNil = fmt.Errorf("boundary should be nil")
 
+// ErrTopology ...
+var ErrTopology = fmt.Errorf("Topology error")
+
+// TopologyError describes an error in the topology of an input, with the location where it was found.
+// It matches ErrTopology with errors.Is.
+type TopologyError struct {
+	Msg      string
+	Location []float64
+}
+
+// Error returns the message and the location of the error.
+func (e *TopologyError) Error() string {
+	return fmt.Sprintf("%v: %v at %v", ErrTopology, e.Msg, e.Location)
+}
+
+// Is returns true if the target is ErrTopology.
+func (e *TopologyError) Is(target error) bool {
+	return target == ErrTopology
+}
+
 // ErrUnknownType ...
 func ErrUnknownType(obj ...interface{}) error {
 	return fmt.Errorf("Unknown Geometry subtype: %v", obj...)
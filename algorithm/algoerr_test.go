@@ -1,6 +1,8 @@
 package algorithm
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -104,3 +106,28 @@ func TestErrorShouldBeLength9(t *testing.T) {
 		})
 	}
 }
+
+func TestTopologyError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantIs  bool
+		wantMsg string
+	}{
+		{"topology error", &TopologyError{Msg: "Ring is not closed", Location: []float64{1, 2}}, true,
+			"Topology error: Ring is not closed at [1 2]"},
+		{"wrapped topology error", fmt.Errorf("overlay: %w", &TopologyError{Msg: "Too few points", Location: []float64{0, 0}}), true,
+			"overlay: Topology error: Too few points at [0 0]"},
+		{"other error", ErrNilSteric, false, "Steric is nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, ErrTopology); got != tt.wantIs {
+				t.Errorf("errors.Is(%v, ErrTopology) = %v, want %v", tt.err, got, tt.wantIs)
+			}
+			if got := tt.err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %v, want %v", got, tt.wantMsg)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package buffer
 
 import (
+	"context"
 	"log"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
@@ -20,36 +21,51 @@ type ComputerBuffer struct {
 // Each offset curve has an attached  indicating
 // its left and right location.
 func Buffer(geom matrix.Steric, distance float64, quadrantSegments int) matrix.Steric {
+	result, _ := BufferContext(context.Background(), geom, distance, quadrantSegments)
+	return result
+}
+
+// BufferContext Computes the buffer as Buffer, returning ctx.Err()
+// if the context is done before the offset curves are computed.
+func BufferContext(ctx context.Context, geom matrix.Steric, distance float64, quadrantSegments int) (matrix.Steric, error) {
 	eb := ComputerBuffer{}
 	eb.param = DefaultCurveParameters()
 	eb.param.QuadrantSegments = quadrantSegments
 	eb.distance = distance
 	eb.CurveBuilder = &CurveBuilder{
 		Curve: CurveWithParameters(eb.param, eb.distance),
+		ctx:   ctx,
 	}
 
 	eb.Add(geom)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	bufferSeg := eb.CurveBuilder.Curves
 	if len(bufferSeg) <= 0 {
-		return nil
+		return nil, nil
 	}
 	poly := matrix.PolygonMatrix{}
 	for _, v := range bufferSeg {
 		poly = append(poly, v.Line)
 	}
-	return poly
+	return poly, nil
 }
 
 // Add Add a geometry to the graph.
 func (eb *ComputerBuffer) Add(geom matrix.Steric) {
-	if geom.IsEmpty() {
+	if geom.IsEmpty() || eb.isDone() {
 		return
 	}
 	if eb.param == nil || eb.param.IsEmpty() {
 		eb.param = DefaultCurveParameters()
-		eb.CurveBuilder = &CurveBuilder{
+		builder := &CurveBuilder{
 			Curve: CurveWithParameters(eb.param, eb.distance),
 		}
+		if eb.CurveBuilder != nil {
+			builder.ctx = eb.CurveBuilder.ctx
+		}
+		eb.CurveBuilder = builder
 	}
 	switch st := geom.(type) {
 	case matrix.Matrix:
@@ -79,7 +95,7 @@ func (eb *ComputerBuffer) addPoint(p matrix.Matrix) {
 
 // addLineString Add a LineString to the graph.
 func (eb *ComputerBuffer) addLineString(line matrix.LineMatrix) {
-	if eb.isLineOffsetEmpty(eb.distance) {
+	if len(line) == 0 || eb.isLineOffsetEmpty(eb.distance) {
 		return
 	}
 
@@ -137,6 +153,9 @@ func (eb *ComputerBuffer) addPolygon(p matrix.PolygonMatrix) {
 	}
 
 	for i := 1; i < len(p); i++ {
+		if eb.isDone() {
+			return
+		}
 
 		hole := p[i]
 
@@ -169,8 +188,8 @@ func (eb *ComputerBuffer) AddRingBothSides(ring matrix.LineMatrix, distance floa
 // (If the ring is in the opposite orientation,this is detected and
 // the left and right locations are interchanged and the side is flipped.)
 func (eb *ComputerBuffer) addRingSide(ring matrix.LineMatrix, offsetDistance float64, side, cwLeftLoc, cwRightLoc int) {
-	// don't bother adding ring if it is "flat" and will disappear in the output
-	if offsetDistance == 0.0 && len(ring) < calc.MinRingSize {
+	// don't bother adding ring if it is empty, or "flat" and will disappear in the output
+	if len(ring) == 0 || offsetDistance == 0.0 && len(ring) < calc.MinRingSize {
 		return
 	}
 
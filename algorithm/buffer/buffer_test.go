@@ -1,7 +1,11 @@
 package buffer
 
 import (
+	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spatial-go/geoos"
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -145,3 +149,59 @@ func TestBuffer(t *testing.T) {
 		})
 	}
 }
+
+func TestBufferContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	poly := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    matrix.Steric
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), want: Buffer(poly, 1, 4)},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BufferContext(tt.ctx, poly, 1, 4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BufferContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BufferContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// countdownContext is a context done after its Err method has been called n times.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (c *countdownContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestBufferContext_cancelPartway(t *testing.T) {
+	line := make(matrix.LineMatrix, 10000)
+	for i := range line {
+		line[i] = matrix.Matrix{float64(i), float64(i % 2 * 10)}
+	}
+	ctx := &countdownContext{Context: context.Background(), n: 100}
+	got, err := BufferContext(ctx, line, 1, 4)
+	if !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("BufferContext() = %v, %v, want nil, %v", got, err, context.Canceled)
+	}
+}
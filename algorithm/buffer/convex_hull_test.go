@@ -18,6 +18,9 @@ func TestConvexHull(t *testing.T) {
 	}{
 		{"convexHull", args{matrix.PolygonMatrix{{{1, 1}, {3, 1}, {2, 2}, {3, 3}, {1, 3}, {1, 1}}}},
 			matrix.PolygonMatrix{{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}}},
+		{"point", args{matrix.Matrix{1, 1}}, matrix.Matrix{1, 1}},
+		{"points", args{matrix.Collection{matrix.Matrix{1, 1}, matrix.Matrix{3, 3}}},
+			matrix.LineMatrix{{1, 1}, {3, 3}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
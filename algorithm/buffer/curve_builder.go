@@ -1,6 +1,7 @@
 package buffer
 
 import (
+	"context"
 	"math"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
@@ -14,6 +15,12 @@ type CurveBuilder struct {
 	*Curve
 	Curves   []Curve
 	distance float64
+	ctx      context.Context
+}
+
+// isDone tests whether the context of the builder is done.
+func (c *CurveBuilder) isDone() bool {
+	return c != nil && c.ctx != nil && c.ctx.Err() != nil
 }
 
 // LineCurve  This method handles single points as well as LineStrings.
@@ -154,6 +161,9 @@ func (c *CurveBuilder) computeLineBufferCurve(pts matrix.LineMatrix) {
 	n1 := len(simp1) - 1
 	c.Curve.initSideSegments(simp1[0], simp1[1], calc.SideLeft)
 	for i := 2; i <= n1; i++ {
+		if c.isDone() {
+			return
+		}
 		c.Curve.addNextSegment(simp1[i], true)
 	}
 	c.Curve.Add(c.Curve.offset1.P1)
@@ -170,6 +180,9 @@ func (c *CurveBuilder) computeLineBufferCurve(pts matrix.LineMatrix) {
 	// since we are traversing line in opposite order, offset position is still LEFT
 	c.Curve.initSideSegments(simp2[n2], simp2[n2-1], calc.SideLeft)
 	for i := n2 - 2; i >= 0; i-- {
+		if c.isDone() {
+			return
+		}
 		c.Curve.addNextSegment(simp2[i], true)
 	}
 	c.Curve.Add(c.Curve.offset1.P1)
@@ -198,6 +211,9 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 		c.Curve.initSideSegments(simp2[n2], simp2[n2-1], calc.SideLeft)
 		c.Curve.Add(c.Curve.offset1.P1)
 		for i := n2 - 2; i >= 0; i-- {
+			if c.isDone() {
+				return
+			}
 			c.Curve.addNextSegment(simp2[i], true)
 		}
 	} else {
@@ -215,6 +231,9 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 		c.Curve.initSideSegments(simp1[0], simp1[1], calc.SideLeft)
 		c.Curve.Add(c.Curve.offset1.P1)
 		for i := 2; i <= n1; i++ {
+			if c.isDone() {
+				return
+			}
 			c.Curve.addNextSegment(simp1[i], true)
 		}
 	}
@@ -235,6 +254,9 @@ func (c *CurveBuilder) computeRingBufferCurve(pts matrix.LineMatrix, side int) {
 	n := len(simp1) - 1
 	c.Curve.initSideSegments(simp1[n-1], simp1[0], side)
 	for i := 1; i <= n; i++ {
+		if c.isDone() {
+			return
+		}
 		addStartPoint := (i != 1)
 		c.Curve.addNextSegment(simp1[i], addStartPoint)
 	}
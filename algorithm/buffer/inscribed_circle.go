@@ -0,0 +1,356 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/index/quadtree"
+)
+
+// Circle a circle computed by MaximumInscribedCircle or LargestEmptyCircle,
+// RadiusPoint is the point of the nearest facet the circle touches.
+type Circle struct {
+	Center, RadiusPoint matrix.Matrix
+	Radius              float64
+}
+
+// MaximumInscribedCircle computes the largest circle contained in a polygonal geometry,
+// its center is the pole of inaccessibility of the geometry, the interior point farthest from the boundary.
+// The center is computed to within tolerance by a branch and bound search over a grid of cells.
+func MaximumInscribedCircle(geom matrix.Steric, tolerance float64) (*Circle, error) {
+	if tolerance <= 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if geom == nil || geom.IsEmpty() {
+		return nil, algorithm.ErrNilSteric
+	}
+	if !finite(geom) {
+		return nil, algorithm.ErrNotFinite
+	}
+	polys := polygonal(geom)
+	if polys == nil {
+		return nil, algorithm.ErrNotMatchType
+	}
+	area := newAreaLocator(polys)
+	facets := newFacetIndex(geom)
+	distance := func(p matrix.Matrix) float64 {
+		dist, _ := facets.nearest(p)
+		if !area.covers(p) {
+			return -dist
+		}
+		return dist
+	}
+	center := farthestPoint(area.env, distance, tolerance)
+	radius, radiusPoint := facets.nearest(center)
+	return &Circle{center, radiusPoint, radius}, nil
+}
+
+// LargestEmptyCircle computes the largest circle whose center lies in the boundary
+// and whose interior does not intersect the obstacles.
+// Obstacles may be points, lines or polygons, polygons are empty only outside them.
+// If boundary is nil the convex hull of the obstacles is used.
+// The center is computed to within tolerance by a branch and bound search over a grid of cells.
+func LargestEmptyCircle(obstacles, boundary matrix.Steric, tolerance float64) (*Circle, error) {
+	if tolerance <= 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if obstacles == nil || obstacles.IsEmpty() {
+		return nil, algorithm.ErrNilSteric
+	}
+	if !finite(obstacles) || boundary != nil && !finite(boundary) {
+		return nil, algorithm.ErrNotFinite
+	}
+	if boundary == nil || boundary.IsEmpty() {
+		boundary = ConvexHull(vertices(obstacles))
+	}
+	bounds := polygonal(boundary)
+	if bounds == nil {
+		return nil, algorithm.ErrNotMatchType
+	}
+	area := newAreaLocator(bounds)
+	boundaryFacets := newFacetIndex(boundary)
+	obstacleAreas := newAreaLocator(polygonal(obstacles))
+	facets := newFacetIndex(obstacles)
+	distance := func(p matrix.Matrix) float64 {
+		if !area.covers(p) {
+			dist, _ := boundaryFacets.nearest(p)
+			return -dist
+		}
+		if obstacleAreas.covers(p) {
+			return 0
+		}
+		dist, _ := facets.nearest(p)
+		return dist
+	}
+	center := farthestPoint(area.env, distance, tolerance)
+	if obstacleAreas.covers(center) {
+		return &Circle{center, center, 0}, nil
+	}
+	radius, radiusPoint := facets.nearest(center)
+	return &Circle{center, radiusPoint, radius}, nil
+}
+
+// farthestPoint returns the point of the envelope with the largest distance,
+// found by subdividing the cells which may contain a point farther than the best one by more than tolerance.
+func farthestPoint(env *envelope.Envelope, distance func(matrix.Matrix) float64, tolerance float64) matrix.Matrix {
+	centre := env.Centre()
+	cellSize := math.Min(env.Width(), env.Height())
+	if cellSize == 0 {
+		return centre
+	}
+	newCell := func(x, y, halfSide float64) *circleCell {
+		return &circleCell{x, y, halfSide, distance(matrix.Matrix{x, y})}
+	}
+	queue := &cellQueue{}
+	halfSide := cellSize / 2
+	for x := env.MinX; x < env.MaxX; x += cellSize {
+		for y := env.MinY; y < env.MaxY; y += cellSize {
+			heap.Push(queue, newCell(x+halfSide, y+halfSide, halfSide))
+		}
+	}
+	best := newCell(centre[0], centre[1], 0)
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(*circleCell)
+		if cell.maxDistance() <= best.distance {
+			break
+		}
+		if cell.distance > best.distance {
+			best = cell
+		}
+		if cell.maxDistance()-best.distance > tolerance {
+			h := cell.halfSide / 2
+			heap.Push(queue, newCell(cell.x-h, cell.y-h, h))
+			heap.Push(queue, newCell(cell.x+h, cell.y-h, h))
+			heap.Push(queue, newCell(cell.x-h, cell.y+h, h))
+			heap.Push(queue, newCell(cell.x+h, cell.y+h, h))
+		}
+	}
+	return matrix.Matrix{best.x, best.y}
+}
+
+// circleCell a square cell of the search grid, distance is the distance at its center.
+type circleCell struct {
+	x, y, halfSide, distance float64
+}
+
+// maxDistance returns the largest distance any point of the cell may have.
+func (c *circleCell) maxDistance() float64 {
+	return c.distance + c.halfSide*math.Sqrt2
+}
+
+// cellQueue a priority queue of cells, ordered by decreasing maximum distance.
+type cellQueue []*circleCell
+
+// Len ...
+func (q cellQueue) Len() int { return len(q) }
+
+// Less ...
+func (q cellQueue) Less(i, j int) bool { return q[i].maxDistance() > q[j].maxDistance() }
+
+// Swap ...
+func (q cellQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push ...
+func (q *cellQueue) Push(x interface{}) { *q = append(*q, x.(*circleCell)) }
+
+// Pop ...
+func (q *cellQueue) Pop() interface{} {
+	old := *q
+	cell := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return cell
+}
+
+// polygonal returns the polygons of a polygonal geometry, or nil if the geometry has no polygons
+// or has other components.
+func polygonal(geom matrix.Steric) []matrix.PolygonMatrix {
+	polys := []matrix.PolygonMatrix{}
+	switch g := geom.(type) {
+	case matrix.PolygonMatrix:
+		polys = append(polys, g)
+	case matrix.MultiPolygonMatrix:
+		for _, v := range g {
+			polys = append(polys, v)
+		}
+	case matrix.Collection:
+		for _, v := range g {
+			p := polygonal(v)
+			if p == nil {
+				return nil
+			}
+			polys = append(polys, p...)
+		}
+	}
+	if len(polys) == 0 {
+		return nil
+	}
+	return polys
+}
+
+// vertices returns the vertices of all the components of the geometry.
+func vertices(geom matrix.Steric) matrix.LineMatrix {
+	switch g := geom.(type) {
+	case matrix.Matrix:
+		return matrix.LineMatrix{g}
+	case matrix.LineMatrix:
+		return g
+	case matrix.PolygonMatrix:
+		result := matrix.LineMatrix{}
+		for _, v := range g {
+			result = append(result, v...)
+		}
+		return result
+	case matrix.MultiPolygonMatrix:
+		result := matrix.LineMatrix{}
+		for _, v := range g {
+			result = append(result, vertices(matrix.PolygonMatrix(v))...)
+		}
+		return result
+	case matrix.Collection:
+		result := matrix.LineMatrix{}
+		for _, v := range g {
+			result = append(result, vertices(v)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// finite returns true if all the vertices of the geometry are finite.
+func finite(geom matrix.Steric) bool {
+	for _, v := range vertices(geom) {
+		for _, c := range v {
+			if math.IsNaN(c) || math.IsInf(c, 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// areaLocator locates points in a set of polygons.
+type areaLocator struct {
+	polys  []matrix.PolygonMatrix
+	bounds []matrix.Bound
+	env    *envelope.Envelope
+}
+
+func newAreaLocator(polys []matrix.PolygonMatrix) *areaLocator {
+	a := &areaLocator{polys: polys, env: envelope.Empty()}
+	for _, v := range polys {
+		bound := v.Bound()
+		a.bounds = append(a.bounds, bound)
+		a.env.ExpandToIncludeEnv(envelope.Bound(bound))
+	}
+	return a
+}
+
+// covers returns true if the point is in the interior or on the boundary of one of the polygons.
+func (a *areaLocator) covers(p matrix.Matrix) bool {
+	for i, poly := range a.polys {
+		if a.bounds[i].Contains(p) && relate.LocateInPolygon(p, poly) != calc.ImExterior {
+			return true
+		}
+	}
+	return false
+}
+
+// facetIndex a quadtree of the segments and points of a geometry, answering nearest facet queries.
+type facetIndex struct {
+	tree *quadtree.Quadtree
+	env  *envelope.Envelope
+	size int
+}
+
+func newFacetIndex(geom matrix.Steric) *facetIndex {
+	f := &facetIndex{tree: quadtree.NewQuadtree(), env: envelope.Empty()}
+	f.add(geom)
+	return f
+}
+
+func (f *facetIndex) add(geom matrix.Steric) {
+	switch g := geom.(type) {
+	case matrix.Matrix:
+		f.insert(&matrix.LineSegment{P0: g, P1: g})
+	case matrix.LineMatrix:
+		for _, v := range matrix.LineArray(g) {
+			f.insert(v)
+		}
+		if len(g) == 1 {
+			f.insert(&matrix.LineSegment{P0: g[0], P1: g[0]})
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range g {
+			f.add(matrix.LineMatrix(v))
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range g {
+			f.add(matrix.PolygonMatrix(v))
+		}
+	case matrix.Collection:
+		for _, v := range g {
+			f.add(v)
+		}
+	}
+}
+
+func (f *facetIndex) insert(seg *matrix.LineSegment) {
+	env := envelope.TwoMatrix(seg.P0, seg.P1)
+	f.env.ExpandToIncludeEnv(env)
+	_ = f.tree.Insert(env, seg)
+	f.size++
+}
+
+// nearest returns the distance from the point to the nearest facet, and the nearest point of the facet.
+// The search window grows until it holds a facet within its half width, covers every facet,
+// or its half width overflows.
+func (f *facetIndex) nearest(p matrix.Matrix) (float64, matrix.Matrix) {
+	if f.size == 0 {
+		return math.Inf(1), nil
+	}
+	r := f.env.MaxExtent() / math.Sqrt(float64(f.size))
+	if d := f.env.Distance(envelope.Matrix(p)); d > r {
+		r = d
+	}
+	if r == 0 {
+		r = 1
+	}
+	for {
+		search := envelope.FourFloat(p[0]-r, p[0]+r, p[1]-r, p[1]+r)
+		items, _ := f.tree.Query(search).([]interface{})
+		dist, nearest := math.Inf(1), matrix.Matrix(nil)
+		for _, v := range items {
+			seg := v.(*matrix.LineSegment)
+			pt := closestPoint(p, seg.P0, seg.P1)
+			if d := math.Hypot(p[0]-pt[0], p[1]-pt[1]); d < dist {
+				dist, nearest = d, pt
+			}
+		}
+		if dist <= r || search.Covers(f.env) || math.IsInf(r, 0) || math.IsNaN(r) {
+			return dist, nearest
+		}
+		r *= 2
+	}
+}
+
+// closestPoint returns the point of the segment ab nearest to p.
+func closestPoint(p, a, b matrix.Matrix) matrix.Matrix {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	len2 := dx*dx + dy*dy
+	if len2 == 0 {
+		return a
+	}
+	r := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / len2
+	if r <= 0 {
+		return a
+	}
+	if r >= 1 {
+		return b
+	}
+	return matrix.Matrix{a[0] + r*dx, a[1] + r*dy}
+}
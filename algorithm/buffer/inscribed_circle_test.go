@@ -0,0 +1,119 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMaximumInscribedCircle(t *testing.T) {
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		tolerance  float64
+		wantCenter matrix.Matrix
+		wantRadius float64
+		wantErr    error
+	}{
+		{"square", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, 0.01,
+			matrix.Matrix{5, 5}, 5, nil},
+		{"rectangle", matrix.PolygonMatrix{{{0, 0}, {20, 0}, {20, 4}, {0, 4}, {0, 0}}}, 0.01,
+			nil, 2, nil},
+		{"L shape", matrix.PolygonMatrix{{{0, 0}, {30, 0}, {30, 10}, {10, 10}, {10, 30}, {0, 30}, {0, 0}}}, 0.01,
+			nil, 10 * math.Sqrt2 / (1 + math.Sqrt2), nil},
+		{"hole", matrix.PolygonMatrix{{{0, 0}, {30, 0}, {30, 30}, {0, 30}, {0, 0}},
+			{{10, 10}, {20, 10}, {20, 20}, {10, 20}, {10, 10}}}, 0.01, nil, 10 * math.Sqrt2 / (1 + math.Sqrt2), nil},
+		{"multipolygon", matrix.MultiPolygonMatrix{{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}}, 0.01, matrix.Matrix{15, 5}, 5, nil},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 1}}, 0.01, nil, 0, algorithm.ErrNotMatchType},
+		{"tolerance", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, 0,
+			nil, 0, algorithm.ErrWrongTolerance},
+		{"infinite", matrix.PolygonMatrix{{{0, 0}, {math.Inf(1), 0}, {10, 10}, {0, 10}, {0, 0}}}, 0.01,
+			nil, 0, algorithm.ErrNotFinite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MaximumInscribedCircle(tt.geom, tt.tolerance)
+			if err != tt.wantErr {
+				t.Fatalf("MaximumInscribedCircle() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if math.Abs(got.Radius-tt.wantRadius) > tt.tolerance {
+				t.Errorf("MaximumInscribedCircle() radius = %v, want %v", got.Radius, tt.wantRadius)
+			}
+			if tt.wantCenter != nil && !got.Center.EqualsExact(tt.wantCenter, tt.tolerance) {
+				t.Errorf("MaximumInscribedCircle() center = %v, want %v", got.Center, tt.wantCenter)
+			}
+			if d := math.Hypot(got.Center[0]-got.RadiusPoint[0], got.Center[1]-got.RadiusPoint[1]); math.Abs(d-got.Radius) > 1e-9 {
+				t.Errorf("MaximumInscribedCircle() radius point = %v, at %v from the center", got.RadiusPoint, d)
+			}
+		})
+	}
+}
+
+func TestLargestEmptyCircle(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name       string
+		obstacles  matrix.Steric
+		boundary   matrix.Steric
+		wantCenter matrix.Matrix
+		wantRadius float64
+	}{
+		{"corners", matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, matrix.Matrix{10, 10}, matrix.Matrix{0, 10}},
+			nil, matrix.Matrix{5, 5}, 5 * math.Sqrt2},
+		{"corners in boundary", matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, matrix.Matrix{10, 10}, matrix.Matrix{0, 10}},
+			square, matrix.Matrix{5, 5}, 5 * math.Sqrt2},
+		{"center point", matrix.Matrix{5, 5}, square, nil, 5 * math.Sqrt2},
+		{"lines", matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 10}, {10, 10}}},
+			nil, nil, 5},
+		{"polygon obstacle", matrix.Collection{matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 10}, {0, 10}, {0, 0}}}},
+			square, nil, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LargestEmptyCircle(tt.obstacles, tt.boundary, 0.01)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got.Radius-tt.wantRadius) > 0.01 {
+				t.Errorf("LargestEmptyCircle() radius = %v, want %v", got.Radius, tt.wantRadius)
+			}
+			if tt.wantCenter != nil && !got.Center.EqualsExact(tt.wantCenter, 0.01) {
+				t.Errorf("LargestEmptyCircle() center = %v, want %v", got.Center, tt.wantCenter)
+			}
+		})
+	}
+}
+
+func TestLargestEmptyCircle_NotFinite(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name      string
+		obstacles matrix.Steric
+		boundary  matrix.Steric
+	}{
+		{"nan", matrix.Matrix{math.NaN(), math.NaN()}, square},
+		{"nan x", matrix.Matrix{math.NaN(), 1}, square},
+		{"inf", matrix.LineMatrix{{0, 0}, {math.Inf(-1), 1}}, nil},
+		{"nan boundary", matrix.Matrix{5, 5}, matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, math.NaN()}, {0, 10}, {0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LargestEmptyCircle(tt.obstacles, tt.boundary, 1); err != algorithm.ErrNotFinite {
+				t.Errorf("LargestEmptyCircle() error = %v, want %v", err, algorithm.ErrNotFinite)
+			}
+		})
+	}
+}
+
+func TestFacetIndex_NearestNotFinite(t *testing.T) {
+	facets := newFacetIndex(matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}})
+	if dist, _ := facets.nearest(matrix.Matrix{math.NaN(), 1}); !math.IsInf(dist, 1) {
+		t.Errorf("nearest() = %v, want +Inf", dist)
+	}
+}
@@ -158,9 +158,15 @@ func (ip *InteriorPointArea) Add(poly matrix.Steric) {
 	switch p := poly.(type) {
 	case matrix.PolygonMatrix:
 		ip.processPolygon(p)
+	case matrix.MultiPolygonMatrix:
+		for _, v := range p {
+			ip.processPolygon(v)
+		}
 	case matrix.Collection:
 		for _, v := range p {
-			ip.processPolygon(v.(matrix.PolygonMatrix))
+			if poly, ok := v.(matrix.PolygonMatrix); ok {
+				ip.processPolygon(poly)
+			}
 		}
 	}
 }
@@ -201,6 +207,9 @@ func (ip *InteriorPointArea) updateInterval(loY, hiY, y float64) (float64, float
 // processPolygon Computes an interior point of a component Polygon
 // and updates current best interior point if appropriate.
 func (ip *InteriorPointArea) processPolygon(polygon matrix.PolygonMatrix) {
+	if polygon.Bound().IsEmpty() {
+		return
+	}
 	ip.interiorPointY = ip.ScanLineY(polygon)
 	ip.process(polygon)
 	width := ip.Width()
@@ -231,8 +240,8 @@ func (ip *InteriorPointArea) process(polygon matrix.PolygonMatrix) {
 }
 
 func (ip *InteriorPointArea) scanRing(ring matrix.LineMatrix, crossings []float64) []float64 {
-	// skip rings which don't cross scan line
-	if !ip.intersectsHorizontalLine(ring.Bound()[0], ring.Bound()[1], ip.interiorPointY) {
+	// skip rings which are empty or don't cross scan line
+	if len(ring) == 0 || !ip.intersectsHorizontalLine(ring.Bound()[0], ring.Bound()[1], ip.interiorPointY) {
 		return crossings
 	}
 
@@ -270,13 +279,12 @@ func (ip *InteriorPointArea) findBestMidpoint(crossings []float64) {
 		return
 	}
 
-	sort.Float64sAreSorted(sort.Float64Slice(crossings))
+	sort.Float64s(crossings)
 
 	// Entries in crossings list are expected to occur in pairs representing a
 	// section of the scan line interior to the polygon (which may be zero-length)
-	for i := 0; i < len(crossings); i += 2 {
+	for i := 0; i+1 < len(crossings); i += 2 {
 		x1 := crossings[i]
-		// crossings count must be even so this should be safe
 		x2 := crossings[i+1]
 
 		width := x2 - x1
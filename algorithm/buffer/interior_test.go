@@ -21,6 +21,16 @@ func TestInteriorPoint(t *testing.T) {
 			{{0, 0}, {0, 5}, {5, 5}, {5, 0}, {0, 0}},
 		},
 		}, matrix.Matrix{2.5, 2.5}},
+		{"polygon ccw interior", args{matrix.PolygonMatrix{
+			{{0, 0}, {5, 0}, {5, 5}, {0, 5}, {0, 0}},
+		},
+		}, matrix.Matrix{2.5, 2.5}},
+		{"multipolygon interior", args{matrix.MultiPolygonMatrix{
+			{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+			{{{10, 0}, {15, 0}, {15, 5}, {10, 5}, {10, 0}}},
+		},
+		}, matrix.Matrix{12.5, 2.5}},
+		{"polygon empty ring", args{matrix.PolygonMatrix{{}}}, nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
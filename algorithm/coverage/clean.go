@@ -0,0 +1,96 @@
+package coverage
+
+import (
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+	"github.com/spatial-go/geoos/algorithm/precision"
+)
+
+// Clean returns the polygons cleaned into a valid coverage, in the order of the input.
+// If gridSize is positive the polygons are first rounded to a grid of that size, which snaps nearly matching vertices.
+// Areas covered by several polygons are given to the polygon with the lowest index,
+// uncovered areas narrower than gapWidth are merged into the adjacent polygon with the longest shared border.
+// Each result is a Polygon, or a Collection of Polygons if the polygon is split into several or no parts.
+func Clean(polys []matrix.PolygonMatrix, gridSize, gapWidth float64) ([]matrix.Steric, error) {
+	if gridSize < 0 || gapWidth < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	// rounding may split a polygon, parts keeps the index of the polygon each part comes from.
+	parts, owners := []matrix.PolygonMatrix{}, []int{}
+	for i, poly := range polys {
+		var reduced matrix.Steric = poly
+		if gridSize > 0 {
+			var err error
+			if reduced, err = precision.Reduce(poly, gridSize); err != nil {
+				return nil, err
+			}
+		}
+		for _, v := range polygons(reduced) {
+			parts = append(parts, v)
+			owners = append(owners, i)
+		}
+	}
+
+	a := newArrangement(parts)
+	assigned := make([]int, len(a.faces))
+	for i := range a.faces {
+		assigned[i] = -1
+		for _, v := range a.owners[i] {
+			if assigned[i] < 0 || owners[v] < assigned[i] {
+				assigned[i] = owners[v]
+			}
+		}
+	}
+	if gapWidth > 0 {
+		gaps := map[int]int{}
+		for i, face := range a.faces {
+			if assigned[i] >= 0 || !isNarrow(face, gapWidth) {
+				continue
+			}
+			best, longest := -1, 0.0
+			for other, length := range a.neighbours(i) {
+				owner := assigned[other]
+				if owner < 0 {
+					continue
+				}
+				if length > longest || (length == longest && owner < best) {
+					best, longest = owner, length
+				}
+			}
+			if best >= 0 {
+				gaps[i] = best
+			}
+		}
+		for face, owner := range gaps {
+			assigned[face] = owner
+		}
+	}
+
+	faces := make([][]matrix.PolygonMatrix, len(polys))
+	for i, owner := range assigned {
+		if owner >= 0 {
+			faces[owner] = append(faces[owner], a.faces[i])
+		}
+	}
+	result := make([]matrix.Steric, len(polys))
+	for i := range result {
+		result[i] = polygonize.Dissolve(faces[i])
+	}
+	return result, nil
+}
+
+// polygons returns the polygons of a Polygon or a Collection of Polygons.
+func polygons(steric matrix.Steric) []matrix.PolygonMatrix {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		return []matrix.PolygonMatrix{s}
+	case matrix.Collection:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			result = append(result, polygons(v)...)
+		}
+		return result
+	}
+	return nil
+}
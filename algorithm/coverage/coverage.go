@@ -0,0 +1,94 @@
+// Package coverage Provides operations on polygonal coverages,
+// sets of polygons which do not overlap and whose shared edges match exactly,
+// such as administrative areas or land parcels.
+package coverage
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/noding"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// arrangement the faces formed by the noded rings of the polygons of a coverage,
+// with the indices of the polygons covering each face.
+type arrangement struct {
+	faces    []matrix.PolygonMatrix
+	owners   [][]int
+	segments map[[4]float64][]int
+}
+
+func newArrangement(polys []matrix.PolygonMatrix) *arrangement {
+	lines := []matrix.LineMatrix{}
+	bounds := make([]matrix.Bound, len(polys))
+	for i, poly := range polys {
+		bounds[i] = poly.Bound()
+		for _, ring := range poly {
+			lines = append(lines, ring)
+		}
+	}
+	a := &arrangement{segments: map[[4]float64][]int{}}
+	a.faces = polygonize.Polygonize(noding.Node(lines, 0)...)
+	a.owners = make([][]int, len(a.faces))
+	for i, face := range a.faces {
+		for _, ring := range face {
+			for j := 0; j < len(ring)-1; j++ {
+				key := segmentKey(ring[j], ring[j+1])
+				a.segments[key] = append(a.segments[key], i)
+			}
+		}
+		ip := polygonize.InteriorPoint(face)
+		if ip == nil {
+			continue
+		}
+		for j, poly := range polys {
+			if !bounds[j].Contains(ip) {
+				continue
+			}
+			if relate.LocateInPolygon(ip, poly) == calc.ImInterior {
+				a.owners[i] = append(a.owners[i], j)
+			}
+		}
+	}
+	return a
+}
+
+// neighbours returns the length of the border the face shares with each of its neighbouring faces.
+func (a *arrangement) neighbours(face int) map[int]float64 {
+	borders := map[int]float64{}
+	for _, ring := range a.faces[face] {
+		for j := 0; j < len(ring)-1; j++ {
+			for _, other := range a.segments[segmentKey(ring[j], ring[j+1])] {
+				if other != face {
+					borders[other] += math.Hypot(ring[j+1][0]-ring[j][0], ring[j+1][1]-ring[j][1])
+				}
+			}
+		}
+	}
+	return borders
+}
+
+// isNarrow returns true if the face is narrower than width,
+// the width of the face being estimated as twice its area divided by its perimeter.
+func isNarrow(face matrix.PolygonMatrix, width float64) bool {
+	perimeter := 0.0
+	for _, ring := range face {
+		perimeter += measure.OfLine(ring)
+	}
+	if perimeter == 0 {
+		return false
+	}
+	return 2*measure.AreaOfPolygon(face)/perimeter <= width
+}
+
+// segmentKey returns a key identifying the segment regardless of its direction.
+func segmentKey(p0, p1 []float64) [4]float64 {
+	if p0[0] > p1[0] || (p0[0] == p1[0] && p0[1] > p1[1]) {
+		p0, p1 = p1, p0
+	}
+	return [4]float64{p0[0], p0[1], p1[0], p1[1]}
+}
@@ -0,0 +1,165 @@
+package coverage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+var (
+	left   = matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	right  = matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}
+	top    = matrix.PolygonMatrix{{{0, 10}, {20, 10}, {20, 20}, {0, 20}, {0, 10}}}
+	topFit = matrix.PolygonMatrix{{{0, 10}, {10, 10}, {20, 10}, {20, 20}, {0, 20}, {0, 10}}}
+
+	// gapped leaves a gap 0.5 wide between it and left, closed by bottom and topGap.
+	gapped = matrix.PolygonMatrix{{{10.5, 0}, {20, 0}, {20, 10}, {10.5, 10}, {10.5, 0}}}
+	bottom = matrix.PolygonMatrix{{{0, -10}, {20, -10}, {20, 0}, {10.5, 0}, {10, 0}, {0, 0}, {0, -10}}}
+	topGap = matrix.PolygonMatrix{{{0, 10}, {10, 10}, {10.5, 10}, {20, 10}, {20, 20}, {0, 20}, {0, 10}}}
+)
+
+func TestValidate(t *testing.T) {
+	overlapping := matrix.PolygonMatrix{{{8, 0}, {20, 0}, {20, 10}, {8, 10}, {8, 0}}}
+	tests := []struct {
+		name     string
+		polys    []matrix.PolygonMatrix
+		gapWidth float64
+		want     []int
+	}{
+		{name: "valid", polys: []matrix.PolygonMatrix{left, right, topFit}, gapWidth: 1, want: []int{}},
+		{name: "missing vertex", polys: []matrix.PolygonMatrix{left, right, top},
+			want: []int{IssueMismatch, IssueMismatch, IssueMismatch, IssueMismatch}},
+		{name: "overlap", polys: []matrix.PolygonMatrix{left, overlapping},
+			want: []int{IssueMismatch, IssueMismatch, IssueMismatch, IssueMismatch, IssueOverlap}},
+		{name: "gap", polys: []matrix.PolygonMatrix{left, gapped}, gapWidth: 1, want: []int{}},
+		{name: "gap in coverage", polys: []matrix.PolygonMatrix{left, gapped, bottom, topGap}, gapWidth: 1,
+			want: []int{IssueGap}},
+		{name: "wide gap", polys: []matrix.PolygonMatrix{left, gapped, bottom, topGap}, gapWidth: 0.2, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.polys, tt.gapWidth)
+			kinds := []int{}
+			for _, v := range got {
+				kinds = append(kinds, v.Kind)
+			}
+			if len(kinds) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want kinds %v", got, tt.want)
+			}
+			for i := range kinds {
+				if kinds[i] != tt.want[i] {
+					t.Errorf("Validate() = %v, want kinds %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestClean(t *testing.T) {
+	overlapping := matrix.PolygonMatrix{{{8, 0}, {20, 0}, {20, 10}, {8, 10}, {8, 0}}}
+	nearly := matrix.PolygonMatrix{{{10.01, 0}, {20, 0}, {20, 10}, {10.01, 10}, {10.01, 0}}}
+	tests := []struct {
+		name      string
+		polys     []matrix.PolygonMatrix
+		gridSize  float64
+		gapWidth  float64
+		wantAreas []float64
+	}{
+		{name: "overlap", polys: []matrix.PolygonMatrix{left, overlapping}, wantAreas: []float64{100, 100}},
+		// the gap borders left and gapped equally, the tie goes to the lower index.
+		{name: "gap", polys: []matrix.PolygonMatrix{left, gapped, bottom, topGap}, gapWidth: 1,
+			wantAreas: []float64{105, 95, 200, 200}},
+		{name: "snap", polys: []matrix.PolygonMatrix{left, nearly}, gridSize: 0.1, wantAreas: []float64{100, 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Clean(tt.polys, tt.gridSize, tt.gapWidth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, v := range got {
+				if a := area(v); math.Abs(a-tt.wantAreas[i]) > 1e-9 {
+					t.Errorf("Clean() area %v = %v, want %v", i, a, tt.wantAreas[i])
+				}
+			}
+			polys := []matrix.PolygonMatrix{}
+			for _, v := range got {
+				polys = append(polys, polygons(v)...)
+			}
+			if issues := Validate(polys, tt.gapWidth); len(issues) != 0 {
+				t.Errorf("Clean() is not valid: %v", issues)
+			}
+		})
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	zigzag := matrix.LineMatrix{{10, 0}, {10.1, 2}, {9.9, 4}, {10.1, 6}, {9.9, 8}, {10, 10}}
+	west := matrix.PolygonMatrix{append(append(matrix.LineMatrix{{0, 0}}, zigzag...), matrix.LineMatrix{{0, 10}, {0, 0}}...)}
+	east := matrix.PolygonMatrix{append(append(matrix.LineMatrix{{20, 0}, {20, 10}}, reverse(zigzag)...), matrix.LineMatrix{{20, 0}}...)}
+
+	got, err := Simplify([]matrix.PolygonMatrix{west, east}, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int{5, 5} {
+		if len(got[i][0]) != want {
+			t.Errorf("Simplify() polygon %v = %v, want %v points", i, got[i], want)
+		}
+	}
+	if issues := Validate(got, 0); len(issues) != 0 {
+		t.Errorf("Simplify() is not valid: %v", issues)
+	}
+	if a := area(Union(got)); a != 200 {
+		t.Errorf("Simplify() union area = %v, want %v", a, 200)
+	}
+
+	notch := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {5, 10.4}, {0, 10}, {0, 0}}}
+	island := matrix.PolygonMatrix{{{4.9, 9.9}, {5.1, 9.9}, {5.1, 10.1}, {4.9, 10.1}, {4.9, 9.9}}}
+	got, err = Simplify([]matrix.PolygonMatrix{notch, island}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got[0][0]) != 6 {
+		t.Errorf("Simplify() = %v, want the notch kept", got[0])
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name      string
+		polys     []matrix.PolygonMatrix
+		wantArea  float64
+		wantParts int
+	}{
+		{name: "adjacent", polys: []matrix.PolygonMatrix{left, right, topFit}, wantArea: 400, wantParts: 1},
+		{name: "disjoint", polys: []matrix.PolygonMatrix{left, {{{30, 0}, {30, 10}, {40, 10}, {40, 0}, {30, 0}}}}, wantArea: 200, wantParts: 2},
+		{name: "ring", polys: []matrix.PolygonMatrix{
+			{{{0, 0}, {30, 0}, {30, 10}, {20, 10}, {10, 10}, {0, 10}, {0, 0}}},
+			{{{0, 10}, {10, 10}, {10, 20}, {0, 20}, {0, 10}}},
+			{{{20, 10}, {30, 10}, {30, 20}, {20, 20}, {20, 10}}},
+			{{{0, 20}, {10, 20}, {20, 20}, {30, 20}, {30, 30}, {0, 30}, {0, 20}}},
+		}, wantArea: 800, wantParts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Union(tt.polys)
+			if a := area(got); a != tt.wantArea {
+				t.Errorf("Union() area = %v, want %v", a, tt.wantArea)
+			}
+			if parts := len(polygons(got)); parts != tt.wantParts {
+				t.Errorf("Union() parts = %v, want %v", parts, tt.wantParts)
+			}
+		})
+	}
+}
+
+func area(steric matrix.Steric) float64 {
+	a := 0.0
+	for _, v := range polygons(steric) {
+		a += measure.AreaOfPolygon(v)
+	}
+	return a
+}
@@ -0,0 +1,243 @@
+package coverage
+
+import (
+	"fmt"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/noding"
+	"github.com/spatial-go/geoos/algorithm/simplify"
+)
+
+// maxSimplifyIterations the number of times the tolerance of an edge is halved
+// before the edge is left unsimplified.
+const maxSimplifyIterations = 8
+
+// edge a section of the coverage linework between nodes, shared by at most two polygons.
+type edge struct {
+	line, simplified matrix.LineMatrix
+	tolerance        float64
+}
+
+// ringEdge an edge of a ring, reversed if the ring runs against the direction of the edge.
+type ringEdge struct {
+	edge     *edge
+	reversed bool
+}
+
+// Simplify simplifies the polygons of a valid coverage with the Douglas-Peucker algorithm,
+// each edge shared by two polygons is simplified once, so adjacent polygons stay consistent.
+// Edges are simplified less where simplification would make them cross or collapse a ring.
+func Simplify(polys []matrix.PolygonMatrix, tolerance float64) ([]matrix.PolygonMatrix, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	nodes := coverageNodes(polys)
+	edges := map[string]*edge{}
+	rings := make([][][]ringEdge, len(polys))
+	for i, poly := range polys {
+		rings[i] = make([][]ringEdge, len(poly))
+		for j, ring := range poly {
+			for _, section := range splitRing(ring, nodes) {
+				line, reversed := canonical(section)
+				key := fmt.Sprint(line)
+				e, ok := edges[key]
+				if !ok {
+					e = &edge{line: line, tolerance: tolerance}
+					e.simplified = simplifyEdge(line, tolerance)
+					edges[key] = e
+				}
+				rings[i][j] = append(rings[i][j], ringEdge{e, reversed})
+			}
+		}
+	}
+
+	for iteration := 1; ; iteration++ {
+		bad := badEdges(edges, rings)
+		if len(bad) == 0 {
+			break
+		}
+		for e := range bad {
+			e.tolerance /= 2
+			if iteration >= maxSimplifyIterations {
+				e.simplified = e.line
+			} else {
+				e.simplified = simplifyEdge(e.line, e.tolerance)
+			}
+		}
+	}
+
+	result := make([]matrix.PolygonMatrix, len(polys))
+	for i, poly := range rings {
+		result[i] = make(matrix.PolygonMatrix, len(poly))
+		for j, ring := range poly {
+			result[i][j] = buildRing(ring)
+		}
+	}
+	return result, nil
+}
+
+// coverageNodes returns the vertices of the coverage where other than two segments meet.
+func coverageNodes(polys []matrix.PolygonMatrix) map[[2]float64]bool {
+	segments := map[[2]float64]map[[4]float64]bool{}
+	add := func(pt []float64, key [4]float64) {
+		vertex := [2]float64{pt[0], pt[1]}
+		if segments[vertex] == nil {
+			segments[vertex] = map[[4]float64]bool{}
+		}
+		segments[vertex][key] = true
+	}
+	for _, poly := range polys {
+		for _, ring := range poly {
+			for i := 0; i < len(ring)-1; i++ {
+				key := segmentKey(ring[i], ring[i+1])
+				add(ring[i], key)
+				add(ring[i+1], key)
+			}
+		}
+	}
+	nodes := map[[2]float64]bool{}
+	for vertex, v := range segments {
+		if len(v) != 2 {
+			nodes[vertex] = true
+		}
+	}
+	return nodes
+}
+
+// splitRing splits the ring at the nodes, a ring without nodes is returned whole,
+// starting at its lowest vertex.
+func splitRing(ring matrix.LineMatrix, nodes map[[2]float64]bool) []matrix.LineMatrix {
+	n := len(ring) - 1
+	start := -1
+	for i := 0; i < n; i++ {
+		if nodes[[2]float64{ring[i][0], ring[i][1]}] {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+		for i := 1; i < n; i++ {
+			if compare(ring[i], ring[start]) < 0 {
+				start = i
+			}
+		}
+		rotated := make(matrix.LineMatrix, 0, n+1)
+		for i := 0; i <= n; i++ {
+			rotated = append(rotated, ring[(start+i)%n])
+		}
+		return []matrix.LineMatrix{rotated}
+	}
+	sections := []matrix.LineMatrix{}
+	section := matrix.LineMatrix{ring[start]}
+	for i := 1; i <= n; i++ {
+		v := ring[(start+i)%n]
+		section = append(section, v)
+		if nodes[[2]float64{v[0], v[1]}] {
+			sections = append(sections, section)
+			section = matrix.LineMatrix{v}
+		}
+	}
+	return sections
+}
+
+// canonical returns the line in its canonical direction, and whether it has been reversed.
+func canonical(line matrix.LineMatrix) (matrix.LineMatrix, bool) {
+	for i, j := 0, len(line)-1; i < j; i, j = i+1, j-1 {
+		if c := compare(line[i], line[j]); c != 0 {
+			if c < 0 {
+				return line, false
+			}
+			return reverse(line), true
+		}
+	}
+	return line, false
+}
+
+func simplifyEdge(line matrix.LineMatrix, tolerance float64) matrix.LineMatrix {
+	simplified, ok := simplify.Simplify(line, tolerance).(matrix.LineMatrix)
+	if !ok || len(simplified) < 2 || (line.IsClosed() && len(simplified) < 4) {
+		return line
+	}
+	return simplified
+}
+
+// badEdges returns the simplified edges which intersect other edges other than at their end nodes,
+// or which collapse a ring.
+func badEdges(edges map[string]*edge, rings [][][]ringEdge) map[*edge]bool {
+	list := make([]*edge, 0, len(edges))
+	lines := make([]matrix.LineMatrix, 0, len(edges))
+	for _, e := range edges {
+		list = append(list, e)
+		lines = append(lines, e.simplified)
+	}
+	isEndPoint := func(pt matrix.Matrix, line matrix.LineMatrix) bool {
+		return pt.Equals(matrix.Matrix(line[0])) || pt.Equals(matrix.Matrix(line[len(line)-1]))
+	}
+	bad := map[*edge]bool{}
+	for _, v := range noding.Intersections(lines...) {
+		l0, l1 := lines[v.Line0], lines[v.Line1]
+		if v.Line0 != v.Line1 && isEndPoint(v.Point, l0) && isEndPoint(v.Point, l1) {
+			continue
+		}
+		if list[v.Line0].simplified.Equals(list[v.Line0].line) && list[v.Line1].simplified.Equals(list[v.Line1].line) {
+			continue
+		}
+		bad[list[v.Line0]], bad[list[v.Line1]] = true, true
+	}
+	for _, poly := range rings {
+		for _, ring := range poly {
+			if len(buildRing(ring)) >= 4 {
+				continue
+			}
+			for _, re := range ring {
+				bad[re.edge] = true
+			}
+		}
+	}
+	for e := range bad {
+		if e.simplified.Equals(e.line) {
+			delete(bad, e)
+		}
+	}
+	return bad
+}
+
+// buildRing joins the simplified edges of a ring.
+func buildRing(edges []ringEdge) matrix.LineMatrix {
+	ring := matrix.LineMatrix{}
+	for _, re := range edges {
+		line := re.edge.simplified
+		if re.reversed {
+			line = reverse(line)
+		}
+		for _, v := range line {
+			if len(ring) > 0 && matrix.Matrix(ring[len(ring)-1]).Equals(matrix.Matrix(v)) {
+				continue
+			}
+			ring = append(ring, v)
+		}
+	}
+	return ring
+}
+
+func reverse(line matrix.LineMatrix) matrix.LineMatrix {
+	result := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		result[len(line)-1-i] = v
+	}
+	return result
+}
+
+func compare(a, b []float64) int {
+	for i := 0; i < 2; i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	return 0
+}
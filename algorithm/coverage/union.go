@@ -0,0 +1,27 @@
+package coverage
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+)
+
+// Union returns the union of the polygons of a valid coverage,
+// computed by removing the edges shared by adjacent polygons, which is much faster than a general union.
+// The result is a Polygon, or a Collection of Polygons if it has several or no parts.
+func Union(polys []matrix.PolygonMatrix) matrix.Steric {
+	oriented := make([]matrix.PolygonMatrix, 0, len(polys))
+	for _, poly := range polys {
+		result := make(matrix.PolygonMatrix, len(poly))
+		for i, ring := range poly {
+			// polygonize.Dissolve needs the polygon on the left of its rings,
+			// measure.AreaDirection is positive for clockwise rings.
+			if isClockwise := measure.AreaDirection(ring) > 0; isClockwise == (i == 0) {
+				ring = reverse(ring)
+			}
+			result[i] = ring
+		}
+		oriented = append(oriented, result)
+	}
+	return polygonize.Dissolve(oriented)
+}
@@ -0,0 +1,109 @@
+package coverage
+
+import (
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/noding"
+)
+
+// const coverage issue kinds.
+const (
+	// IssueMismatch a segment of a polygon which does not match the edges of an adjacent polygon exactly.
+	IssueMismatch = iota + 1
+	// IssueOverlap an area covered by several polygons.
+	IssueOverlap
+	// IssueGap a narrow area between polygons which is not covered.
+	IssueGap
+)
+
+// Issue an invalid location of a coverage.
+// Polygons are the indices of the polygons involved, Location is the mismatched segment
+// of the first polygon, or the overlap or gap area.
+type Issue struct {
+	Kind     int
+	Polygons []int
+	Location matrix.Steric
+}
+
+// Validate returns the issues which make the polygons an invalid coverage,
+// the segments which do not match the edges of adjacent polygons exactly,
+// the areas covered by several polygons and the uncovered areas narrower than gapWidth.
+// Gaps are not reported if gapWidth is zero.
+func Validate(polys []matrix.PolygonMatrix, gapWidth float64) []Issue {
+	issues := mismatches(polys)
+	a := newArrangement(polys)
+	for i, face := range a.faces {
+		switch {
+		case len(a.owners[i]) > 1:
+			issues = append(issues, Issue{IssueOverlap, a.owners[i], face})
+		case len(a.owners[i]) == 0 && gapWidth > 0 && isNarrow(face, gapWidth):
+			adjacent := map[int]bool{}
+			for other := range a.neighbours(i) {
+				for _, v := range a.owners[other] {
+					adjacent[v] = true
+				}
+			}
+			if len(adjacent) == 0 {
+				continue
+			}
+			polygons := make([]int, 0, len(adjacent))
+			for v := range adjacent {
+				polygons = append(polygons, v)
+			}
+			sort.Ints(polygons)
+			issues = append(issues, Issue{IssueGap, polygons, face})
+		}
+	}
+	return issues
+}
+
+// IsValid returns true if the polygons form a valid coverage, without gaps narrower than gapWidth.
+func IsValid(polys []matrix.PolygonMatrix, gapWidth float64) bool {
+	return len(Validate(polys, gapWidth)) == 0
+}
+
+// mismatches returns the segments of the polygons which intersect the segments of other polygons
+// other than by matching them exactly or at common vertices,
+// that is which cross or overlap them, or touch them at a point which is not a vertex of both.
+func mismatches(polys []matrix.PolygonMatrix) []Issue {
+	lines := []matrix.LineMatrix{}
+	owners := []int{}
+	for i, poly := range polys {
+		for _, ring := range poly {
+			lines = append(lines, ring)
+			owners = append(owners, i)
+		}
+	}
+	issues := []Issue{}
+	reported := map[[4]int]bool{}
+	report := func(line, seg, other int) {
+		key := [4]int{owners[line], line, seg, owners[other]}
+		if reported[key] {
+			return
+		}
+		reported[key] = true
+		issues = append(issues, Issue{IssueMismatch, []int{owners[line], owners[other]},
+			matrix.LineMatrix{lines[line][seg], lines[line][seg+1]}})
+	}
+	for _, v := range noding.Intersections(lines...) {
+		if owners[v.Line0] == owners[v.Line1] {
+			continue
+		}
+		p0, p1 := matrix.Matrix(lines[v.Line0][v.Segment0]), matrix.Matrix(lines[v.Line0][v.Segment0+1])
+		q0, q1 := matrix.Matrix(lines[v.Line1][v.Segment1]), matrix.Matrix(lines[v.Line1][v.Segment1+1])
+		if (p0.Equals(q0) && p1.Equals(q1)) || (p0.Equals(q1) && p1.Equals(q0)) {
+			continue
+		}
+		// segments touching at a vertex of one of them are only mismatched
+		// if the vertex is not on the other, or if they overlap.
+		overlap := len(noding.IntersectSegments(p0, p1, q0, q1)) > 1
+		if overlap || !(v.Point.Equals(p0) || v.Point.Equals(p1)) {
+			report(v.Line0, v.Segment0, v.Line1)
+		}
+		if overlap || !(v.Point.Equals(q0) || v.Point.Equals(q1)) {
+			report(v.Line1, v.Segment1, v.Line0)
+		}
+	}
+	return issues
+}
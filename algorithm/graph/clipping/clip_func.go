@@ -3,6 +3,8 @@
 package clipping
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/graph"
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -11,14 +13,32 @@ import (
 // UnaryUnion returns a Geometry containing the union.
 //	or an empty atomic geometry, or an empty GEOMETRYCOLLECTION
 func UnaryUnion(matrix4 matrix.Steric) (result matrix.Steric, err error) {
+	return UnaryUnionContext(context.Background(), matrix4)
+}
+
+// UnaryUnionContext returns a Geometry containing the union as UnaryUnion,
+// returning ctx.Err() if the context is done before the union is computed.
+func UnaryUnionContext(ctx context.Context, matrix4 matrix.Steric) (result matrix.Steric, err error) {
 	if c, ok := matrix4.(matrix.Collection); ok {
-		return unaryUnionByHalf(c, 0, len(c))
+		return unaryUnionByHalf(ctx, c, 0, len(c))
 	}
 	return nil, algorithm.ErrUnknownType(matrix4)
 }
 
 // Union  Computes the Union of two geometries, if one is encountered.
 func Union(m0, m1 matrix.Steric) (result matrix.Steric, err error) {
+	return UnionContext(context.Background(), m0, m1)
+}
+
+// UnionContext Computes the Union of two geometries as Union,
+// the context being checked before the union of each part of a collection.
+func UnionContext(ctx context.Context, m0, m1 matrix.Steric) (result matrix.Steric, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validPolygons(m0, m1); err != nil {
+		return nil, err
+	}
 	switch m := m0.(type) {
 	case matrix.Matrix:
 		over := &PointClipping{Subject: m, Clipping: m1}
@@ -33,7 +53,10 @@ func Union(m0, m1 matrix.Steric) (result matrix.Steric, err error) {
 		resultColl := matrix.Collection{}
 		IsUnion := false
 		for _, v := range m {
-			un, err := Union(v, m1)
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			un, err := UnionContext(ctx, v, m1)
 			if _, ok := un.(matrix.Collection); ok || err != nil {
 				resultColl = append(resultColl, v)
 			} else {
@@ -41,6 +64,9 @@ func Union(m0, m1 matrix.Steric) (result matrix.Steric, err error) {
 				resultColl = append(resultColl, un)
 			}
 		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		if !IsUnion {
 			switch coll := m1.(type) {
 			case matrix.Collection:
@@ -56,6 +82,9 @@ func Union(m0, m1 matrix.Steric) (result matrix.Steric, err error) {
 
 // Intersection  Computes the Intersection of two geometries,either or both of which may be nil.
 func Intersection(m0, m1 matrix.Steric) (matrix.Steric, error) {
+	if err := validPolygons(m0, m1); err != nil {
+		return nil, err
+	}
 	switch m := m0.(type) {
 	case matrix.Matrix:
 		over := &PointClipping{Subject: m, Clipping: m1}
@@ -81,6 +110,9 @@ func Intersection(m0, m1 matrix.Steric) (matrix.Steric, error) {
 // One can think of this as Union(geomA,geomB) - Intersection(A,B).
 func SymDifference(m0, m1 matrix.Steric) (matrix.Steric, error) {
 
+	if err := validPolygons(m0, m1); err != nil {
+		return nil, err
+	}
 	result := matrix.Collection{}
 	if res, err := Difference(m0, m1); err == nil {
 		if r, ok := res.(matrix.Collection); ok {
@@ -103,6 +135,9 @@ func SymDifference(m0, m1 matrix.Steric) (matrix.Steric, error) {
 // One can think of this as GeometryA - Intersection(A,B).
 // If A is completely contained in B then an empty geometry collection is returned.
 func Difference(m0, m1 matrix.Steric) (matrix.Steric, error) {
+	if err := validPolygons(m0, m1); err != nil {
+		return nil, err
+	}
 	switch m := m0.(type) {
 	case matrix.Matrix:
 		return m0, nil
@@ -122,20 +157,44 @@ func Difference(m0, m1 matrix.Steric) (matrix.Steric, error) {
 	}
 }
 
+// validPolygons returns a TopologyError if a ring of a polygon of the geometries is not valid.
+func validPolygons(ms ...matrix.Steric) error {
+	for _, m := range ms {
+		switch p := m.(type) {
+		case matrix.PolygonMatrix:
+			if err := p.ValidRings(); err != nil {
+				return err
+			}
+		case matrix.MultiPolygonMatrix:
+			for _, v := range p {
+				if err := matrix.PolygonMatrix(v).ValidRings(); err != nil {
+					return err
+				}
+			}
+		case matrix.Collection:
+			if err := validPolygons(p...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // unaryUnionByHalf returns Unions a section of a list using a recursive binary union on each half of the section.
-func unaryUnionByHalf(matrix4 matrix.Collection, start, end int) (result matrix.Steric, err error) {
+// The context is checked before each union.
+func unaryUnionByHalf(ctx context.Context, matrix4 matrix.Collection, start, end int) (result matrix.Steric, err error) {
 	if matrix4 == nil {
 		return nil, nil
 	}
 	if end-start <= 1 {
-		result, err = Union(matrix4[start], nil)
+		result, err = UnionContext(ctx, matrix4[start], nil)
 	} else if end-start == 2 {
-		result, err = Union(matrix4[start], matrix4[start+1])
+		result, err = UnionContext(ctx, matrix4[start], matrix4[start+1])
 	} else {
 		mid := (end + start) / 2
-		g0, _ := unaryUnionByHalf(matrix4, start, mid)
-		g1, _ := unaryUnionByHalf(matrix4, mid, end)
-		result, err = Union(g0, g1)
+		g0, _ := unaryUnionByHalf(ctx, matrix4, start, mid)
+		g1, _ := unaryUnionByHalf(ctx, matrix4, mid, end)
+		result, err = UnionContext(ctx, g0, g1)
 	}
 	return
 }
@@ -3,9 +3,13 @@
 package clipping
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -43,3 +47,59 @@ func TestLineMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestUnaryUnionContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	polys := matrix.Collection{
+		matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+		matrix.PolygonMatrix{{{20, 20}, {30, 20}, {30, 30}, {20, 30}, {20, 20}}},
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background()},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnaryUnionContext(tt.ctx, polys)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnaryUnionContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != (tt.wantErr != nil) {
+				t.Errorf("UnaryUnionContext() = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClip_TopologyError(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	collapsed := matrix.PolygonMatrix{{{0, 1}, {0, 1}, {0, 1}, {0, 1}}}
+	tests := []struct {
+		name string
+		clip func(m0, m1 matrix.Steric) (matrix.Steric, error)
+	}{
+		{name: "union", clip: Union},
+		{name: "intersection", clip: Intersection},
+		{name: "difference", clip: Difference},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.clip(square, collapsed); !errors.Is(err, algorithm.ErrTopology) {
+				t.Errorf("%v() error = %v, want %v", tt.name, err, algorithm.ErrTopology)
+			}
+			if _, err := tt.clip(matrix.Collection{collapsed}, square); !errors.Is(err, algorithm.ErrTopology) {
+				t.Errorf("%v() collection error = %v, want %v", tt.name, err, algorithm.ErrTopology)
+			}
+		})
+	}
+}
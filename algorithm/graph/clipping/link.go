@@ -14,10 +14,10 @@ func link(gu, gi graph.Graph) (results []matrix.LineMatrix, err error) {
 	result := matrix.LineMatrix{}
 
 	attempts := 0
-	guNodes := gu.Nodes()
+	guNodes := nonEmptyLines(gu.Nodes())
 	giNodes := []*graph.Node{}
 	if gi != nil {
-		giNodes = gi.Nodes()
+		giNodes = nonEmptyLines(gi.Nodes())
 	}
 	beUsed := map[int]int{}
 	lenBeUsed := len(beUsed)
@@ -134,7 +134,7 @@ func linkmerge(gu graph.Graph) (results []matrix.LineMatrix, err error) {
 	results = []matrix.LineMatrix{}
 	result := matrix.LineMatrix{}
 
-	guNodes := gu.Nodes()
+	guNodes := nonEmptyLines(gu.Nodes())
 	beUsed := map[int]int{}
 	currentNode := 0
 	for {
@@ -201,3 +201,17 @@ func linkmerge(gu graph.Graph) (results []matrix.LineMatrix, err error) {
 	}
 	return results, nil
 }
+
+// nonEmptyLines returns the nodes without the line nodes of empty lines.
+func nonEmptyLines(nodes []*graph.Node) []*graph.Node {
+	result := make([]*graph.Node, 0, len(nodes))
+	for _, v := range nodes {
+		if v.NodeType == graph.CNode || v.NodeType == graph.LNode {
+			if line, ok := v.Value.(matrix.LineMatrix); ok && len(line) == 0 {
+				continue
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
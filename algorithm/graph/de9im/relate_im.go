@@ -6,6 +6,7 @@
 package de9im
 
 import (
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/graph"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
@@ -96,6 +97,9 @@ func IMStructure(m0, m1 matrix.Steric) *matrix.IntersectionMatrix {
 // IMByRelationship Gets the relate  for the spatial relationship
 // between the input geometries.
 func IMByRelationship(m0, m1 matrix.Steric, f RelateAlgorithm) *matrix.IntersectionMatrix {
+	if m0.IsEmpty() || m1.IsEmpty() {
+		return imEmpty(m0, m1)
+	}
 	arg := []matrix.Steric{m0, m1}
 	if m0.Dimensions() > m1.Dimensions() {
 		arg = []matrix.Steric{m1, m0}
@@ -108,6 +112,22 @@ func IMByRelationship(m0, m1 matrix.Steric, f RelateAlgorithm) *matrix.Intersect
 	return im
 }
 
+// imEmpty Gets the relate of geometries of which at least one is empty,
+// only the exteriors of the geometries may intersect.
+func imEmpty(m0, m1 matrix.Steric) *matrix.IntersectionMatrix {
+	im := matrix.IntersectionMatrixDefault()
+	im.Set(calc.ImExterior, calc.ImExterior, 2)
+	if !m0.IsEmpty() {
+		im.Set(calc.ImInterior, calc.ImExterior, m0.Dimensions())
+		im.Set(calc.ImBoundary, calc.ImExterior, m0.BoundaryDimensions())
+	}
+	if !m1.IsEmpty() {
+		im.Set(calc.ImExterior, calc.ImInterior, m1.Dimensions())
+		im.Set(calc.ImExterior, calc.ImBoundary, m1.BoundaryDimensions())
+	}
+	return im
+}
+
 // AInB Returns isIntersect, isAInB, isSure.
 func AInB(A, B matrix.Steric) (isIntersect, isAInB, isSure bool) {
 
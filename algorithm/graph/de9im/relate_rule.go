@@ -0,0 +1,302 @@
+package de9im
+
+import (
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/noding"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// kinds of the linework of an argument.
+const (
+	lineEdge = 1 << iota
+	ringEdge
+)
+
+// RelateWithRule Gets the relate string for the spatial relationship
+// between the input geometries, the boundaries of their lines given by the boundary node rule.
+func RelateWithRule(m0, m1 matrix.Steric, rule relate.BoundaryNodeRule) (string, error) {
+	im, err := IMWithRule(m0, m1, rule)
+	if err != nil {
+		return "", err
+	}
+	return im.ToString(), nil
+}
+
+// IMWithRule Gets the relate for the spatial relationship
+// between the input geometries, the boundaries of their lines given by the boundary node rule.
+// Geometries without lines are related as IM, error if the overlay of their polygons fails.
+func IMWithRule(m0, m1 matrix.Steric, rule relate.BoundaryNodeRule) (*matrix.IntersectionMatrix, error) {
+	if m0.IsEmpty() || m1.IsEmpty() || !hasLines(m0) && !hasLines(m1) {
+		return IM(m0, m1), nil
+	}
+	r := &ruleRelationship{
+		args:     [2]matrix.Steric{m0, m1},
+		locators: [2]*relate.Locator{relate.NewLocator(m0, rule), relate.NewLocator(m1, rule)},
+		IM:       matrix.IntersectionMatrixDefault(),
+	}
+	return r.ComputeIM()
+}
+
+// ruleRelationship computes the relate of the geometries from their noded linework,
+// the edges and nodes being located in both geometries.
+type ruleRelationship struct {
+	args     [2]matrix.Steric
+	locators [2]*relate.Locator
+	IM       *matrix.IntersectionMatrix
+
+	edges    map[[4]float64][2]int
+	vertices [2]map[[2]float64]int
+}
+
+// ComputeIM IntersectionMatrix Gets the IntersectionMatrix for the spatial relationship
+// between the input geometries, error if the overlay of their polygons fails.
+func (r *ruleRelationship) ComputeIM() (*matrix.IntersectionMatrix, error) {
+	r.IM.Set(calc.ImExterior, calc.ImExterior, calc.ImA)
+	if err := r.computeAreas(); err != nil {
+		return nil, err
+	}
+
+	lines, owners, kinds := []matrix.LineMatrix{}, []int{}, []int{}
+	for i, arg := range r.args {
+		for _, v := range linework(arg, false) {
+			lines, owners, kinds = append(lines, v.line), append(owners, i), append(kinds, v.kind)
+		}
+	}
+	r.edges = map[[4]float64][2]int{}
+	r.vertices = [2]map[[2]float64]int{{}, {}}
+	edges := [][]matrix.Matrix{}
+	for i, parts := range (&noding.Noder{}).NodeEach(lines...) {
+		for _, part := range parts {
+			for j := 1; j < len(part); j++ {
+				key := edgeKey(part[j-1], part[j])
+				kind, ok := r.edges[key]
+				if !ok {
+					edges = append(edges, []matrix.Matrix{part[j-1], part[j]})
+				}
+				kind[owners[i]] |= kinds[i]
+				r.edges[key] = kind
+				for _, p := range part[j-1 : j+1] {
+					r.vertices[owners[i]][vertexKey(p)] |= kinds[i]
+				}
+			}
+		}
+	}
+
+	for _, e := range edges {
+		kind := r.edges[edgeKey(e[0], e[1])]
+		mid := matrix.Matrix{(e[0][0] + e[1][0]) / 2, (e[0][1] + e[1][1]) / 2}
+		var locations [2]int
+		for i := range r.args {
+			locations[i] = r.locateByKind(i, kind[i], mid, false)
+		}
+		r.IM.SetAtLeast(locations[0], locations[1], calc.ImL)
+	}
+	for _, p := range r.nodes(edges) {
+		var locations [2]int
+		for i := range r.args {
+			locations[i] = r.locateByKind(i, r.vertices[i][vertexKey(p)], p, true)
+		}
+		r.IM.SetAtLeast(locations[0], locations[1], calc.ImP)
+	}
+	return r.IM, nil
+}
+
+// computeAreas sets the entries of dimension 2, from the areas of the overlay of the polygons of the geometries.
+func (r *ruleRelationship) computeAreas() error {
+	polygons := [2]matrix.MultiPolygonMatrix{polygonal(r.args[0]), polygonal(r.args[1])}
+	areas := [2]float64{measure.AreaOfMultiPolygon(polygons[0]), measure.AreaOfMultiPolygon(polygons[1])}
+	if areas[0] > 0 && areas[1] > 0 {
+		a, err := area(snapround.Intersection(polygons[0], polygons[1], 0))
+		if err != nil {
+			return err
+		}
+		if a > 0 {
+			r.IM.Set(calc.ImInterior, calc.ImInterior, calc.ImA)
+		}
+	}
+	for i, location := range []int{calc.ImInterior, calc.ImExterior} {
+		other := 1 - i
+		if areas[i] == 0 {
+			continue
+		}
+		if areas[other] > 0 {
+			a, err := area(snapround.Difference(polygons[i], polygons[other], 0))
+			if err != nil {
+				return err
+			}
+			if a == 0 {
+				continue
+			}
+		}
+		r.IM.Set(location, calc.ImExterior-location, calc.ImA)
+	}
+	return nil
+}
+
+// locateByKind returns the location of the point in the argument, from the kinds of the linework of the argument through it.
+// The boundary of the lines takes precedence at nodes, edges off the linework are only in the polygons.
+func (r *ruleRelationship) locateByKind(arg, kind int, p matrix.Matrix, isNode bool) int {
+	switch {
+	case isNode && isLineBoundary(r.locators[arg], p):
+		return calc.ImBoundary
+	case kind&lineEdge != 0:
+		return calc.ImInterior
+	case kind&ringEdge != 0:
+		return calc.ImBoundary
+	}
+	if isNode {
+		return r.locators[arg].Locate(p)
+	}
+	location := calc.ImExterior
+	for _, v := range polygonal(r.args[arg]) {
+		switch relate.LocateInPolygon(p, v) {
+		case calc.ImInterior:
+			return calc.ImInterior
+		case calc.ImBoundary:
+			location = calc.ImBoundary
+		}
+	}
+	return location
+}
+
+// nodes returns the vertices of the edges, the points and the boundary points of the lines of the geometries.
+func (r *ruleRelationship) nodes(edges [][]matrix.Matrix) []matrix.Matrix {
+	seen := map[[2]float64]bool{}
+	result := []matrix.Matrix{}
+	add := func(p matrix.Matrix) {
+		if key := vertexKey(p); !seen[key] {
+			seen[key] = true
+			result = append(result, p)
+		}
+	}
+	for _, e := range edges {
+		add(e[0])
+		add(e[1])
+	}
+	for i, arg := range r.args {
+		for _, p := range points(arg) {
+			add(p)
+		}
+		for _, p := range r.locators[i].Boundary() {
+			add(p)
+		}
+	}
+	return result
+}
+
+// isLineBoundary tests whether the point is in the boundary of the lines located by the locator.
+func isLineBoundary(l *relate.Locator, p matrix.Matrix) bool {
+	for _, v := range l.Boundary() {
+		if v.Equals(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// kindLine a line of the linework of a geometry, with its kind.
+type kindLine struct {
+	line matrix.LineMatrix
+	kind int
+}
+
+// linework returns the lines and the rings of the polygons of the steric.
+func linework(steric matrix.Steric, ring bool) []kindLine {
+	result := []kindLine{}
+	switch s := steric.(type) {
+	case matrix.LineMatrix:
+		if len(s) > 1 {
+			kind := lineEdge
+			if ring {
+				kind = ringEdge
+			}
+			result = append(result, kindLine{s, kind})
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range s {
+			result = append(result, linework(matrix.LineMatrix(v), true)...)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range s {
+			result = append(result, linework(matrix.PolygonMatrix(v), true)...)
+		}
+	case matrix.Collection:
+		for _, v := range s {
+			result = append(result, linework(v, false)...)
+		}
+	}
+	return result
+}
+
+// hasLines tests whether the steric has lines, the rings of polygons left out.
+func hasLines(steric matrix.Steric) bool {
+	for _, v := range linework(steric, false) {
+		if v.kind == lineEdge {
+			return true
+		}
+	}
+	return false
+}
+
+// points returns the points of the steric.
+func points(steric matrix.Steric) []matrix.Matrix {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		if len(s) > 1 {
+			return []matrix.Matrix{s}
+		}
+	case matrix.Collection:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, points(v)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// polygonal returns the polygons of the steric.
+func polygonal(steric matrix.Steric) matrix.MultiPolygonMatrix {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		if len(s) > 0 {
+			return matrix.MultiPolygonMatrix{s}
+		}
+	case matrix.MultiPolygonMatrix:
+		return s
+	case matrix.Collection:
+		result := matrix.MultiPolygonMatrix{}
+		for _, v := range s {
+			result = append(result, polygonal(v)...)
+		}
+		return result
+	}
+	return matrix.MultiPolygonMatrix{}
+}
+
+// area returns the area of the polygons of an overlay result, the error if the overlay failed.
+func area(result matrix.Steric, err error) (float64, error) {
+	if err != nil {
+		return 0, err
+	}
+	if result == nil {
+		return 0, nil
+	}
+	return measure.AreaOfMultiPolygon(polygonal(result)), nil
+}
+
+// edgeKey returns the key of the segment, the same in both directions.
+func edgeKey(a, b matrix.Matrix) [4]float64 {
+	if a[0] > b[0] || (a[0] == b[0] && a[1] > b[1]) {
+		a, b = b, a
+	}
+	return [4]float64{a[0], a[1], b[0], b[1]}
+}
+
+// vertexKey returns the key of the point.
+func vertexKey(p matrix.Matrix) [2]float64 {
+	return [2]float64{p[0], p[1]}
+}
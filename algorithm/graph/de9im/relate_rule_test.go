@@ -0,0 +1,82 @@
+package de9im
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+func TestRelateWithRule(t *testing.T) {
+	network := matrix.Collection{matrix.LineMatrix{{0, 0}, {1, 0}}, matrix.LineMatrix{{1, 0}, {2, 0}}}
+	ring := matrix.LineMatrix{{0, 0}, {1, 0}, {1, 1}, {0, 0}}
+	tests := []struct {
+		name   string
+		m0, m1 matrix.Steric
+		rule   relate.BoundaryNodeRule
+		want   string
+	}{
+		{"node mod2", matrix.Matrix{1, 0}, network, relate.Mod2, "0FFFFF102"},
+		{"node endpoint", matrix.Matrix{1, 0}, network, relate.EndPoint, "F0FFFF102"},
+		{"node multivalent", matrix.Matrix{1, 0}, network, relate.MultivalentEndPoint, "F0FFFF1F2"},
+		{"node monovalent", matrix.Matrix{1, 0}, network, relate.MonovalentEndPoint, "0FFFFF102"},
+		{"ring mod2", matrix.Matrix{0, 0}, ring, relate.Mod2, "0FFFFF1F2"},
+		{"ring endpoint", matrix.Matrix{0, 0}, ring, relate.EndPoint, "F0FFFF1F2"},
+		{"lines endpoint", matrix.LineMatrix{{0, 0}, {1, 0}}, matrix.LineMatrix{{1, 0}, {2, 0}}, relate.EndPoint, "FF1F00102"},
+		{"ring line mod2", ring, matrix.LineMatrix{{0, 0}, {-1, 0}}, relate.Mod2, "F01FFF102"},
+		{"ring line endpoint", ring, matrix.LineMatrix{{0, 0}, {-1, 0}}, relate.EndPoint, "FF1F0F102"},
+		{"line polygon endpoint", matrix.LineMatrix{{-1, 0.5}, {0.5, 0.5}, {0.5, 2}},
+			matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, relate.EndPoint, "101FF0212"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RelateWithRule(tt.m0, tt.m1, tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("RelateWithRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIMWithRule(t *testing.T) {
+	polygon := matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	tests := []struct {
+		name   string
+		m0, m1 matrix.Steric
+		want   string
+	}{
+		{"line crosses polygon", matrix.LineMatrix{{-1, 1}, {3, 1}}, polygon, "101FF0212"},
+		{"line in polygon", matrix.LineMatrix{{0.5, 1}, {1.5, 1}}, polygon, "1FF0FF212"},
+		{"line on boundary", matrix.LineMatrix{{0, 0}, {2, 0}}, polygon, "F1FF0F212"},
+		{"lines cross", matrix.LineMatrix{{0, 0}, {2, 2}}, matrix.LineMatrix{{0, 2}, {2, 0}}, "0F1FF0102"},
+		{"lines overlap", matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{1, 0}, {3, 0}}, "1010F0102"},
+		{"point on line", matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {2, 2}}, "0FFFFF102"},
+		{"polygon line", polygon, matrix.LineMatrix{{1, 1}, {1, 3}}, "1020F1102"},
+		{"collection", matrix.Collection{matrix.Matrix{5, 5}, polygon}, matrix.LineMatrix{{5, 5}, {5, 6}}, "F02FF1102"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IMWithRule(tt.m0, tt.m1, relate.Mod2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ToString() != tt.want {
+				t.Errorf("IMWithRule() = %v, want %v", got.ToString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestIMWithRule_overlayError(t *testing.T) {
+	unclosed := matrix.Collection{matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}}}, matrix.LineMatrix{{5, 5}, {6, 6}}}
+	polygon := matrix.PolygonMatrix{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}}
+	if got, err := IMWithRule(unclosed, polygon, relate.EndPoint); err == nil {
+		t.Errorf("IMWithRule() = %v, want error", got.ToString())
+	}
+	if got, err := RelateWithRule(unclosed, polygon, relate.EndPoint); err == nil {
+		t.Errorf("RelateWithRule() = %v, want error", got)
+	}
+}
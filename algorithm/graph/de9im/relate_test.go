@@ -5,6 +5,7 @@ import (
 
 	"github.com/spatial-go/geoos"
 	"github.com/spatial-go/geoos/algorithm/graph/graphtests"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 )
 
@@ -31,3 +32,24 @@ func TestRelate(t *testing.T) {
 		})
 	}
 }
+
+func TestRelate_Empty(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name   string
+		m0, m1 matrix.Steric
+		want   string
+	}{
+		{"empty polygon", matrix.PolygonMatrix{}, square, "FFFFFF212"},
+		{"polygon empty", square, matrix.PolygonMatrix{}, "FF2FF1FF2"},
+		{"line empty", matrix.LineMatrix{{0, 0}, {1, 1}}, matrix.Collection{}, "FF1FF0FF2"},
+		{"empty empty", matrix.Collection{}, matrix.PolygonMatrix{}, "FFFFFFFF2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Relate(tt.m0, tt.m1); got != tt.want {
+				t.Errorf("Relate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
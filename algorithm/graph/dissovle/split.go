@@ -0,0 +1,139 @@
+package dissovle
+
+import (
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/noding"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// Split splits a geometry by a blade, returns a collection of the parts.
+// Polygons are split by lineal or polygonal blades, lines are split by puntal, lineal or polygonal blades,
+// the components of multi geometries are split one by one.
+// Parts which are not touched by the blade are returned unchanged.
+func Split(geom, blade matrix.Steric) (matrix.Collection, error) {
+	if geom == nil || blade == nil {
+		return nil, algorithm.ErrNilSteric
+	}
+	points, lines := bladeComponents(blade)
+	return split(geom, points, lines)
+}
+
+func split(geom matrix.Steric, points []matrix.Matrix, lines []matrix.LineMatrix) (matrix.Collection, error) {
+	switch g := geom.(type) {
+	case matrix.Matrix:
+		return matrix.Collection{g}, nil
+	case matrix.LineMatrix:
+		return SplitLine(g, points, lines), nil
+	case matrix.PolygonMatrix:
+		return SplitPolygon(g, lines), nil
+	case matrix.MultiPolygonMatrix:
+		result := matrix.Collection{}
+		for _, v := range g {
+			result = append(result, SplitPolygon(v, lines)...)
+		}
+		return result, nil
+	case matrix.Collection:
+		result := matrix.Collection{}
+		for _, v := range g {
+			parts, err := split(v, points, lines)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, parts...)
+		}
+		return result, nil
+	default:
+		return nil, algorithm.ErrUnknownType(geom)
+	}
+}
+
+// bladeComponents returns the points and the lines of the blade, polygons contribute their rings.
+func bladeComponents(blade matrix.Steric) (points []matrix.Matrix, lines []matrix.LineMatrix) {
+	switch b := blade.(type) {
+	case matrix.Matrix:
+		points = append(points, b)
+	case matrix.LineMatrix:
+		lines = append(lines, b)
+	case matrix.PolygonMatrix:
+		for _, v := range b {
+			lines = append(lines, v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, poly := range b {
+			for _, v := range poly {
+				lines = append(lines, v)
+			}
+		}
+	case matrix.Collection:
+		for _, v := range b {
+			p, l := bladeComponents(v)
+			points, lines = append(points, p...), append(lines, l...)
+		}
+	}
+	return
+}
+
+// SplitPolygon splits the polygon by the lines, returns the polygons into which it is divided.
+// Blade portions outside the polygon and dangling blade ends inside it are ignored.
+func SplitPolygon(poly matrix.PolygonMatrix, lines []matrix.LineMatrix) matrix.Collection {
+	if poly.IsEmpty() || len(lines) == 0 {
+		return matrix.Collection{poly}
+	}
+	bound := poly.Bound()
+	linework := []matrix.LineMatrix{}
+	for _, ring := range poly {
+		linework = append(linework, ring)
+	}
+	for _, line := range lines {
+		if line.Bound().IntersectsBound(bound) {
+			linework = append(linework, line)
+		}
+	}
+	if len(linework) == len(poly) {
+		return matrix.Collection{poly}
+	}
+	result := matrix.Collection{}
+	for _, face := range polygonize.Polygonize(noding.Node(linework, 0)...) {
+		ip := polygonize.InteriorPoint(face)
+		if ip == nil || relate.LocateInPolygon(ip, poly) != calc.ImInterior {
+			continue
+		}
+		result = append(result, face)
+	}
+	if len(result) <= 1 {
+		return matrix.Collection{poly}
+	}
+	return result
+}
+
+// SplitLine splits the line at the points lying on it and at its intersections with the lines,
+// returns the lines into which it is divided.
+func SplitLine(line matrix.LineMatrix, points []matrix.Matrix, lines []matrix.LineMatrix) matrix.Collection {
+	nodes := []noding.SegmentNode{}
+	for i := 0; i < len(line)-1; i++ {
+		for _, pt := range points {
+			if noding.PointOnSegment(line[i], line[i+1], pt) {
+				nodes = append(nodes, noding.SegmentNode{Point: pt, Segment: i})
+			}
+		}
+	}
+	for _, v := range noding.Intersections(append([]matrix.LineMatrix{line}, lines...)...) {
+		switch {
+		case v.Line0 == 0 && v.Line1 != 0:
+			nodes = append(nodes, noding.SegmentNode{Point: v.Point, Segment: v.Segment0})
+		case v.Line1 == 0 && v.Line0 != 0:
+			nodes = append(nodes, noding.SegmentNode{Point: v.Point, Segment: v.Segment1})
+		}
+	}
+	result := matrix.Collection{}
+	for _, part := range noding.SplitLine(line, nodes) {
+		result = append(result, part)
+	}
+	if len(result) == 0 {
+		return matrix.Collection{line}
+	}
+	return result
+}
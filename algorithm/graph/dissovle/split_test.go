@@ -0,0 +1,71 @@
+package dissovle
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestSplit(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	squareHole := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	type args struct {
+		geom  matrix.Steric
+		blade matrix.Steric
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantParts int
+		wantErr   bool
+	}{
+		{"polygon by line", args{square, matrix.LineMatrix{{-1, 5}, {11, 5}}}, 2, false},
+		{"polygon by diagonal", args{square, matrix.LineMatrix{{-1, -1}, {11, 11}}}, 2, false},
+		{"polygon by dangling line", args{square, matrix.LineMatrix{{-1, 5}, {5, 5}}}, 1, false},
+		{"polygon by outside line", args{square, matrix.LineMatrix{{-1, 20}, {11, 20}}}, 1, false},
+		{"polygon by boundary line", args{square, matrix.LineMatrix{{0, -1}, {0, 11}}}, 1, false},
+		{"polygon with hole by line", args{squareHole, matrix.LineMatrix{{5, -1}, {5, 11}}}, 2, false},
+		{"polygon with hole by line beside hole", args{squareHole, matrix.LineMatrix{{-1, 1}, {11, 1}}}, 2, false},
+		{"polygon by cross", args{square, matrix.Collection{
+			matrix.LineMatrix{{5, -1}, {5, 11}}, matrix.LineMatrix{{-1, 5}, {11, 5}}}}, 4, false},
+		{"polygon by polygon", args{square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}, 2, false},
+		{"polygon by point", args{square, matrix.Matrix{5, 5}}, 1, false},
+		{"multipolygon by line", args{matrix.Collection{square,
+			matrix.PolygonMatrix{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}}}, matrix.LineMatrix{{-1, 5}, {31, 5}}}, 4, false},
+		{"line by points", args{line, matrix.Collection{matrix.Matrix{5, 0}, matrix.Matrix{10, 0}, matrix.Matrix{20, 20}}}, 3, false},
+		{"line by line", args{line, matrix.LineMatrix{{5, -5}, {5, 5}, {15, 5}}}, 3, false},
+		{"line by collinear line", args{line, matrix.LineMatrix{{2, 0}, {4, 0}}}, 3, false},
+		{"line by polygon", args{line, matrix.PolygonMatrix{{{5, -5}, {15, -5}, {15, 5}, {5, 5}, {5, -5}}}}, 3, false},
+		{"point", args{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {2, 2}}}, 1, false},
+		{"nil blade", args{square, nil}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Split(tt.args.geom, tt.args.blade)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Split() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantParts {
+				t.Errorf("Split() = %v, want %v parts", got, tt.wantParts)
+			}
+		})
+	}
+}
+
+func TestSplitPolygon_Area(t *testing.T) {
+	poly := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	parts := SplitPolygon(poly, []matrix.LineMatrix{{{-1, 2}, {11, 2}}, {{3, -1}, {3, 11}}})
+	if len(parts) != 4 {
+		t.Fatalf("SplitPolygon() = %v, want 4 parts", parts)
+	}
+	area := 0.0
+	for _, v := range parts {
+		area += measure.AreaOfPolygon(v.(matrix.PolygonMatrix))
+	}
+	if area != measure.AreaOfPolygon(poly) {
+		t.Errorf("SplitPolygon() area = %v, want %v", area, measure.AreaOfPolygon(poly))
+	}
+}
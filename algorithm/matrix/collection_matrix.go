@@ -63,15 +63,21 @@ func (c Collection) Bound() Bound {
 	if len(c) == 0 {
 		return []Matrix{}
 	}
-	b := c[0].Bound()
-	for i := 1; i < len(c); i++ {
-		bound := c[1].Bound()
+	b := Bound{}
+	for _, v := range c {
+		bound := v.Bound()
+		if bound.IsEmpty() {
+			continue
+		}
+		if len(b) == 0 {
+			b = Bound{{bound[0][0], bound[0][1]}, {bound[1][0], bound[1][1]}}
+			continue
+		}
 		b[0][0] = math.Min(b[0][0], bound[0][0])
 		b[0][1] = math.Min(b[0][1], bound[0][1])
-		b[1][0] = math.Min(b[1][0], bound[1][0])
-		b[1][1] = math.Min(b[1][1], bound[1][1])
+		b[1][0] = math.Max(b[1][0], bound[1][0])
+		b[1][1] = math.Max(b[1][1], bound[1][1])
 	}
-
 	return b
 }
 
@@ -146,6 +152,7 @@ func (c Collection) Filter(f Filter) Steric {
 	if f.IsChanged() {
 		mc := c[:0]
 		for _, v := range c {
+			f.Clear()
 			g := v.Filter(f)
 			mc = append(mc, g)
 		}
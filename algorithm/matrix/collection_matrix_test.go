@@ -103,7 +103,8 @@ func TestCollection_Bound(t *testing.T) {
 		c    Collection
 		want Bound
 	}{
-		// TODO: Add test cases.
+		{"points", Collection{Matrix{1, 1}, Matrix{-2, 3}, Matrix{4, -5}}, Bound{{-2, -5}, {4, 3}}},
+		{"lines", Collection{LineMatrix{{-1, -1}, {-3, -2}}, LineMatrix{{5, 6}, {7, 8}}}, Bound{{-3, -2}, {7, 8}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -188,7 +189,10 @@ func TestCollection_Filter(t *testing.T) {
 		args args
 		want Steric
 	}{
-		// TODO: Add test cases.
+		{"lines", Collection{LineMatrix{{1, 1}, {2, 2}, {2, 2}}, LineMatrix{{1, 1}, {3, 3}}},
+			args{&UniqueArrayFilter{}}, Collection{LineMatrix{{1, 1}, {2, 2}}, LineMatrix{{1, 1}, {3, 3}}}},
+		{"points not changed", Collection{Matrix{1, 1}, Matrix{1, 1}, Matrix{2, 2}},
+			args{&UniqueArrayFilter{IsNotChange: true}}, Collection{Matrix{1, 1}, Matrix{1, 1}, Matrix{2, 2}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2,6 +2,7 @@ package matrix
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/calc"
@@ -178,6 +179,11 @@ func (im *IntersectionMatrix) Matches(pattern string) (bool, error) {
 	if len(pattern) != 9 {
 		return false, algorithm.ErrorShouldBeLength9(pattern)
 	}
+	for i := 0; i < len(pattern); i++ {
+		if !strings.ContainsRune("TF*012", rune(pattern[i])) {
+			return false, algorithm.ErrorUnknownDimension(string(pattern[i]))
+		}
+	}
 	for ai := 0; ai < 3; ai++ {
 		for bi := 0; bi < 3; bi++ {
 			if !matches(im.matrix[ai][bi], pattern[3*ai+bi]) {
@@ -302,6 +302,16 @@ func TestIntersectionMatrix_Matches(t *testing.T) {
 				{1, -1, -1},
 				{1, -1, -1}}}, args{"TFTTFFTFF"}, true, false,
 		},
+		{"case2", &IntersectionMatrix{
+			[][]int{{1, -1, 1},
+				{1, -1, -1},
+				{1, -1, -1}}}, args{"T*F**F***"}, false, false,
+		},
+		{"case3", &IntersectionMatrix{
+			[][]int{{1, -1, 1},
+				{1, -1, -1},
+				{1, -1, -1}}}, args{"TFTTFFTFX"}, false, true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
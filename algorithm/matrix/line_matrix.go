@@ -60,7 +60,7 @@ func (l LineMatrix) Bound() Bound {
 		return []Matrix{}
 	}
 
-	b := []Matrix{{math.MaxFloat64, math.MaxFloat64}, {0, 0}}
+	b := []Matrix{{math.MaxFloat64, math.MaxFloat64}, {-math.MaxFloat64, -math.MaxFloat64}}
 	for _, p := range l {
 		b[0][0] = math.Min(b[0][0], p[0])
 		b[0][1] = math.Min(b[0][1], p[1])
@@ -294,6 +294,16 @@ func TestSteric_Filter(t *testing.T) {
 		}}, args{&UniqueArrayFilter{}},
 			PolygonMatrix{{{0, 0}, {0, 5}, {5, 5}, {5, 0}, {0, 0}}},
 		},
+		{"poly hole", PolygonMatrix{
+			{{0, 0}, {0, 5}, {5, 5}, {5, 0}, {0, 0}},
+			{{1, 1}, {2, 1}, {2, 2}, {2, 2}, {1, 1}},
+		}, args{&UniqueArrayFilter{}},
+			PolygonMatrix{
+				{{0, 0}, {0, 5}, {5, 5}, {5, 0}, {0, 0}},
+				{{1, 1}, {2, 1}, {2, 2}, {1, 1}},
+			},
+		},
+		{"poly empty ring", PolygonMatrix{{}}, args{&UniqueArrayFilter{}}, PolygonMatrix{}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
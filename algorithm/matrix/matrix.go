@@ -234,11 +234,15 @@ func (m Matrix) EqualsExact(ms Steric, tolerance float64) bool {
 
 // Filter Performs an operation with the provided .
 func (m Matrix) Filter(f Filter) Steric {
+	f.Filter(m)
 	return m
 }
 
 // String ...
 func (m Matrix) String() string {
+	if len(m) < 2 {
+		return "{}"
+	}
 	return fmt.Sprintf("{%v,%v}", m[0], m[1])
 }
 
@@ -49,15 +49,21 @@ func (m MultiPolygonMatrix) Bound() Bound {
 	if len(m) == 0 {
 		return []Matrix{}
 	}
-	b := PolygonMatrix(m[0]).Bound()
-	for i := 1; i < len(m); i++ {
-		bound := PolygonMatrix(m[i]).Bound()
+	b := Bound{}
+	for _, v := range m {
+		bound := PolygonMatrix(v).Bound()
+		if bound.IsEmpty() {
+			continue
+		}
+		if len(b) == 0 {
+			b = Bound{{bound[0][0], bound[0][1]}, {bound[1][0], bound[1][1]}}
+			continue
+		}
 		b[0][0] = math.Min(b[0][0], bound[0][0])
 		b[0][1] = math.Min(b[0][1], bound[0][1])
-		b[1][0] = math.Min(b[1][0], bound[1][0])
-		b[1][1] = math.Min(b[1][1], bound[1][1])
+		b[1][0] = math.Max(b[1][0], bound[1][0])
+		b[1][1] = math.Max(b[1][1], bound[1][1])
 	}
-
 	return b
 }
 
@@ -132,6 +138,7 @@ func (m MultiPolygonMatrix) Filter(f Filter) Steric {
 	if f.IsChanged() {
 		mPoly := m[:0]
 		for _, v := range m {
+			f.Clear()
 			p := PolygonMatrix(v).Filter(f)
 			mPoly = append(mPoly, p.(PolygonMatrix))
 		}
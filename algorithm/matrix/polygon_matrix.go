@@ -1,7 +1,11 @@
 // Package matrix Define spatial matrix base.
 package matrix
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/spatial-go/geoos/algorithm"
+)
 
 // PolygonMatrix is a three-dimensional matrix.
 type PolygonMatrix [][][]float64
@@ -39,7 +43,7 @@ func (p PolygonMatrix) Nums() int {
 
 // IsEmpty returns true if the Matrix is empty.
 func (p PolygonMatrix) IsEmpty() bool {
-	return len(p) == 0
+	return len(p) == 0 || len(p[0]) == 0
 }
 
 // Bound returns a bound around the polygon.
@@ -121,7 +125,11 @@ func (p PolygonMatrix) Filter(f Filter) Steric {
 	if f.IsChanged() {
 		poly := PolygonMatrix{}
 		for _, v := range p {
+			f.Clear()
 			r := LineMatrix(v).Filter(f).(LineMatrix)
+			if len(r) == 0 {
+				continue
+			}
 			if !Matrix(r[len(r)-1]).Equals(Matrix(r[0])) {
 				r = append(r, r[0])
 			}
@@ -135,6 +143,29 @@ func (p PolygonMatrix) Filter(f Filter) Steric {
 	return p
 }
 
+// ValidRings returns a TopologyError if a ring of the polygon is not closed or does not bound an area.
+func (p PolygonMatrix) ValidRings() error {
+	for _, ring := range p {
+		if len(ring) == 0 {
+			return &algorithm.TopologyError{Msg: "Ring is empty"}
+		}
+		if !Matrix(ring[0]).Equals(Matrix(ring[len(ring)-1])) {
+			return &algorithm.TopologyError{Msg: "Ring is not closed", Location: ring[0]}
+		}
+		if len(ring) < 4 {
+			return &algorithm.TopologyError{Msg: "Ring has too few points", Location: ring[0]}
+		}
+		area := 0.0
+		for i := 1; i < len(ring); i++ {
+			area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+		}
+		if area == 0 {
+			return &algorithm.TopologyError{Msg: "Ring is collapsed", Location: ring[0]}
+		}
+	}
+	return nil
+}
+
 // IsRectangle returns true if  the polygon is rectangle.
 func (p PolygonMatrix) IsRectangle() bool {
 
@@ -2,8 +2,11 @@
 package matrix
 
 import (
+	"errors"
 	"reflect"
 	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
 )
 
 func TestPolygonMatrix_Bound(t *testing.T) {
@@ -38,3 +41,33 @@ func TestPolygonMatrix_IsRectangle(t *testing.T) {
 		})
 	}
 }
+
+func TestPolygonMatrix_ValidRings(t *testing.T) {
+	tests := []struct {
+		name         string
+		p            PolygonMatrix
+		wantErr      bool
+		wantLocation []float64
+	}{
+		{name: "valid", p: PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		{name: "valid hole", p: PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, {{5, 2}, {6, 2}, {6, 3}, {5, 2}}}},
+		{name: "empty ring", p: PolygonMatrix{{}}, wantErr: true},
+		{name: "not closed", p: PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}}, wantErr: true, wantLocation: []float64{0, 0}},
+		{name: "too few points", p: PolygonMatrix{{{1, 0}, {1, 0}}}, wantErr: true, wantLocation: []float64{1, 0}},
+		{name: "collapsed", p: PolygonMatrix{{{0, 1}, {0, 1}, {0, 1}, {0, 1}}}, wantErr: true, wantLocation: []float64{0, 1}},
+		{name: "collapsed hole", p: PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, {{5, 2}, {6, 2}, {7, 2}, {5, 2}}},
+			wantErr: true, wantLocation: []float64{5, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.p.ValidRings()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PolygonMatrix.ValidRings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var topoErr *algorithm.TopologyError
+			if err != nil && (!errors.As(err, &topoErr) || !reflect.DeepEqual(topoErr.Location, tt.wantLocation)) {
+				t.Errorf("PolygonMatrix.ValidRings() error = %#v, want location %v", err, tt.wantLocation)
+			}
+		})
+	}
+}
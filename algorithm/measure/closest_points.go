@@ -0,0 +1,126 @@
+package measure
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/coordtransform"
+)
+
+// ClosestPoints returns the nearest points of the sterics, the first on from and the second on to,
+// nil if either is empty. Sterics which intersect return a point of their intersection twice.
+func ClosestPoints(fromSteric, toSteric matrix.Steric) []matrix.Matrix {
+	if isEmpty(fromSteric) || isEmpty(toSteric) {
+		return nil
+	}
+	if p, ok := pointInPolygons(fromSteric, toSteric); ok {
+		return []matrix.Matrix{p, p}
+	}
+	if p, ok := pointInPolygons(toSteric, fromSteric); ok {
+		return []matrix.Matrix{p, p}
+	}
+	from, to := segments(fromSteric), segments(toSteric)
+	sort.Slice(to, func(i, j int) bool { return to[i].env.MinX < to[j].env.MinX })
+	dist := math.MaxFloat64
+	var result []matrix.Matrix
+	for _, s0 := range from {
+		for _, s1 := range to {
+			if s1.env.MinX > s0.env.MaxX+dist {
+				break
+			}
+			if s0.env.Distance(s1.env) > dist {
+				continue
+			}
+			if p, q := closestPointsSegments(s0.a, s0.b, s1.a, s1.b); PlanarDistance(p, q) < dist {
+				result, dist = []matrix.Matrix{p, q}, PlanarDistance(p, q)
+				if dist == 0 {
+					return result
+				}
+			}
+		}
+	}
+	return result
+}
+
+// FarthestPoints returns the vertices of the sterics farthest apart, the first of from and the second of to,
+// nil if either is empty.
+func FarthestPoints(fromSteric, toSteric matrix.Steric) []matrix.Matrix {
+	return farthestPoints(fromSteric, toSteric, PlanarDistance)
+}
+
+// SpheroidClosestPoints returns the nearest points of the sterics in longitude and latitude,
+// found as SpheroidDistance in the Mercator projection.
+func SpheroidClosestPoints(fromSteric, toSteric matrix.Steric) []matrix.Matrix {
+	trans := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
+	from, _ := trans.TransformGeometry(copySteric(fromSteric))
+	to, _ := trans.TransformGeometry(copySteric(toSteric))
+	result := ClosestPoints(from, to)
+	trans = coordtransform.NewTransformer(coordtransform.MERCATORTOLL)
+	for i, v := range result {
+		result[i] = trans.TransformPoint(v)
+	}
+	return result
+}
+
+// SpheroidFarthestPoints returns the vertices of the sterics farthest apart by spheroid distance.
+func SpheroidFarthestPoints(fromSteric, toSteric matrix.Steric) []matrix.Matrix {
+	return farthestPoints(fromSteric, toSteric, SpheroidDistance)
+}
+
+// farthestPoints returns the vertices of the sterics farthest apart by the distance func.
+func farthestPoints(fromSteric, toSteric matrix.Steric, f DistanceFunc) []matrix.Matrix {
+	if isEmpty(fromSteric) || isEmpty(toSteric) {
+		return nil
+	}
+	dist := -1.0
+	var result []matrix.Matrix
+	to := vertices(toSteric)
+	for _, p := range vertices(fromSteric) {
+		for _, q := range to {
+			if d := f(p, q); d > dist {
+				result, dist = []matrix.Matrix{p, q}, d
+			}
+		}
+	}
+	return result
+}
+
+// closestPointsSegments returns the nearest points of the segments a0-a1 and b0-b1.
+func closestPointsSegments(a0, a1, b0, b1 matrix.Matrix) (matrix.Matrix, matrix.Matrix) {
+	if orientation(a0, a1, b0)*orientation(a0, a1, b1) < 0 && orientation(b0, b1, a0)*orientation(b0, b1, a1) < 0 {
+		d := (a1[0]-a0[0])*(b1[1]-b0[1]) - (a1[1]-a0[1])*(b1[0]-b0[0])
+		t := ((b0[0]-a0[0])*(b1[1]-b0[1]) - (b0[1]-a0[1])*(b1[0]-b0[0])) / d
+		p := matrix.Matrix{a0[0] + t*(a1[0]-a0[0]), a0[1] + t*(a1[1]-a0[1])}
+		return p, p
+	}
+	pairs := [][]matrix.Matrix{
+		{a0, ClosestPoint(a0, b0, b1)}, {a1, ClosestPoint(a1, b0, b1)},
+		{ClosestPoint(b0, a0, a1), b0}, {ClosestPoint(b1, a0, a1), b1},
+	}
+	best := pairs[0]
+	for _, v := range pairs[1:] {
+		if PlanarDistance(v[0], v[1]) < PlanarDistance(best[0], best[1]) {
+			best = v
+		}
+	}
+	return best[0], best[1]
+}
+
+// pointInPolygons returns the first vertex of a part of the steric in a polygon of the other.
+func pointInPolygons(steric, other matrix.Steric) (matrix.Matrix, bool) {
+	polys := polygons(other)
+	if len(polys) == 0 {
+		return nil, false
+	}
+	for _, p := range firstVertices(steric) {
+		for _, polygon := range polys {
+			if relate.LocateInPolygon(p, polygon) != calc.ImExterior {
+				return p, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,58 @@
+package measure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestClosestPoints(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name        string
+		from, to    matrix.Steric
+		want        []matrix.Matrix
+		wantFarther []matrix.Matrix
+	}{
+		{"points", matrix.Matrix{0, 0}, matrix.Matrix{3, 4}, []matrix.Matrix{{0, 0}, {3, 4}}, []matrix.Matrix{{0, 0}, {3, 4}}},
+		{"point line", matrix.Matrix{5, 3}, matrix.LineMatrix{{0, 0}, {10, 0}},
+			[]matrix.Matrix{{5, 3}, {5, 0}}, []matrix.Matrix{{5, 3}, {0, 0}}},
+		{"lines cross", matrix.LineMatrix{{0, -1}, {2, 1}}, matrix.LineMatrix{{0, 1}, {2, -1}},
+			[]matrix.Matrix{{1, 0}, {1, 0}}, []matrix.Matrix{{0, -1}, {0, 1}}},
+		{"lines", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{4, 5}, {6, 2}},
+			[]matrix.Matrix{{6, 0}, {6, 2}}, []matrix.Matrix{{10, 0}, {4, 5}}},
+		{"point in polygon", matrix.Matrix{5, 5}, square, []matrix.Matrix{{5, 5}, {5, 5}}, []matrix.Matrix{{5, 5}, {0, 0}}},
+		{"line polygon", matrix.LineMatrix{{12, 2}, {15, 2}}, square,
+			[]matrix.Matrix{{12, 2}, {10, 2}}, []matrix.Matrix{{15, 2}, {0, 10}}},
+		{"collection", matrix.Collection{matrix.Matrix{20, 20}, matrix.Matrix{5, 12}}, square,
+			[]matrix.Matrix{{5, 12}, {5, 10}}, []matrix.Matrix{{20, 20}, {0, 0}}},
+		{"empty", matrix.LineMatrix{}, square, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClosestPoints(tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClosestPoints() = %v, want %v", got, tt.want)
+			}
+			if got := FarthestPoints(tt.from, tt.to); !reflect.DeepEqual(got, tt.wantFarther) {
+				t.Errorf("FarthestPoints() = %v, want %v", got, tt.wantFarther)
+			}
+		})
+	}
+}
+
+func TestSpheroidClosestPoints(t *testing.T) {
+	point := matrix.Matrix{116.4, 39.92}
+	line := matrix.LineMatrix{{116.39, 39.91}, {116.41, 39.91}}
+	got := SpheroidClosestPoints(point, line)
+	if len(got) != 2 || !got[0].EqualsExact(point, 1e-9) || !got[1].EqualsExact(matrix.Matrix{116.4, 39.91}, 1e-9) {
+		t.Errorf("SpheroidClosestPoints() = %v", got)
+	}
+	if line[0][0] != 116.39 {
+		t.Errorf("SpheroidClosestPoints() changed %v", line)
+	}
+	got = SpheroidFarthestPoints(point, line)
+	if !reflect.DeepEqual(got, []matrix.Matrix{point, {116.39, 39.91}}) && !reflect.DeepEqual(got, []matrix.Matrix{point, {116.41, 39.91}}) {
+		t.Errorf("SpheroidFarthestPoints() = %v", got)
+	}
+}
@@ -51,8 +51,8 @@ func SpheroidDistance(fromSteric, toSteric matrix.Steric) float64 {
 		}
 	}
 	trans := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
-	from, _ := trans.TransformGeometry(fromSteric)
-	to, _ := trans.TransformGeometry(toSteric)
+	from, _ := trans.TransformGeometry(copySteric(fromSteric))
+	to, _ := trans.TransformGeometry(copySteric(toSteric))
 	locMatrix := []matrix.Matrix{{0, 0}, {0, 0}}
 	dist := distanceCompute(from, to, locMatrix)
 
@@ -63,6 +63,39 @@ func SpheroidDistance(fromSteric, toSteric matrix.Steric) float64 {
 	return dist / k
 }
 
+// copySteric returns a copy of the steric, the transformer changing the coordinates in place.
+func copySteric(steric matrix.Steric) matrix.Steric {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		return append(matrix.Matrix{}, s...)
+	case matrix.LineMatrix:
+		line := make(matrix.LineMatrix, len(s))
+		for i, v := range s {
+			line[i] = append([]float64{}, v...)
+		}
+		return line
+	case matrix.PolygonMatrix:
+		polygon := make(matrix.PolygonMatrix, len(s))
+		for i, v := range s {
+			polygon[i] = copySteric(matrix.LineMatrix(v)).(matrix.LineMatrix)
+		}
+		return polygon
+	case matrix.MultiPolygonMatrix:
+		collection := matrix.Collection{}
+		for _, v := range s {
+			collection = append(collection, copySteric(matrix.PolygonMatrix(v)))
+		}
+		return collection
+	case matrix.Collection:
+		collection := make(matrix.Collection, len(s))
+		for i, v := range s {
+			collection[i] = copySteric(v)
+		}
+		return collection
+	}
+	return steric
+}
+
 // PlanarDistance returns Distance of form to.
 func PlanarDistance(fromSteric, toSteric matrix.Steric) float64 {
 	locMatrix := []matrix.Matrix{{0, 0}, {0, 0}}
@@ -0,0 +1,110 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// FrechetDistance An algorithm for computing the discrete Fréchet distance,
+// the Hausdorff distance taking the order of the vertices into account,
+// such as the similarity of two tracks.
+type FrechetDistance struct{}
+
+// Distance returns the discrete Fréchet distance between the vertices of the geometries.
+func (f *FrechetDistance) Distance(g0, g1 matrix.Steric) float64 {
+	return frechet(densify(g0, 0), densify(g1, 0))
+}
+
+// DistanceDensifyFrac returns the discrete Fréchet distance, each segment being split
+// into segments of the fraction of its length.
+func (f *FrechetDistance) DistanceDensifyFrac(g0, g1 matrix.Steric, densifyFrac float64) (float64, error) {
+	if densifyFrac > 1.0 || densifyFrac <= 0.0 {
+		return 0, algorithm.ErrWrongFractionRange
+	}
+	return frechet(densify(g0, densifyFrac), densify(g1, densifyFrac)), nil
+}
+
+// DTWDistance returns the dynamic time warping distance between the vertices of the geometries,
+// the least sum of the distances of the pairs of vertices matched in order.
+func DTWDistance(g0, g1 matrix.Steric) float64 {
+	return warp(matrix.TransMatrixes(g0), matrix.TransMatrixes(g1), func(d, prev float64) float64 {
+		return d + prev
+	})
+}
+
+// frechet returns the discrete Fréchet distance between the sequences of points.
+func frechet(p, q []matrix.Matrix) float64 {
+	return warp(p, q, math.Max)
+}
+
+// warp returns the cost of coupling the sequences of points in order,
+// the cost of a coupling being found from the distance of its last pair and the least cost before it.
+func warp(p, q []matrix.Matrix, cost func(d, prev float64) float64) float64 {
+	if len(p) == 0 || len(q) == 0 {
+		return 0
+	}
+	prev, curr := make([]float64, len(q)), make([]float64, len(q))
+	for i := range p {
+		for j := range q {
+			d := PlanarDistance(p[i], q[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = cost(d, curr[j-1])
+			case j == 0:
+				curr[j] = cost(d, prev[j])
+			default:
+				curr[j] = cost(d, math.Min(prev[j], math.Min(prev[j-1], curr[j-1])))
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(q)-1]
+}
+
+// densify returns the vertices of the steric in order, each segment of its lines and rings being split
+// into segments of the fraction of its length if densifyFrac is not 0.
+func densify(steric matrix.Steric, densifyFrac float64) []matrix.Matrix {
+	if densifyFrac <= 0 {
+		return matrix.TransMatrixes(steric)
+	}
+	switch s := steric.(type) {
+	case matrix.LineMatrix:
+		if len(s) < 2 {
+			return matrix.TransMatrixes(s)
+		}
+		numSubSegs := int(1.0 / densifyFrac)
+		result := []matrix.Matrix{s[0]}
+		for i := 1; i < len(s); i++ {
+			p0, p1 := s[i-1], s[i]
+			for j := 1; j < numSubSegs; j++ {
+				t := float64(j) / float64(numSubSegs)
+				result = append(result, matrix.Matrix{p0[0] + t*(p1[0]-p0[0]), p0[1] + t*(p1[1]-p0[1])})
+			}
+			result = append(result, p1)
+		}
+		return result
+	case matrix.PolygonMatrix:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, densify(matrix.LineMatrix(v), densifyFrac)...)
+		}
+		return result
+	case matrix.MultiPolygonMatrix:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, densify(matrix.PolygonMatrix(v), densifyFrac)...)
+		}
+		return result
+	case matrix.Collection:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, densify(v, densifyFrac)...)
+		}
+		return result
+	}
+	return matrix.TransMatrixes(steric)
+}
@@ -0,0 +1,48 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestFrechetDistance(t *testing.T) {
+	track := matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}
+	reversed := matrix.LineMatrix{{2, 0}, {1, 0}, {0, 0}}
+	square := [][][]float64{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	tests := []struct {
+		name        string
+		g0, g1      matrix.Steric
+		densifyFrac float64
+		want        float64
+		wantDTW     float64
+	}{
+		{"same", track, track, 0, 0, 0},
+		{"reversed", track, reversed, 0, 2, 4},
+		{"shifted", track, matrix.LineMatrix{{0, 1}, {2, 1}}, 0, math.Sqrt(2), 2 + math.Sqrt(2)},
+		{"sparse", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 1}, {5.5, 1}, {10, 1}}, 0, math.Sqrt(21.25), 2 + math.Sqrt(21.25)},
+		{"densified", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 1}, {5.5, 1}, {10, 1}}, 0.5, math.Sqrt(6.0625), 2 + math.Sqrt(21.25)},
+		{"empty", matrix.LineMatrix{}, track, 0, 0, 0},
+		{"multipolygon densified", matrix.MultiPolygonMatrix{square}, matrix.PolygonMatrix(square), 0.5, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got float64
+			if tt.densifyFrac > 0 {
+				got, _ = (&FrechetDistance{}).DistanceDensifyFrac(tt.g0, tt.g1, tt.densifyFrac)
+			} else {
+				got = (&FrechetDistance{}).Distance(tt.g0, tt.g1)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("FrechetDistance() = %v, want %v", got, tt.want)
+			}
+			if got := DTWDistance(tt.g0, tt.g1); math.Abs(got-tt.wantDTW) > 1e-12 {
+				t.Errorf("DTWDistance() = %v, want %v", got, tt.wantDTW)
+			}
+		})
+	}
+	if _, err := (&FrechetDistance{}).DistanceDensifyFrac(track, track, 1.5); err == nil {
+		t.Errorf("DistanceDensifyFrac() error = nil, want error")
+	}
+}
@@ -16,6 +16,10 @@ type PointPairDistance struct {
 }
 
 func (p *PointPairDistance) setMaximum(ppd *PointPairDistance) {
+	// no point pair was found, e.g. the geometry has only empty parts
+	if ppd.IsNil {
+		return
+	}
 	if p.IsNil {
 		p.Pt = [2]matrix.Matrix{ppd.Pt[0], ppd.Pt[1]}
 		p.Distance = PlanarDistance(ppd.Pt[0], ppd.Pt[1])
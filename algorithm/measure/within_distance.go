@@ -0,0 +1,242 @@
+package measure
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+)
+
+// IsWithinDistance returns true if the distance between the sterics is at most distance.
+// It returns as soon as a pair of segments within the distance, or a part inside a polygon of the other, is found,
+// the segments being pruned by their envelopes.
+func IsWithinDistance(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 || isEmpty(fromSteric) || isEmpty(toSteric) {
+		return false
+	}
+	if envelope.Bound(fromSteric.Bound()).Distance(envelope.Bound(toSteric.Bound())) > distance {
+		return false
+	}
+	if isInPolygons(fromSteric, toSteric) || isInPolygons(toSteric, fromSteric) {
+		return true
+	}
+	from, to := segments(fromSteric), segments(toSteric)
+	sort.Slice(to, func(i, j int) bool { return to[i].env.MinX < to[j].env.MinX })
+	for _, s0 := range from {
+		for _, s1 := range to {
+			if s1.env.MinX > s0.env.MaxX+distance {
+				break
+			}
+			if s0.env.Distance(s1.env) > distance {
+				continue
+			}
+			if distanceSegmentToSegment(s0.a, s0.b, s1.a, s1.b) <= distance {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsFullyWithinDistance returns true if the maximum distance between the sterics is at most distance,
+// all points of each being within the distance of all points of the other.
+// It returns true at once if the diagonal of their bound is within the distance,
+// false as soon as a pair of vertices farther apart is found.
+func IsFullyWithinDistance(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 || isEmpty(fromSteric) || isEmpty(toSteric) {
+		return false
+	}
+	env := envelope.Bound(fromSteric.Bound())
+	env.ExpandToIncludeEnv(envelope.Bound(toSteric.Bound()))
+	if math.Hypot(env.Width(), env.Height()) <= distance {
+		return true
+	}
+	to := vertices(toSteric)
+	for _, p := range vertices(fromSteric) {
+		for _, q := range to {
+			if math.Hypot(p[0]-q[0], p[1]-q[1]) > distance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IsWithinSpheroidDistance returns true if the spheroid distance between the sterics, in meters, is at most distance.
+// Sterics which intersect, or whose latitudes are farther apart than the distance, are decided without computing it.
+func IsWithinSpheroidDistance(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 || isEmpty(fromSteric) || isEmpty(toSteric) {
+		return false
+	}
+	if IsWithinDistance(fromSteric, toSteric, 0) {
+		return true
+	}
+	from, to := envelope.Bound(fromSteric.Bound()), envelope.Bound(toSteric.Bound())
+	if gap := math.Max(from.MinY-to.MaxY, to.MinY-from.MaxY); gap*math.Pi/180*R > distance {
+		return false
+	}
+	return SpheroidDistance(fromSteric, toSteric) <= distance
+}
+
+// IsFullyWithinSpheroidDistance returns true if the maximum spheroid distance between the sterics,
+// in meters, is at most distance, returning false as soon as a pair of vertices farther apart is found.
+func IsFullyWithinSpheroidDistance(fromSteric, toSteric matrix.Steric, distance float64) bool {
+	if distance < 0 || isEmpty(fromSteric) || isEmpty(toSteric) {
+		return false
+	}
+	to := vertices(toSteric)
+	for _, p := range vertices(fromSteric) {
+		for _, q := range to {
+			if SpheroidDistance(p, q) > distance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// distanceSegmentToSegment returns the distance between the segments a0-a1 and b0-b1.
+func distanceSegmentToSegment(a0, a1, b0, b1 matrix.Matrix) float64 {
+	if segmentsIntersect(a0, a1, b0, b1) {
+		return 0
+	}
+	return math.Min(
+		math.Min(distanceSegmentToPoint(a0, b0, b1), distanceSegmentToPoint(a1, b0, b1)),
+		math.Min(distanceSegmentToPoint(b0, a0, a1), distanceSegmentToPoint(b1, a0, a1)))
+}
+
+// segmentsIntersect tests whether the segments a0-a1 and b0-b1 intersect, properly or not.
+func segmentsIntersect(a0, a1, b0, b1 matrix.Matrix) bool {
+	o0, o1 := orientation(a0, a1, b0), orientation(a0, a1, b1)
+	o2, o3 := orientation(b0, b1, a0), orientation(b0, b1, a1)
+	if o0*o1 < 0 && o2*o3 < 0 {
+		return true
+	}
+	return o0 == 0 && distanceSegmentToPoint(b0, a0, a1) == 0 ||
+		o1 == 0 && distanceSegmentToPoint(b1, a0, a1) == 0 ||
+		o2 == 0 && distanceSegmentToPoint(a0, b0, b1) == 0 ||
+		o3 == 0 && distanceSegmentToPoint(a1, b0, b1) == 0
+}
+
+// orientation returns the sign of the turn from a, b to c.
+func orientation(a, b, c matrix.Matrix) int {
+	v := (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// segment a segment of a steric with its envelope, the points of the steric being segments of one point.
+type segment struct {
+	a, b matrix.Matrix
+	env  *envelope.Envelope
+}
+
+// segments returns the segments of the steric.
+func segments(steric matrix.Steric) []segment {
+	result := []segment{}
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		result = append(result, segment{s, s, envelope.Matrix(s)})
+	case matrix.LineMatrix:
+		if len(s) == 1 {
+			return segments(matrix.Matrix(s[0]))
+		}
+		for i := 1; i < len(s); i++ {
+			result = append(result, segment{s[i-1], s[i], envelope.TwoMatrix(s[i-1], s[i])})
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range s {
+			result = append(result, segments(matrix.LineMatrix(v))...)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range s {
+			result = append(result, segments(matrix.PolygonMatrix(v))...)
+		}
+	case matrix.Collection:
+		for _, v := range s {
+			result = append(result, segments(v)...)
+		}
+	}
+	return result
+}
+
+// vertices returns the vertices of the steric.
+func vertices(steric matrix.Steric) []matrix.Matrix {
+	result := []matrix.Matrix{}
+	for _, s := range segments(steric) {
+		result = append(result, s.a)
+		if !s.b.Equals(s.a) {
+			result = append(result, s.b)
+		}
+	}
+	return result
+}
+
+// isInPolygons tests whether a part of the steric is in a polygon of the other, by its first vertex.
+func isInPolygons(steric, other matrix.Steric) bool {
+	_, ok := pointInPolygons(steric, other)
+	return ok
+}
+
+// firstVertices returns the first vertex of each part of the steric.
+func firstVertices(steric matrix.Steric) []matrix.Matrix {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		return []matrix.Matrix{s}
+	case matrix.LineMatrix:
+		if len(s) > 0 {
+			return []matrix.Matrix{s[0]}
+		}
+	case matrix.PolygonMatrix:
+		if len(s) > 0 && len(s[0]) > 0 {
+			return []matrix.Matrix{s[0][0]}
+		}
+	case matrix.MultiPolygonMatrix:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, firstVertices(matrix.PolygonMatrix(v))...)
+		}
+		return result
+	case matrix.Collection:
+		result := []matrix.Matrix{}
+		for _, v := range s {
+			result = append(result, firstVertices(v)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// polygons returns the polygons of the steric.
+func polygons(steric matrix.Steric) []matrix.PolygonMatrix {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		if len(s) > 0 {
+			return []matrix.PolygonMatrix{s}
+		}
+	case matrix.MultiPolygonMatrix:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			result = append(result, polygons(matrix.PolygonMatrix(v))...)
+		}
+		return result
+	case matrix.Collection:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			result = append(result, polygons(v)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// isEmpty tests whether the steric has no vertices.
+func isEmpty(steric matrix.Steric) bool {
+	return steric == nil || len(segments(steric)) == 0
+}
@@ -0,0 +1,70 @@
+package measure
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIsWithinDistance(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name     string
+		from, to matrix.Steric
+		distance float64
+		want     bool
+		wantFull bool
+	}{
+		{"points", matrix.Matrix{0, 0}, matrix.Matrix{3, 4}, 5, true, true},
+		{"points far", matrix.Matrix{0, 0}, matrix.Matrix{3, 4}, 4.9, false, false},
+		{"point line", matrix.Matrix{5, 1}, matrix.LineMatrix{{0, 0}, {10, 0}}, 1, true, false},
+		{"lines cross", matrix.LineMatrix{{0, -1}, {0, 1}}, matrix.LineMatrix{{-1, 0}, {1, 0}}, 0, true, false},
+		{"lines apart", matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{0, 2}, {10, 2.5}}, 1.9, false, false},
+		{"point in polygon", matrix.Matrix{5, 5}, square, 0, true, false},
+		{"polygon in polygon", matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 4}}}, square, 0, true, false},
+		{"polygon fully", matrix.Matrix{5, 5}, square, 7.1, true, true},
+		{"collection", matrix.Collection{matrix.Matrix{20, 20}, matrix.LineMatrix{{12, 0}, {12, 10}}}, square, 2, true, false},
+		{"negative", matrix.Matrix{0, 0}, matrix.Matrix{0, 0}, -1, false, false},
+		{"empty", matrix.LineMatrix{}, matrix.Matrix{0, 0}, 1, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWithinDistance(tt.from, tt.to, tt.distance); got != tt.want {
+				t.Errorf("IsWithinDistance() = %v, want %v", got, tt.want)
+			}
+			if got := IsFullyWithinDistance(tt.from, tt.to, tt.distance); got != tt.wantFull {
+				t.Errorf("IsFullyWithinDistance() = %v, want %v", got, tt.wantFull)
+			}
+		})
+	}
+}
+
+func TestIsWithinSpheroidDistance(t *testing.T) {
+	from, to := matrix.Matrix{12, 15}, matrix.Matrix{13, 15}
+	line0 := matrix.LineMatrix{{116.40495300292967, 39.926785883895654}, {116.3975715637207, 39.9295502919}}
+	line1 := matrix.LineMatrix{{116.37310981750488, 39.92099342895789}, {116.39928817749023, 39.9174387253541}}
+	tests := []struct {
+		name     string
+		from, to matrix.Steric
+		distance float64
+		want     bool
+		wantFull bool
+	}{
+		{"points", from, to, 107406, true, true},
+		{"points far", from, to, 107405, false, false},
+		{"lines", line0, line1, 1148, true, false},
+		{"lines far", line0, line1, 1147, false, false},
+		{"latitudes", matrix.Matrix{0, 0}, matrix.Matrix{0, 1}, 100000, false, false},
+		{"intersect", line0, matrix.Matrix{116.40495300292967, 39.926785883895654}, 0, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWithinSpheroidDistance(tt.from, tt.to, tt.distance); got != tt.want {
+				t.Errorf("IsWithinSpheroidDistance() = %v, want %v", got, tt.want)
+			}
+			if got := IsFullyWithinSpheroidDistance(tt.from, tt.to, tt.distance); got != tt.wantFull {
+				t.Errorf("IsFullyWithinSpheroidDistance() = %v, want %v", got, tt.wantFull)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+// Package noding Computes the full noding of a set of lines,
+// that is splits the lines at all their mutual and self intersections.
+package noding
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay/chain"
+)
+
+// Intersection an intersection point of two segments of the linework,
+// the segment with index Segment0 of line Line0 and the segment with index Segment1 of line Line1.
+type Intersection struct {
+	Point           matrix.Matrix
+	Line0, Segment0 int
+	Line1, Segment1 int
+}
+
+// SegmentNode a node located on the segment with index Segment of a line.
+type SegmentNode struct {
+	Point   matrix.Matrix
+	Segment int
+}
+
+// Noder Nodes a set of lines at all their intersections.
+// If GridSize is positive the linework is snap-rounded to a grid of that size,
+// otherwise the full floating precision is used.
+type Noder struct {
+	GridSize      float64
+	Intersections []Intersection
+	ctx           context.Context
+}
+
+// Node returns the lines noded at all their intersections, optionally snap-rounded to gridSize.
+func Node(lines []matrix.LineMatrix, gridSize float64) []matrix.LineMatrix {
+	n := &Noder{GridSize: gridSize}
+	return n.Node(lines...)
+}
+
+// Intersections returns all the intersection points of the segments of the lines.
+// Intersections of adjacent segments of a line at their common vertex are not reported.
+func Intersections(lines ...matrix.LineMatrix) []Intersection {
+	n := &Noder{}
+	n.computeIntersections(lines)
+	return n.Intersections
+}
+
+// Node returns the lines split at all their intersections, the noded lines are unique.
+// When snap-rounding the segment indices of the intersections refer to the rounded lines.
+func (n *Noder) Node(lines ...matrix.LineMatrix) []matrix.LineMatrix {
+	result := []matrix.LineMatrix{}
+	seen := map[string]bool{}
+	for _, parts := range n.NodeEach(lines...) {
+		for _, part := range parts {
+			key := lineKey(part)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// NodeEach returns for each line the parts into which it is split by all the intersections of the lines,
+// parts shared by several lines are returned for each of them.
+func (n *Noder) NodeEach(lines ...matrix.LineMatrix) [][]matrix.LineMatrix {
+	result, _ := n.NodeEachContext(context.Background(), lines...)
+	return result
+}
+
+// NodeEachContext returns the parts of each line as NodeEach,
+// returning ctx.Err() if the context is done before the lines are noded.
+func (n *Noder) NodeEachContext(ctx context.Context, lines ...matrix.LineMatrix) ([][]matrix.LineMatrix, error) {
+	n.ctx = ctx
+	defer func() { n.ctx = nil }()
+	if n.GridSize > 0 {
+		rounded := make([]matrix.LineMatrix, 0, len(lines))
+		for _, line := range lines {
+			rounded = append(rounded, n.roundLine(line))
+		}
+		lines = rounded
+	}
+	n.computeIntersections(lines)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	nodes := make([][]SegmentNode, len(lines))
+	for i, v := range n.Intersections {
+		if n.GridSize > 0 {
+			v.Point = n.round(v.Point)
+			n.Intersections[i] = v
+		}
+		nodes[v.Line0] = append(nodes[v.Line0], SegmentNode{v.Point, v.Segment0})
+		nodes[v.Line1] = append(nodes[v.Line1], SegmentNode{v.Point, v.Segment1})
+	}
+	if n.GridSize > 0 {
+		n.addHotPixelNodes(lines, nodes)
+	}
+	result := make([][]matrix.LineMatrix, len(lines))
+	for i, line := range lines {
+		if n.isDone() {
+			return nil, ctx.Err()
+		}
+		if len(line) > 1 {
+			result[i] = SplitLine(line, nodes[i])
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// isDone tests whether the context of the noding is done.
+func (n *Noder) isDone() bool {
+	return n.ctx != nil && n.ctx.Err() != nil
+}
+
+func (n *Noder) computeIntersections(lines []matrix.LineMatrix) {
+	n.Intersections = []Intersection{}
+	chains := []*chain.MonotoneChain{}
+	for i, line := range lines {
+		for _, mc := range chain.ChainsContext(line, i) {
+			mc.ID = len(chains)
+			mc.Env = envelope.TwoMatrix(line[mc.Start], line[mc.End])
+			chains = append(chains, mc)
+		}
+	}
+	sort.SliceStable(chains, func(i, j int) bool {
+		return chains[i].Env.MinX < chains[j].Env.MinX
+	})
+	action := &segmentIntersector{noder: n, lines: lines}
+	for i, mc0 := range chains {
+		if n.isDone() {
+			return
+		}
+		for _, mc1 := range chains[i+1:] {
+			if mc1.Env.MinX > mc0.Env.MaxX {
+				break
+			}
+			if mc0.Env.IsIntersects(mc1.Env) {
+				mc0.ComputeOverlaps(mc1, action)
+			}
+		}
+	}
+}
+
+// segmentIntersector computes the intersections of the overlapping segments of two monotone chains.
+type segmentIntersector struct {
+	noder *Noder
+	lines []matrix.LineMatrix
+}
+
+// Overlap computes the intersections of the segment at start0 of mc0 and the segment at start1 of mc1.
+func (s *segmentIntersector) Overlap(mc0 *chain.MonotoneChain, start0 int, mc1 *chain.MonotoneChain, start1 int) {
+	line0, line1 := mc0.Context.(int), mc1.Context.(int)
+	if line0 > line1 || (line0 == line1 && start0 > start1) {
+		line0, line1, start0, start1 = line1, line0, start1, start0
+	}
+	e0, e1 := s.lines[line0], s.lines[line1]
+	ips := IntersectSegments(e0[start0], e0[start0+1], e1[start1], e1[start1+1])
+	for _, ip := range ips {
+		if line0 == line1 && isTrivialIntersection(e0, start0, start1, ip) {
+			continue
+		}
+		s.noder.Intersections = append(s.noder.Intersections, Intersection{ip, line0, start0, line1, start1})
+	}
+}
+
+// isTrivialIntersection returns true if the intersection point is the vertex shared by adjacent segments,
+// the first and the last segment of a closed line are adjacent too.
+func isTrivialIntersection(line matrix.LineMatrix, seg0, seg1 int, ip matrix.Matrix) bool {
+	if seg1-seg0 == 1 {
+		return ip.Equals(matrix.Matrix(line[seg1]))
+	}
+	if seg0 == 0 && seg1 == len(line)-2 && matrix.Matrix(line[0]).Equals(matrix.Matrix(line[len(line)-1])) {
+		return ip.Equals(matrix.Matrix(line[0]))
+	}
+	return false
+}
+
+func (n *Noder) round(pt matrix.Matrix) matrix.Matrix {
+	scale := 1 / n.GridSize
+	return matrix.Matrix{math.Round(pt[0]*scale) / scale, math.Round(pt[1]*scale) / scale}
+}
+
+// roundLine rounds the vertices of the line to the grid, removing repeated vertices.
+func (n *Noder) roundLine(line matrix.LineMatrix) matrix.LineMatrix {
+	result := make(matrix.LineMatrix, 0, len(line))
+	for _, v := range line {
+		pt := n.round(v)
+		if len(result) > 0 && pt.Equals(matrix.Matrix(result[len(result)-1])) {
+			continue
+		}
+		result = append(result, pt)
+	}
+	return result
+}
+
+// addHotPixelNodes adds nodes where segments pass through the grid cells (hot pixels)
+// containing vertices or intersection points, so that the snapped linework is fully noded.
+func (n *Noder) addHotPixelNodes(lines []matrix.LineMatrix, nodes [][]SegmentNode) {
+	pixelSet := map[[2]float64]bool{}
+	for _, line := range lines {
+		for _, v := range line {
+			pixelSet[[2]float64{v[0], v[1]}] = true
+		}
+	}
+	for _, v := range n.Intersections {
+		pixelSet[[2]float64{v.Point[0], v.Point[1]}] = true
+	}
+	pixels := make([][2]float64, 0, len(pixelSet))
+	for k := range pixelSet {
+		pixels = append(pixels, k)
+	}
+	sort.Slice(pixels, func(i, j int) bool {
+		if pixels[i][0] != pixels[j][0] {
+			return pixels[i][0] < pixels[j][0]
+		}
+		return pixels[i][1] < pixels[j][1]
+	})
+	half := n.GridSize / 2
+	for i, line := range lines {
+		if n.isDone() {
+			return
+		}
+		for j := 0; j < len(line)-1; j++ {
+			p0, p1 := matrix.Matrix(line[j]), matrix.Matrix(line[j+1])
+			minX, maxX := math.Min(p0[0], p1[0])-half, math.Max(p0[0], p1[0])+half
+			minY, maxY := math.Min(p0[1], p1[1])-half, math.Max(p0[1], p1[1])+half
+			for k := sort.Search(len(pixels), func(k int) bool { return pixels[k][0] >= minX }); k < len(pixels) && pixels[k][0] <= maxX; k++ {
+				pixel := matrix.Matrix{pixels[k][0], pixels[k][1]}
+				if pixel[1] < minY || pixel[1] > maxY || pixel.Equals(p0) || pixel.Equals(p1) {
+					continue
+				}
+				if intersectsPixel(p0, p1, pixel, half) {
+					nodes[i] = append(nodes[i], SegmentNode{pixel, j})
+				}
+			}
+		}
+	}
+}
+
+// intersectsPixel returns true if the segment p0-p1 intersects the square of half size centred at pixel.
+func intersectsPixel(p0, p1, pixel matrix.Matrix, half float64) bool {
+	pos, neg := false, false
+	for _, corner := range []matrix.Matrix{
+		{pixel[0] - half, pixel[1] - half},
+		{pixel[0] + half, pixel[1] - half},
+		{pixel[0] + half, pixel[1] + half},
+		{pixel[0] - half, pixel[1] + half},
+	} {
+		switch Orientation(p0, p1, corner) {
+		case 1:
+			pos = true
+		case -1:
+			neg = true
+		default:
+			return true
+		}
+	}
+	return pos && neg
+}
+
+// SplitLine splits the line at the nodes, returns the lines into which it is divided.
+// Repeated vertices are removed from the parts.
+func SplitLine(line matrix.LineMatrix, nodes []SegmentNode) []matrix.LineMatrix {
+	fractions := make([]float64, len(nodes))
+	for i, v := range nodes {
+		fractions[i] = segmentFraction(v.Point, line[v.Segment], line[v.Segment+1])
+	}
+	order := make([]int, len(nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := nodes[order[i]], nodes[order[j]]
+		if a.Segment != b.Segment {
+			return a.Segment < b.Segment
+		}
+		return fractions[order[i]] < fractions[order[j]]
+	})
+	result := []matrix.LineMatrix{}
+	part := matrix.LineMatrix{line[0]}
+	addVertex := func(pt matrix.Matrix) {
+		if !pt.Equals(matrix.Matrix(part[len(part)-1])) {
+			part = append(part, pt)
+		}
+	}
+	next := 1
+	for _, i := range order {
+		v := nodes[i]
+		for ; next <= v.Segment; next++ {
+			addVertex(line[next])
+		}
+		addVertex(v.Point)
+		if len(part) > 1 {
+			result = append(result, part)
+			part = matrix.LineMatrix{v.Point}
+		}
+	}
+	for ; next < len(line); next++ {
+		addVertex(line[next])
+	}
+	if len(part) > 1 {
+		result = append(result, part)
+	}
+	return result
+}
+
+// segmentFraction returns the fraction of the projection of p along the segment start-end.
+func segmentFraction(p, start, end matrix.Matrix) float64 {
+	dx, dy := end[0]-start[0], end[1]-start[1]
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return 0
+	}
+	return ((p[0]-start[0])*dx + (p[1]-start[1])*dy) / length
+}
+
+// lineKey returns a key identifying the line regardless of its direction.
+func lineKey(line matrix.LineMatrix) string {
+	reversed := false
+	for i, j := 0, len(line)-1; i < j; i, j = i+1, j-1 {
+		if c := compare(line[i], line[j]); c != 0 {
+			reversed = c > 0
+			break
+		}
+	}
+	if !reversed {
+		return fmt.Sprint(line)
+	}
+	rev := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		rev[len(line)-1-i] = v
+	}
+	return fmt.Sprint(rev)
+}
+
+func compare(a, b []float64) int {
+	for i := 0; i < 2; i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Linework returns the lines of the steric, polygons contribute their rings.
+func Linework(steric matrix.Steric) []matrix.LineMatrix {
+	lines := []matrix.LineMatrix{}
+	switch s := steric.(type) {
+	case matrix.LineMatrix:
+		lines = append(lines, s)
+	case matrix.PolygonMatrix:
+		for _, v := range s {
+			lines = append(lines, v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range s {
+			lines = append(lines, Linework(matrix.PolygonMatrix(v))...)
+		}
+	case matrix.Collection:
+		for _, v := range s {
+			lines = append(lines, Linework(v)...)
+		}
+	}
+	return lines
+}
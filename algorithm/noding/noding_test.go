@@ -0,0 +1,87 @@
+package noding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		lines    []matrix.LineMatrix
+		gridSize float64
+		want     []matrix.LineMatrix
+	}{
+		{name: "cross", lines: []matrix.LineMatrix{{{0, 0}, {2, 2}}, {{0, 2}, {2, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 1}}, {{1, 1}, {2, 2}}, {{0, 2}, {1, 1}}, {{1, 1}, {2, 0}}}},
+		{name: "disjoint", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{0, 1}, {1, 1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{0, 1}, {1, 1}}}},
+		{name: "touch", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}}, {{1, 0}, {1, 1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{1, 0}, {1, 1}}}},
+		{name: "collinear overlap", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}}, {{3, 0}, {1, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{3, 0}, {2, 0}}}},
+		{name: "self intersection", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, -1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 0}}, {{1, 0}, {1, -1}}}},
+		{name: "closed ring", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		{name: "snap rounding", lines: []matrix.LineMatrix{{{0, 0}, {10, 0.4}}, {{5, -5}, {5.2, 5}}}, gridSize: 1,
+			want: []matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}}, {{5, -5}, {5, 0}}, {{5, 0}, {5, 5}}}},
+		{name: "snap rounding hot pixel", lines: []matrix.LineMatrix{{{0, 0}, {10, 0}}, {{4.9, 0.3}, {4.9, 5}}}, gridSize: 1,
+			want: []matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}}, {{5, 0}, {5, 5}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Node(tt.lines, tt.gridSize); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Node() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersections(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		want  []Intersection
+	}{
+		{name: "cross", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}, {1, 2}}, {{0, 2}, {2, 0}}},
+			want: []Intersection{{matrix.Matrix{1, 1}, 0, 1, 1, 0}}},
+		{name: "adjacent segments", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			want: []Intersection{}},
+		{name: "self intersection", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, -1}}},
+			want: []Intersection{{matrix.Matrix{1, 0}, 0, 0, 0, 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Intersections(tt.lines...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersections() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersectSegments(t *testing.T) {
+	tests := []struct {
+		name           string
+		p1, p2, q1, q2 matrix.Matrix
+		want           []matrix.Matrix
+	}{
+		{name: "proper", p1: matrix.Matrix{0, 0}, p2: matrix.Matrix{2, 2}, q1: matrix.Matrix{0, 2}, q2: matrix.Matrix{2, 0},
+			want: []matrix.Matrix{{1, 1}}},
+		{name: "endpoint", p1: matrix.Matrix{0, 0}, p2: matrix.Matrix{2, 0}, q1: matrix.Matrix{2, 0}, q2: matrix.Matrix{3, 3},
+			want: []matrix.Matrix{{2, 0}}},
+		{name: "collinear", p1: matrix.Matrix{0, 0}, p2: matrix.Matrix{2, 0}, q1: matrix.Matrix{1, 0}, q2: matrix.Matrix{3, 0},
+			want: []matrix.Matrix{{1, 0}, {2, 0}}},
+		{name: "parallel", p1: matrix.Matrix{0, 0}, p2: matrix.Matrix{2, 0}, q1: matrix.Matrix{0, 1}, q2: matrix.Matrix{2, 1},
+			want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IntersectSegments(tt.p1, tt.p2, tt.q1, tt.q2); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IntersectSegments() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
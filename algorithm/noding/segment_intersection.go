@@ -0,0 +1,91 @@
+package noding
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Orientation returns the orientation index of q relative to the segment p1-p2,
+// 1 if q is on the left, -1 if q is on the right and 0 if q is collinear.
+func Orientation(p1, p2, q matrix.Matrix) int {
+	det := (p2[0]-p1[0])*(q[1]-p1[1]) - (p2[1]-p1[1])*(q[0]-p1[0])
+	switch {
+	case det > 0:
+		return 1
+	case det < 0:
+		return -1
+	}
+	return 0
+}
+
+// PointOnSegment returns true if q lies on the segment p1-p2.
+func PointOnSegment(p1, p2, q matrix.Matrix) bool {
+	return Orientation(p1, p2, q) == 0 && inEnvelope(p1, p2, q)
+}
+
+// inEnvelope returns true if q is inside the envelope of the segment p1-p2.
+func inEnvelope(p1, p2, q matrix.Matrix) bool {
+	return q[0] >= math.Min(p1[0], p2[0]) && q[0] <= math.Max(p1[0], p2[0]) &&
+		q[1] >= math.Min(p1[1], p2[1]) && q[1] <= math.Max(p1[1], p2[1])
+}
+
+// IntersectSegments returns the intersection points of segment p1-p2 and segment q1-q2,
+// two points are returned if the segments overlap collinearly.
+func IntersectSegments(p1, p2, q1, q2 matrix.Matrix) []matrix.Matrix {
+	if !inEnvelopePair(p1, p2, q1, q2) {
+		return nil
+	}
+	o1, o2 := Orientation(p1, p2, q1), Orientation(p1, p2, q2)
+	o3, o4 := Orientation(q1, q2, p1), Orientation(q1, q2, p2)
+	if o1*o2 > 0 || o3*o4 > 0 {
+		return nil
+	}
+	ips := []matrix.Matrix{}
+	add := func(ip matrix.Matrix) {
+		for _, v := range ips {
+			if v.Equals(ip) {
+				return
+			}
+		}
+		ips = append(ips, ip)
+	}
+	if o1 == 0 && o2 == 0 {
+		for _, v := range []matrix.Matrix{q1, q2} {
+			if inEnvelope(p1, p2, v) {
+				add(v)
+			}
+		}
+		for _, v := range []matrix.Matrix{p1, p2} {
+			if inEnvelope(q1, q2, v) {
+				add(v)
+			}
+		}
+		return ips
+	}
+	switch {
+	case o1 == 0 && inEnvelope(p1, p2, q1):
+		add(q1)
+	case o2 == 0 && inEnvelope(p1, p2, q2):
+		add(q2)
+	case o3 == 0 && inEnvelope(q1, q2, p1):
+		add(p1)
+	case o4 == 0 && inEnvelope(q1, q2, p2):
+		add(p2)
+	default:
+		denom := (p2[0]-p1[0])*(q2[1]-q1[1]) - (p2[1]-p1[1])*(q2[0]-q1[0])
+		if denom == 0 {
+			return nil
+		}
+		t := ((q1[0]-p1[0])*(q2[1]-q1[1]) - (q1[1]-p1[1])*(q2[0]-q1[0])) / denom
+		add(matrix.Matrix{p1[0] + t*(p2[0]-p1[0]), p1[1] + t*(p2[1]-p1[1])})
+	}
+	return ips
+}
+
+func inEnvelopePair(p1, p2, q1, q2 matrix.Matrix) bool {
+	return math.Max(p1[0], p2[0]) >= math.Min(q1[0], q2[0]) &&
+		math.Max(q1[0], q2[0]) >= math.Min(p1[0], p2[0]) &&
+		math.Max(p1[1], p2[1]) >= math.Min(q1[1], q2[1]) &&
+		math.Max(q1[1], q2[1]) >= math.Min(p1[1], p2[1])
+}
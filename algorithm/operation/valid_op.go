@@ -38,7 +38,7 @@ func (el *ValidOP) isSimpleMultiSteric(matr matrix.Collection) bool {
 	nonSimplePts := true
 	for _, v := range matr {
 		points.Do(func(i interface{}) {
-			if v.Equals(i.(matrix.Steric)) {
+			if s, ok := i.(matrix.Steric); ok && v.Equals(s) {
 				nonSimplePts = false
 			}
 		})
@@ -0,0 +1,166 @@
+package overlay
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+	"github.com/spatial-go/geoos/index/hprtree"
+)
+
+// CascadedUnion returns the union of the polygons of the polygonal geometry, unioned by halves as UnaryUnionByHalf.
+// The polygons are ordered along the Hilbert curve of a hprtree so that each half holds polygons near each other,
+// halves whose envelopes are disjoint are gathered without overlay,
+// and the halves are unioned on at most workers goroutines, runtime.GOMAXPROCS(0) if workers is not positive.
+// The result is a Polygon, or a Collection of Polygons if it has several or no parts.
+// It returns ctx.Err() if the context is done before the union is computed.
+func CascadedUnion(ctx context.Context, steric matrix.Steric, workers int) (matrix.Steric, error) {
+	polys, err := polygonal(steric)
+	if err != nil {
+		return nil, err
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	tree := hprtree.NewHPRTree()
+	env := envelope.Empty()
+	for _, v := range polys {
+		polyEnv := envelope.Bound(v.Bound())
+		_ = tree.Insert(polyEnv, v)
+		env.ExpandToIncludeEnv(polyEnv)
+	}
+	parts := []*unionPart{}
+	if len(polys) > 0 {
+		for _, v := range tree.Query(env).([]interface{}) {
+			poly := v.(matrix.PolygonMatrix)
+			parts = append(parts, &unionPart{polys: []matrix.PolygonMatrix{poly}, env: envelope.Bound(poly.Bound())})
+		}
+	}
+	u := &cascadedUnion{ctx: ctx, slots: make(chan struct{}, workers-1)}
+	part, err := u.unionByHalf(parts)
+	if err != nil {
+		return nil, err
+	}
+	if part == nil {
+		return matrix.Collection{}, nil
+	}
+	if len(part.polys) == 1 {
+		return part.polys[0], nil
+	}
+	return collection(part.polys), nil
+}
+
+// unionPart the polygons of a unioned section, with their envelope.
+type unionPart struct {
+	polys []matrix.PolygonMatrix
+	env   *envelope.Envelope
+}
+
+// cascadedUnion unions sections of polygons, the slots bounding the goroutines started besides the caller.
+type cascadedUnion struct {
+	ctx   context.Context
+	slots chan struct{}
+}
+
+// unionByHalf returns the union of the parts, the first half being unioned on a new goroutine if a slot is free.
+func (u *cascadedUnion) unionByHalf(parts []*unionPart) (*unionPart, error) {
+	if err := u.ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch len(parts) {
+	case 0:
+		return nil, nil
+	case 1:
+		return parts[0], nil
+	}
+	mid := len(parts) / 2
+	var p0, p1 *unionPart
+	var err0, err1 error
+	select {
+	case u.slots <- struct{}{}:
+		done := make(chan struct{})
+		go func() {
+			defer func() {
+				<-u.slots
+				close(done)
+			}()
+			p0, err0 = u.unionByHalf(parts[:mid])
+		}()
+		p1, err1 = u.unionByHalf(parts[mid:])
+		<-done
+	default:
+		p0, err0 = u.unionByHalf(parts[:mid])
+		p1, err1 = u.unionByHalf(parts[mid:])
+	}
+	if err0 != nil {
+		return nil, err0
+	}
+	if err1 != nil {
+		return nil, err1
+	}
+	return u.union(p0, p1)
+}
+
+// union returns the union of the parts, gathering their polygons if their envelopes are disjoint.
+func (u *cascadedUnion) union(p0, p1 *unionPart) (*unionPart, error) {
+	env := envelope.Empty()
+	env.ExpandToIncludeEnv(p0.env)
+	env.ExpandToIncludeEnv(p1.env)
+	if !p0.env.IsIntersects(p1.env) {
+		polys := make([]matrix.PolygonMatrix, 0, len(p0.polys)+len(p1.polys))
+		return &unionPart{polys: append(append(polys, p0.polys...), p1.polys...), env: env}, nil
+	}
+	if err := u.ctx.Err(); err != nil {
+		return nil, err
+	}
+	result, err := snapround.Union(collection(p0.polys), collection(p1.polys), 0)
+	if err != nil {
+		return nil, err
+	}
+	polys, err := polygonal(result)
+	if err != nil {
+		return nil, err
+	}
+	return &unionPart{polys: polys, env: env}, nil
+}
+
+// collection returns the polygons as a Collection.
+func collection(polys []matrix.PolygonMatrix) matrix.Collection {
+	result := make(matrix.Collection, 0, len(polys))
+	for _, v := range polys {
+		result = append(result, v)
+	}
+	return result
+}
+
+// polygonal returns the polygons of a polygonal geometry.
+func polygonal(steric matrix.Steric) ([]matrix.PolygonMatrix, error) {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		if s.IsEmpty() {
+			return nil, nil
+		}
+		return []matrix.PolygonMatrix{s}, nil
+	case matrix.MultiPolygonMatrix:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			result = append(result, v)
+		}
+		return result, nil
+	case matrix.Collection:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			polys, err := polygonal(v)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, polys...)
+		}
+		return result, nil
+	default:
+		return nil, algorithm.ErrNotMatchType
+	}
+}
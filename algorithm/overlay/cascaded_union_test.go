@@ -0,0 +1,73 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestCascadedUnion(t *testing.T) {
+	square := func(x, y, size float64) matrix.PolygonMatrix {
+		return matrix.PolygonMatrix{{{x, y}, {x + size, y}, {x + size, y + size}, {x, y + size}, {x, y}}}
+	}
+	grid := matrix.Collection{}
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			grid = append(grid, square(float64(i), float64(j), 1))
+		}
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		steric    matrix.Steric
+		workers   int
+		wantParts int
+		wantArea  float64
+		wantErr   error
+	}{
+		{name: "overlapping", ctx: context.Background(),
+			steric:    matrix.Collection{square(0, 0, 10), square(5, 5, 10)},
+			wantParts: 1, wantArea: 175},
+		{name: "disjoint", ctx: context.Background(),
+			steric:    matrix.Collection{square(0, 0, 10), square(5, 5, 10), square(20, 20, 10)},
+			wantParts: 2, wantArea: 275},
+		{name: "multipolygon", ctx: context.Background(),
+			steric:    matrix.MultiPolygonMatrix{square(0, 0, 10), square(10, 0, 10)},
+			wantParts: 1, wantArea: 200},
+		{name: "grid serial", ctx: context.Background(), steric: grid, workers: 1, wantParts: 1, wantArea: 400},
+		{name: "grid parallel", ctx: context.Background(), steric: grid, workers: 4, wantParts: 1, wantArea: 400},
+		{name: "grid all cores", ctx: context.Background(), steric: grid, wantParts: 1, wantArea: 400},
+		{name: "empty", ctx: context.Background(), steric: matrix.Collection{}, wantParts: 0},
+		{name: "cancelled", ctx: cancelled, steric: grid, wantErr: context.Canceled},
+		{name: "not polygon", ctx: context.Background(), steric: matrix.LineMatrix{{0, 0}, {1, 1}},
+			wantErr: algorithm.ErrNotMatchType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CascadedUnion(tt.ctx, tt.steric, tt.workers)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CascadedUnion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			polys, _ := polygonal(got)
+			area := 0.0
+			for _, v := range polys {
+				area += measure.AreaOfPolygon(v)
+			}
+			if len(polys) != tt.wantParts || math.Abs(area-tt.wantArea) > 1e-9 {
+				t.Errorf("CascadedUnion() = %v parts of area %v, want %v parts of area %v",
+					len(polys), area, tt.wantParts, tt.wantArea)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Package snapround Computes the overlay of polygonal geometries using snap-rounding.
+// The linework of the inputs is snap-rounded to a grid and fully noded,
+// so the results are valid and reproducible on that grid.
+package snapround
+
+import (
+	"context"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/noding"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+)
+
+// const overlay operation codes.
+const (
+	// OpIntersection the code for the intersection overlay operation.
+	OpIntersection = iota + 1
+	// OpUnion the code for the union overlay operation.
+	OpUnion
+	// OpDifference the code for the difference overlay operation.
+	OpDifference
+	// OpSymDifference the code for the symmetric difference overlay operation.
+	OpSymDifference
+)
+
+// Intersection returns the snap-rounded intersection of the polygonal geometries.
+func Intersection(a, b matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return Overlay(a, b, OpIntersection, gridSize)
+}
+
+// Union returns the snap-rounded union of the polygonal geometries.
+func Union(a, b matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return Overlay(a, b, OpUnion, gridSize)
+}
+
+// Difference returns the snap-rounded difference of the polygonal geometries.
+func Difference(a, b matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return Overlay(a, b, OpDifference, gridSize)
+}
+
+// SymDifference returns the snap-rounded symmetric difference of the polygonal geometries.
+func SymDifference(a, b matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return Overlay(a, b, OpSymDifference, gridSize)
+}
+
+// UnaryUnion returns the snap-rounded union of the components of the polygonal geometry.
+func UnaryUnion(a matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return UnaryUnionContext(context.Background(), a, gridSize)
+}
+
+// UnaryUnionContext returns the snap-rounded union of the components of the polygonal geometry,
+// returning ctx.Err() if the context is done before the union is computed.
+func UnaryUnionContext(ctx context.Context, a matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	return OverlayContext(ctx, a, matrix.Collection{}, OpUnion, gridSize)
+}
+
+// Overlay returns the result of the overlay operation opCode on the polygonal geometries,
+// the linework is snap-rounded to a grid of gridSize, a zero gridSize uses floating precision.
+// The result is a Polygon, or a Collection of Polygons if it has several or no parts.
+func Overlay(a, b matrix.Steric, opCode int, gridSize float64) (matrix.Steric, error) {
+	return OverlayContext(context.Background(), a, b, opCode, gridSize)
+}
+
+// OverlayContext returns the result of the overlay operation as Overlay,
+// returning ctx.Err() if the context is done before the overlay is computed.
+func OverlayContext(ctx context.Context, a, b matrix.Steric, opCode int, gridSize float64) (matrix.Steric, error) {
+	if a == nil || b == nil {
+		return nil, algorithm.ErrNilSteric
+	}
+	if gridSize < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if opCode < OpIntersection || opCode > OpSymDifference {
+		return nil, algorithm.ErrWrongUsageFunc
+	}
+	polysA, err := polygons(a)
+	if err != nil {
+		return nil, err
+	}
+	polysB, err := polygons(b)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []matrix.LineMatrix{}
+	owners := []int{}
+	for i, poly := range append(polysA, polysB...) {
+		for _, ring := range poly {
+			lines = append(lines, ring)
+			owners = append(owners, i)
+		}
+	}
+	noder := &noding.Noder{GridSize: gridSize}
+	parts, err := noder.NodeEachContext(ctx, lines...)
+	if err != nil {
+		return nil, err
+	}
+
+	areas := make([]*area, len(polysA)+len(polysB))
+	for i := range areas {
+		areas[i] = &area{}
+	}
+	linework := []matrix.LineMatrix{}
+	for i, v := range parts {
+		areas[owners[i]].add(v)
+		linework = append(linework, v...)
+	}
+
+	selected := []matrix.PolygonMatrix{}
+	faces := polygonize.Polygonize(linework...)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, face := range faces {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		ip := polygonize.InteriorPoint(face)
+		if ip == nil {
+			continue
+		}
+		inA, inB := false, false
+		for i, v := range areas {
+			if !v.contains(ip) {
+				continue
+			}
+			if i < len(polysA) {
+				inA = true
+			} else {
+				inB = true
+			}
+		}
+		if isResult(opCode, inA, inB) {
+			selected = append(selected, face)
+		}
+	}
+	return polygonize.Dissolve(selected), nil
+}
+
+// polygons returns the polygons of a polygonal geometry.
+func polygons(steric matrix.Steric) ([]matrix.PolygonMatrix, error) {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		if s.IsEmpty() {
+			return nil, nil
+		}
+		if err := s.ValidRings(); err != nil {
+			return nil, err
+		}
+		return []matrix.PolygonMatrix{s}, nil
+	case matrix.MultiPolygonMatrix:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			polys, err := polygons(matrix.PolygonMatrix(v))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, polys...)
+		}
+		return result, nil
+	case matrix.Collection:
+		result := []matrix.PolygonMatrix{}
+		for _, v := range s {
+			polys, err := polygons(v)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, polys...)
+		}
+		return result, nil
+	default:
+		return nil, algorithm.ErrNotMatchType
+	}
+}
+
+func isResult(opCode int, inA, inB bool) bool {
+	switch opCode {
+	case OpIntersection:
+		return inA && inB
+	case OpUnion:
+		return inA || inB
+	case OpDifference:
+		return inA && !inB
+	default:
+		return inA != inB
+	}
+}
+
+// area the snap-rounded rings of an input polygon.
+type area struct {
+	edges []matrix.LineMatrix
+	bound []matrix.Matrix
+}
+
+func (a *area) add(edges []matrix.LineMatrix) {
+	for _, e := range edges {
+		b := e.Bound()
+		if a.bound == nil {
+			a.bound = []matrix.Matrix{{b[0][0], b[0][1]}, {b[1][0], b[1][1]}}
+		}
+		for i := 0; i < 2; i++ {
+			if b[0][i] < a.bound[0][i] {
+				a.bound[0][i] = b[0][i]
+			}
+			if b[1][i] > a.bound[1][i] {
+				a.bound[1][i] = b[1][i]
+			}
+		}
+		a.edges = append(a.edges, e)
+	}
+}
+
+// contains returns true if the point is inside the rings, by the even-odd rule.
+// The point must not lie on the rings.
+func (a *area) contains(pt matrix.Matrix) bool {
+	if a.bound == nil || pt[0] < a.bound[0][0] || pt[0] > a.bound[1][0] ||
+		pt[1] < a.bound[0][1] || pt[1] > a.bound[1][1] {
+		return false
+	}
+	inside := false
+	for _, e := range a.edges {
+		for i := 0; i < len(e)-1; i++ {
+			p0, p1 := e[i], e[i+1]
+			if (p0[1] > pt[1]) == (p1[1] > pt[1]) {
+				continue
+			}
+			if x := p0[0] + (pt[1]-p0[1])*(p1[0]-p0[0])/(p1[1]-p0[1]); x > pt[0] {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
@@ -0,0 +1,114 @@
+package snapround
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestOverlay(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	shifted := matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}
+	adjacent := matrix.PolygonMatrix{{{10.0000001, 0}, {20, 0}, {20, 10}, {10.0000001, 10}, {10.0000001, 0}}}
+	inner := matrix.PolygonMatrix{{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}}
+
+	type args struct {
+		a, b     matrix.Steric
+		opCode   int
+		gridSize float64
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantArea  float64
+		wantParts int
+		wantErr   bool
+	}{
+		{name: "intersection", args: args{square, shifted, OpIntersection, 1}, wantArea: 25, wantParts: 1},
+		{name: "union", args: args{square, shifted, OpUnion, 1}, wantArea: 175, wantParts: 1},
+		{name: "difference", args: args{square, shifted, OpDifference, 1}, wantArea: 75, wantParts: 1},
+		{name: "sym difference", args: args{square, shifted, OpSymDifference, 1}, wantArea: 150, wantParts: 2},
+		{name: "union adjacent snapped", args: args{square, adjacent, OpUnion, 0.001}, wantArea: 200, wantParts: 1},
+		{name: "difference hole", args: args{square, inner, OpDifference, 1}, wantArea: 64, wantParts: 1},
+		{name: "intersection disjoint", args: args{inner, matrix.PolygonMatrix{{{20, 20}, {30, 20}, {30, 30}, {20, 20}}}, OpIntersection, 1},
+			wantArea: 0, wantParts: 0},
+		{name: "floating", args: args{square, shifted, OpIntersection, 0}, wantArea: 25, wantParts: 1},
+		{name: "line", args: args{square, matrix.LineMatrix{{0, 0}, {1, 1}}, OpUnion, 1}, wantErr: true},
+		{name: "wrong grid size", args: args{square, shifted, OpUnion, -1}, wantErr: true},
+		{name: "ring not closed", args: args{square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}}}, OpUnion, 1}, wantErr: true},
+		{name: "ring too few points", args: args{square, matrix.MultiPolygonMatrix{{{{5, 5}, {15, 5}, {5, 5}}}}, OpUnion, 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Overlay(tt.args.a, tt.args.b, tt.args.opCode, tt.args.gridSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Overlay() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			parts := 0
+			switch g := got.(type) {
+			case matrix.PolygonMatrix:
+				parts = 1
+			case matrix.Collection:
+				parts = len(g)
+			}
+			if parts != tt.wantParts {
+				t.Errorf("Overlay() parts = %v, want %v", parts, tt.wantParts)
+			}
+			if a := stericArea(got); a != tt.wantArea {
+				t.Errorf("Overlay() area = %v, want %v", a, tt.wantArea)
+			}
+		})
+	}
+}
+
+func TestUnaryUnion(t *testing.T) {
+	multi := matrix.MultiPolygonMatrix{
+		{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+		{{{20, 20}, {30, 20}, {30, 30}, {20, 30}, {20, 20}}},
+	}
+	got, err := UnaryUnion(multi, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mp, ok := got.(matrix.Collection); !ok || len(mp) != 2 {
+		t.Errorf("UnaryUnion() = %v, want 2 polygons", got)
+	}
+	if a := stericArea(got); a != 275 {
+		t.Errorf("UnaryUnion() area = %v, want %v", a, 275)
+	}
+}
+
+func stericArea(steric matrix.Steric) float64 {
+	switch s := steric.(type) {
+	case matrix.PolygonMatrix:
+		return measure.AreaOfPolygon(s)
+	case matrix.Collection:
+		a := 0.0
+		for _, v := range s {
+			a += stericArea(v)
+		}
+		return a
+	}
+	return 0
+}
+
+func TestOverlay_TopologyError(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	_, err := Union(square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}}}, 0)
+	var topoErr *algorithm.TopologyError
+	if !errors.As(err, &topoErr) || !errors.Is(err, algorithm.ErrTopology) {
+		t.Fatalf("Union() error = %v, want TopologyError", err)
+	}
+	if !reflect.DeepEqual(topoErr.Location, []float64{5, 5}) {
+		t.Errorf("TopologyError.Location = %v, want %v", topoErr.Location, []float64{5, 5})
+	}
+}
@@ -1,33 +1,60 @@
 package overlay
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
 // UnaryUnion returns a Geometry containing the union.
 //	or an empty atomic geometry, or an empty GEOMETRYCOLLECTION
 func UnaryUnion(matrix4 matrix.Steric) matrix.Steric {
+	result, _ := UnaryUnionContext(context.Background(), matrix4)
+	return result
+}
+
+// UnaryUnionContext returns a Geometry containing the union as UnaryUnion,
+// returning ctx.Err() if the context is done before the union is computed.
+func UnaryUnionContext(ctx context.Context, matrix4 matrix.Steric) (matrix.Steric, error) {
 	if c, ok := matrix4.(matrix.Collection); ok {
-		return UnaryUnionByHalf(c, 0, len(c))
+		return UnaryUnionByHalfContext(ctx, c, 0, len(c))
 	}
-	return nil
+	return nil, ctx.Err()
 }
 
 // UnaryUnionByHalf returns Unions a section of a list using a recursive binary union on each half of the section.
 func UnaryUnionByHalf(matrix4 matrix.Collection, start, end int) matrix.Steric {
+	result, _ := UnaryUnionByHalfContext(context.Background(), matrix4, start, end)
+	return result
+}
+
+// UnaryUnionByHalfContext returns Unions a section of a list as UnaryUnionByHalf,
+// the context being checked before each union.
+func UnaryUnionByHalfContext(ctx context.Context, matrix4 matrix.Collection, start, end int) (matrix.Steric, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	if matrix4 == nil {
-		return nil
+		return nil, nil
 	}
 	if end-start <= 1 {
-		return Union(matrix4[start], nil)
+		return Union(matrix4[start], nil), nil
 	} else if end-start == 2 {
-		return Union(matrix4[start], matrix4[start+1])
-	} else {
-		mid := (end + start) / 2
-		g0 := UnaryUnionByHalf(matrix4, start, mid)
-		g1 := UnaryUnionByHalf(matrix4, mid, end)
-		return Union(g0, g1)
+		return Union(matrix4[start], matrix4[start+1]), nil
+	}
+	mid := (end + start) / 2
+	g0, err := UnaryUnionByHalfContext(ctx, matrix4, start, mid)
+	if err != nil {
+		return nil, err
+	}
+	g1, err := UnaryUnionByHalfContext(ctx, matrix4, mid, end)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	return Union(g0, g1), nil
 }
 
 // Union  Computes the Union of two geometries,either or both of which may be null.
@@ -1,7 +1,11 @@
 package overlay
 
 import (
+	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spatial-go/geoos"
 	"github.com/spatial-go/geoos/algorithm/matrix"
@@ -94,3 +98,37 @@ func TestUnion(t *testing.T) {
 		})
 	}
 }
+
+func TestUnaryUnionContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	polys := matrix.Collection{
+		matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+		matrix.PolygonMatrix{{{20, 20}, {30, 20}, {30, 30}, {20, 30}, {20, 20}}},
+	}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    matrix.Steric
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), want: UnaryUnion(polys)},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnaryUnionContext(tt.ctx, polys)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnaryUnionContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnaryUnionContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
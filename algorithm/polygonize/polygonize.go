@@ -0,0 +1,369 @@
+// Package polygonize Polygonizes a set of noded lines into the polygons they enclose.
+package polygonize
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// Polygonizer Polygonizes a set of lines which are correctly noded,
+// that is they only meet at their vertices.
+// Dangles (edges with a free end) and cut edges (edges with the same face on both sides)
+// do not form part of any polygon and are reported separately.
+type Polygonizer struct {
+	Polygons []matrix.PolygonMatrix
+	Dangles  []matrix.LineMatrix
+	CutEdges []matrix.LineMatrix
+
+	nodes     []*node
+	halfEdges []*halfEdge
+}
+
+type node struct {
+	pt  matrix.Matrix
+	out []int
+}
+
+type halfEdge struct {
+	orig, dest int
+	angle      float64
+	deleted    bool
+	face       int
+}
+
+// Polygonize returns the polygons formed by the noded linework.
+func Polygonize(lines ...matrix.LineMatrix) []matrix.PolygonMatrix {
+	p := &Polygonizer{}
+	p.Add(lines...)
+	p.Compute()
+	return p.Polygons
+}
+
+// Add adds lines to the linework to be polygonized.
+func (p *Polygonizer) Add(lines ...matrix.LineMatrix) {
+	index := map[[2]float64]int{}
+	for i, n := range p.nodes {
+		index[[2]float64{n.pt[0], n.pt[1]}] = i
+	}
+	edges := map[[2]int]bool{}
+	for _, e := range p.halfEdges {
+		edges[[2]int{e.orig, e.dest}] = true
+	}
+	nodeOf := func(pt []float64) int {
+		key := [2]float64{pt[0], pt[1]}
+		if i, ok := index[key]; ok {
+			return i
+		}
+		index[key] = len(p.nodes)
+		p.nodes = append(p.nodes, &node{pt: matrix.Matrix{pt[0], pt[1]}})
+		return len(p.nodes) - 1
+	}
+	for _, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			u, v := nodeOf(line[i]), nodeOf(line[i+1])
+			if u == v || edges[[2]int{u, v}] {
+				continue
+			}
+			edges[[2]int{u, v}], edges[[2]int{v, u}] = true, true
+			p.addEdge(u, v)
+		}
+	}
+}
+
+func (p *Polygonizer) addEdge(u, v int) {
+	pu, pv := p.nodes[u].pt, p.nodes[v].pt
+	e := len(p.halfEdges)
+	p.halfEdges = append(p.halfEdges,
+		&halfEdge{orig: u, dest: v, angle: math.Atan2(pv[1]-pu[1], pv[0]-pu[0]), face: -1},
+		&halfEdge{orig: v, dest: u, angle: math.Atan2(pu[1]-pv[1], pu[0]-pv[0]), face: -1})
+	p.nodes[u].out = append(p.nodes[u].out, e)
+	p.nodes[v].out = append(p.nodes[v].out, e+1)
+}
+
+// Compute computes the polygons, dangles and cut edges of the linework.
+func (p *Polygonizer) Compute() {
+	p.Polygons, p.Dangles, p.CutEdges = nil, nil, nil
+	for _, n := range p.nodes {
+		out := n.out
+		sort.Slice(out, func(i, j int) bool {
+			return p.halfEdges[out[i]].angle < p.halfEdges[out[j]].angle
+		})
+	}
+	var rings [][]int
+	for {
+		p.deleteDangles()
+		rings = p.traceFaces()
+		if !p.deleteCutEdges() {
+			break
+		}
+	}
+	p.buildPolygons(rings)
+}
+
+func (p *Polygonizer) degree(n int) int {
+	deg := 0
+	for _, e := range p.nodes[n].out {
+		if !p.halfEdges[e].deleted {
+			deg++
+		}
+	}
+	return deg
+}
+
+func (p *Polygonizer) deleteEdge(e int) {
+	p.halfEdges[e].deleted = true
+	p.halfEdges[e^1].deleted = true
+}
+
+func (p *Polygonizer) edgeLine(e int) matrix.LineMatrix {
+	he := p.halfEdges[e]
+	return matrix.LineMatrix{p.nodes[he.orig].pt, p.nodes[he.dest].pt}
+}
+
+// deleteDangles removes all edges which have a free end, repeatedly.
+func (p *Polygonizer) deleteDangles() {
+	stack := []int{}
+	for i := range p.nodes {
+		if p.degree(i) == 1 {
+			stack = append(stack, i)
+		}
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range p.nodes[n].out {
+			if p.halfEdges[e].deleted {
+				continue
+			}
+			p.deleteEdge(e)
+			p.Dangles = append(p.Dangles, p.edgeLine(e))
+			if other := p.halfEdges[e].dest; p.degree(other) == 1 {
+				stack = append(stack, other)
+			}
+		}
+	}
+}
+
+// next returns the half edge following e around the face on the left of e.
+func (p *Polygonizer) next(e int) int {
+	out := p.nodes[p.halfEdges[e].dest].out
+	twin := e ^ 1
+	pos := 0
+	for i, o := range out {
+		if o == twin {
+			pos = i
+			break
+		}
+	}
+	for i := 1; i <= len(out); i++ {
+		o := out[(pos-i+len(out))%len(out)]
+		if !p.halfEdges[o].deleted {
+			return o
+		}
+	}
+	return twin
+}
+
+// traceFaces labels every live half edge with the face on its left and returns the face rings.
+func (p *Polygonizer) traceFaces() [][]int {
+	rings := [][]int{}
+	for _, he := range p.halfEdges {
+		he.face = -1
+	}
+	for i, he := range p.halfEdges {
+		if he.deleted || he.face >= 0 {
+			continue
+		}
+		ring := []int{}
+		for e := i; p.halfEdges[e].face < 0 && len(ring) <= len(p.halfEdges); e = p.next(e) {
+			p.halfEdges[e].face = len(rings)
+			ring = append(ring, e)
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+// deleteCutEdges removes edges which have the same face on both sides.
+func (p *Polygonizer) deleteCutEdges() bool {
+	found := false
+	for i := 0; i < len(p.halfEdges); i += 2 {
+		he := p.halfEdges[i]
+		if he.deleted || he.face != p.halfEdges[i+1].face {
+			continue
+		}
+		p.deleteEdge(i)
+		p.CutEdges = append(p.CutEdges, p.edgeLine(i))
+		found = true
+	}
+	return found
+}
+
+func (p *Polygonizer) buildPolygons(rings [][]int) {
+	type shell struct {
+		ring matrix.LineMatrix
+		area float64
+		poly matrix.PolygonMatrix
+	}
+	shells := []*shell{}
+	holes := []matrix.LineMatrix{}
+	for _, r := range rings {
+		// rings start at their lowest vertex, so the output does not depend on the tracing order.
+		first := 0
+		for i, e := range r {
+			pt, low := p.nodes[p.halfEdges[e].orig].pt, p.nodes[p.halfEdges[r[first]].orig].pt
+			if pt[0] < low[0] || (pt[0] == low[0] && pt[1] < low[1]) {
+				first = i
+			}
+		}
+		ring := make(matrix.LineMatrix, 0, len(r)+1)
+		for i := range r {
+			ring = append(ring, p.nodes[p.halfEdges[r[(first+i)%len(r)]].orig].pt)
+		}
+		ring = append(ring, ring[0])
+		if len(ring) < calc.MinRingSize+1 {
+			continue
+		}
+		// faces are traced with the face on the left, so shells are counter-clockwise,
+		// measure.AreaDirection is negative for counter-clockwise rings.
+		if area := -measure.AreaDirection(ring); area > 0 {
+			shells = append(shells, &shell{ring: ring, area: area, poly: matrix.PolygonMatrix{ring}})
+		} else if area < 0 {
+			holes = append(holes, ring)
+		}
+	}
+	for _, hole := range holes {
+		holeArea := measure.Area(hole)
+		var owner *shell
+		for _, s := range shells {
+			if s.area <= holeArea || (owner != nil && s.area >= owner.area) {
+				continue
+			}
+			if !s.ring.Bound().ContainsBound(hole.Bound()) {
+				continue
+			}
+			if isRingInside(hole, s.ring) {
+				owner = s
+			}
+		}
+		if owner != nil {
+			owner.poly = append(owner.poly, hole)
+		}
+	}
+	for _, s := range shells {
+		p.Polygons = append(p.Polygons, s.poly)
+	}
+}
+
+// isRingInside returns true if the first vertex of ring not on the boundary of shell is inside it.
+func isRingInside(ring, shell matrix.LineMatrix) bool {
+	for _, v := range ring {
+		switch relate.LocateInRing(v, shell) {
+		case calc.ImInterior:
+			return true
+		case calc.ImExterior:
+			return false
+		}
+	}
+	return false
+}
+
+// InteriorPoint returns a point in the interior of the polygon,
+// the midpoint of the widest interior section of a horizontal scan line which avoids the vertices.
+func InteriorPoint(poly matrix.PolygonMatrix) matrix.Matrix {
+	bound := poly.Bound()
+	centreY := (bound[0][1] + bound[1][1]) / 2
+	loY, hiY := math.Inf(-1), math.Inf(1)
+	for _, ring := range poly {
+		for _, v := range ring {
+			if v[1] <= centreY {
+				loY = math.Max(loY, v[1])
+			} else {
+				hiY = math.Min(hiY, v[1])
+			}
+		}
+	}
+	if math.IsInf(loY, 0) || math.IsInf(hiY, 0) {
+		return nil
+	}
+	scanY := (loY + hiY) / 2
+	xs := []float64{}
+	for _, ring := range poly {
+		for i := 0; i < len(ring)-1; i++ {
+			a, b := ring[i], ring[i+1]
+			if (a[1] > scanY) != (b[1] > scanY) {
+				xs = append(xs, a[0]+(scanY-a[1])*(b[0]-a[0])/(b[1]-a[1]))
+			}
+		}
+	}
+	sort.Float64s(xs)
+	var ip matrix.Matrix
+	width := -1.0
+	for i := 0; i+1 < len(xs); i += 2 {
+		if w := xs[i+1] - xs[i]; w > width {
+			width = w
+			ip = matrix.Matrix{(xs[i] + xs[i+1]) / 2, scanY}
+		}
+	}
+	return ip
+}
+
+// Dissolve merges the adjacent faces, which must only meet along common segments,
+// returns a Polygon, or a Collection of Polygons if they form several or no polygons.
+// The rings of the faces have the face on their left, the boundary segments of the merged faces
+// keep that direction, so the polygons formed by the boundary which are faces of the result
+// are those whose shell runs in the direction of the boundary.
+func Dissolve(faces []matrix.PolygonMatrix) matrix.Steric {
+	count := map[[4]float64]int{}
+	directed := map[[4]float64]bool{}
+	for _, face := range faces {
+		for _, ring := range face {
+			for i := 0; i < len(ring)-1; i++ {
+				count[segmentKey(ring[i], ring[i+1])]++
+				directed[[4]float64{ring[i][0], ring[i][1], ring[i+1][0], ring[i+1][1]}] = true
+			}
+		}
+	}
+	keys := make([][4]float64, 0, len(count))
+	for k, v := range count {
+		if v == 1 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		for k := 0; k < 4; k++ {
+			if keys[i][k] != keys[j][k] {
+				return keys[i][k] < keys[j][k]
+			}
+		}
+		return false
+	})
+	boundary := make([]matrix.LineMatrix, 0, len(keys))
+	for _, k := range keys {
+		boundary = append(boundary, matrix.LineMatrix{{k[0], k[1]}, {k[2], k[3]}})
+	}
+	result := matrix.Collection{}
+	for _, poly := range Polygonize(boundary...) {
+		shell := poly[0]
+		if directed[[4]float64{shell[0][0], shell[0][1], shell[1][0], shell[1][1]}] {
+			result = append(result, poly)
+		}
+	}
+	if len(result) == 1 {
+		return result[0]
+	}
+	return result
+}
+
+// segmentKey returns a key identifying the segment regardless of its direction.
+func segmentKey(p0, p1 []float64) [4]float64 {
+	if p0[0] > p1[0] || (p0[0] == p1[0] && p0[1] > p1[1]) {
+		p0, p1 = p1, p0
+	}
+	return [4]float64{p0[0], p0[1], p1[0], p1[1]}
+}
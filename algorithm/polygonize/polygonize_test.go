@@ -0,0 +1,45 @@
+package polygonize
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestPolygonize(t *testing.T) {
+	tests := []struct {
+		name         string
+		lines        []matrix.LineMatrix
+		wantPolygons int
+		wantHoles    int
+		wantDangles  int
+		wantCutEdges int
+	}{
+		{"square", []matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, 1, 0, 0, 0},
+		{"two faces", []matrix.LineMatrix{
+			{{0, 0}, {10, 0}, {10, 5}}, {{10, 5}, {10, 10}, {0, 10}, {0, 5}}, {{0, 5}, {0, 0}}, {{0, 5}, {10, 5}},
+		}, 2, 0, 0, 0},
+		{"nested ring", []matrix.LineMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}},
+		}, 2, 1, 0, 0},
+		{"dangle", []matrix.LineMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{0, 0}, {-5, -5}}}, 1, 0, 1, 0},
+		{"cut edge", []matrix.LineMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}, {{0, 0}, {4, 4}},
+		}, 2, 1, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Polygonizer{}
+			p.Add(tt.lines...)
+			p.Compute()
+			holes := 0
+			for _, v := range p.Polygons {
+				holes += len(v) - 1
+			}
+			if len(p.Polygons) != tt.wantPolygons || holes != tt.wantHoles ||
+				len(p.Dangles) != tt.wantDangles || len(p.CutEdges) != tt.wantCutEdges {
+				t.Errorf("Polygonize() polygons = %v, dangles = %v, cut edges = %v", p.Polygons, p.Dangles, p.CutEdges)
+			}
+		})
+	}
+}
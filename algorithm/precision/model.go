@@ -0,0 +1,119 @@
+// Package precision Specifies the precision model of coordinates and reduces the precision of geometries.
+package precision
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// const precision model types.
+const (
+	// TypeFloating full double precision floating point.
+	TypeFloating = iota
+	// TypeFloatingSingle single precision floating point.
+	TypeFloatingSingle
+	// TypeFixed a fixed number of decimal places, coordinates are rounded to a grid.
+	TypeFixed
+)
+
+// Model Specifies the precision model of coordinates.
+type Model struct {
+	modelType int
+	scale     float64
+}
+
+var (
+	// Floating the precision model of full double precision floating point coordinates.
+	Floating = Model{modelType: TypeFloating}
+	// FloatingSingle the precision model of single precision floating point coordinates.
+	FloatingSingle = Model{modelType: TypeFloatingSingle}
+)
+
+// Fixed returns a fixed precision model, coordinates are rounded to multiples of 1/scale,
+// e.g. a scale of 1000 keeps 3 decimal places.
+func Fixed(scale float64) Model {
+	return Model{modelType: TypeFixed, scale: scale}
+}
+
+// FixedGrid returns a fixed precision model rounding coordinates to a grid of gridSize.
+func FixedGrid(gridSize float64) Model {
+	return Fixed(1 / gridSize)
+}
+
+// Type returns the type of the precision model.
+func (m Model) Type() int {
+	return m.modelType
+}
+
+// Scale returns the scale of a fixed precision model, 0 for floating models.
+func (m Model) Scale() float64 {
+	return m.scale
+}
+
+// GridSize returns the grid size of a fixed precision model, 0 for floating models.
+func (m Model) GridSize() float64 {
+	if m.modelType != TypeFixed || m.scale == 0 {
+		return 0
+	}
+	return 1 / m.scale
+}
+
+// IsFloating returns true if the precision model is floating.
+func (m Model) IsFloating() bool {
+	return m.modelType != TypeFixed
+}
+
+// MakePrecise rounds the value to the precision model.
+func (m Model) MakePrecise(v float64) float64 {
+	switch m.modelType {
+	case TypeFloatingSingle:
+		return float64(float32(v))
+	case TypeFixed:
+		if m.scale == 0 {
+			return v
+		}
+		return math.Round(v*m.scale) / m.scale
+	default:
+		return v
+	}
+}
+
+// MakePreciseSteric returns a copy of the steric with all its coordinates rounded to the precision model.
+// No attempt is made to keep the result valid, see Reduce.
+func (m Model) MakePreciseSteric(steric matrix.Steric) matrix.Steric {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		result := make(matrix.Matrix, len(s))
+		for i, v := range s {
+			result[i] = m.MakePrecise(v)
+		}
+		return result
+	case matrix.LineMatrix:
+		result := make(matrix.LineMatrix, len(s))
+		for i, v := range s {
+			result[i] = m.MakePreciseSteric(matrix.Matrix(v)).(matrix.Matrix)
+		}
+		return result
+	case matrix.PolygonMatrix:
+		result := make(matrix.PolygonMatrix, len(s))
+		for i, v := range s {
+			result[i] = m.MakePreciseSteric(matrix.LineMatrix(v)).(matrix.LineMatrix)
+		}
+		return result
+	case matrix.MultiPolygonMatrix:
+		result := make(matrix.MultiPolygonMatrix, len(s))
+		for i, v := range s {
+			result[i] = m.MakePreciseSteric(matrix.PolygonMatrix(v)).(matrix.PolygonMatrix)
+		}
+		return result
+	case matrix.Collection:
+		result := make(matrix.Collection, len(s))
+		for i, v := range s {
+			result[i] = m.MakePreciseSteric(v)
+		}
+		return result
+	default:
+		return steric
+	}
+}
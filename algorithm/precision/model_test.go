@@ -0,0 +1,63 @@
+package precision
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestModel_MakePrecise(t *testing.T) {
+	tests := []struct {
+		name  string
+		model Model
+		v     float64
+		want  float64
+	}{
+		{name: "floating", model: Floating, v: 1.23456789, want: 1.23456789},
+		{name: "floating single", model: FloatingSingle, v: 0.1, want: float64(float32(0.1))},
+		{name: "fixed", model: Fixed(100), v: 1.23456789, want: 1.23},
+		{name: "fixed grid", model: FixedGrid(5), v: 12.6, want: 15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.MakePrecise(tt.v); got != tt.want {
+				t.Errorf("MakePrecise() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	tests := []struct {
+		name     string
+		steric   matrix.Steric
+		gridSize float64
+		want     matrix.Steric
+		wantErr  bool
+	}{
+		{name: "point", steric: matrix.Matrix{1.26, 2.74}, gridSize: 0.5, want: matrix.Matrix{1.5, 2.5}},
+		{name: "line", steric: matrix.LineMatrix{{0, 0}, {0.1, 0.1}, {1.9, 2.1}}, gridSize: 1,
+			want: matrix.LineMatrix{{0, 0}, {2, 2}}},
+		{name: "collapsed line", steric: matrix.LineMatrix{{0, 0}, {0.1, 0.1}}, gridSize: 1,
+			want: matrix.LineMatrix{}},
+		{name: "polygon", steric: matrix.PolygonMatrix{{{0.1, 0}, {10.2, 0.1}, {9.9, 10}, {0, 10.3}, {0.1, 0}}}, gridSize: 1,
+			want: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}},
+		{name: "collapsed polygon", steric: matrix.PolygonMatrix{{{0, 0}, {0.2, 0}, {0.2, 0.2}, {0, 0}}}, gridSize: 1,
+			want: matrix.Collection{}},
+		{name: "unchanged", steric: matrix.Matrix{1.26, 2.74}, gridSize: 0, want: matrix.Matrix{1.26, 2.74}},
+		{name: "wrong grid size", steric: matrix.Matrix{1, 2}, gridSize: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reduce(tt.steric, tt.gridSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Reduce() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
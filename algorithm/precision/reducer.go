@@ -0,0 +1,86 @@
+package precision
+
+import (
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+)
+
+// Reduce returns the steric with its coordinates rounded to a grid of gridSize.
+// Repeated points are removed and lines which collapse to a point are removed,
+// polygonal components are snap-rounded and unioned, so the result is valid.
+// A zero gridSize returns the steric unchanged.
+func Reduce(steric matrix.Steric, gridSize float64) (matrix.Steric, error) {
+	if steric == nil {
+		return nil, algorithm.ErrNilSteric
+	}
+	if gridSize < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if gridSize == 0 {
+		return steric, nil
+	}
+	return reduce(steric, FixedGrid(gridSize))
+}
+
+func reduce(steric matrix.Steric, m Model) (matrix.Steric, error) {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		return m.MakePreciseSteric(s), nil
+	case matrix.LineMatrix:
+		return reduceLine(s, m), nil
+	case matrix.PolygonMatrix, matrix.MultiPolygonMatrix:
+		return snapround.UnaryUnion(s, m.GridSize())
+	case matrix.Collection:
+		if isPolygonal(s) {
+			return snapround.UnaryUnion(s, m.GridSize())
+		}
+		result := matrix.Collection{}
+		for _, v := range s {
+			r, err := reduce(v, m)
+			if err != nil {
+				return nil, err
+			}
+			if !r.IsEmpty() {
+				result = append(result, r)
+			}
+		}
+		return result, nil
+	default:
+		return nil, algorithm.ErrUnknownType(steric)
+	}
+}
+
+// reduceLine rounds the line, removing repeated points, returns an empty line if it collapses.
+func reduceLine(line matrix.LineMatrix, m Model) matrix.LineMatrix {
+	result := matrix.LineMatrix{}
+	for _, v := range line {
+		pt := m.MakePreciseSteric(matrix.Matrix(v)).(matrix.Matrix)
+		if len(result) > 0 && pt.Equals(matrix.Matrix(result[len(result)-1])) {
+			continue
+		}
+		result = append(result, pt)
+	}
+	if len(result) < 2 {
+		return matrix.LineMatrix{}
+	}
+	return result
+}
+
+func isPolygonal(coll matrix.Collection) bool {
+	if len(coll) == 0 {
+		return false
+	}
+	for _, v := range coll {
+		switch c := v.(type) {
+		case matrix.PolygonMatrix, matrix.MultiPolygonMatrix:
+		case matrix.Collection:
+			if !isPolygonal(c) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+package relate
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// BoundaryNodeRule decides which endpoints of the lines of a lineal geometry are in its boundary,
+// from the number of lines ending at the point, a closed line ending twice at its first point.
+type BoundaryNodeRule int
+
+// const boundary node rules.
+const (
+	// Mod2 the OGC SFS rule, the points at which an odd number of lines end are in the boundary.
+	Mod2 BoundaryNodeRule = iota
+	// EndPoint all the endpoints of the lines are in the boundary, also those of closed lines.
+	EndPoint
+	// MultivalentEndPoint the points at which more than one line end are in the boundary.
+	MultivalentEndPoint
+	// MonovalentEndPoint the points at which exactly one line ends are in the boundary.
+	MonovalentEndPoint
+)
+
+// IsValid returns true if the rule is one of the boundary node rules.
+func (r BoundaryNodeRule) IsValid() bool {
+	return r >= Mod2 && r <= MonovalentEndPoint
+}
+
+// IsInBoundary tests whether a point at which count lines end is in the boundary.
+func (r BoundaryNodeRule) IsInBoundary(count int) bool {
+	switch r {
+	case EndPoint:
+		return count > 0
+	case MultivalentEndPoint:
+		return count > 1
+	case MonovalentEndPoint:
+		return count == 1
+	}
+	return count%2 == 1
+}
+
+// Locator Locates points in a geometry, the boundary of its lines given by a boundary node rule.
+type Locator struct {
+	steric   matrix.Steric
+	boundary []matrix.Matrix
+}
+
+// NewLocator returns a locator of points in the steric with the boundary node rule.
+func NewLocator(steric matrix.Steric, rule BoundaryNodeRule) *Locator {
+	l := &Locator{steric: steric}
+	endpoints := []matrix.Matrix{}
+	counts := []int{}
+	for _, line := range lines(steric) {
+		if len(line) == 0 {
+			continue
+		}
+		for _, p := range []matrix.Matrix{line[0], line[len(line)-1]} {
+			found := false
+			for i, v := range endpoints {
+				if v.Equals(p) {
+					counts[i]++
+					found = true
+					break
+				}
+			}
+			if !found {
+				endpoints = append(endpoints, p)
+				counts = append(counts, 1)
+			}
+		}
+	}
+	for i, p := range endpoints {
+		if rule.IsInBoundary(counts[i]) {
+			l.boundary = append(l.boundary, p)
+		}
+	}
+	return l
+}
+
+// Boundary returns the points of the boundary of the lines of the geometry.
+func (l *Locator) Boundary() []matrix.Matrix {
+	return l.boundary
+}
+
+// Locate returns the location of the point in the geometry, calc.ImInterior, calc.ImBoundary or calc.ImExterior.
+// A point in the interior of a part is in the interior, else a point in the boundary of a part in the boundary.
+func (l *Locator) Locate(point matrix.Matrix) int {
+	for _, v := range l.boundary {
+		if v.Equals(point) {
+			return calc.ImBoundary
+		}
+	}
+	return l.locate(point, l.steric)
+}
+
+// locate returns the location of the point in the steric, the boundaries of lines being excluded.
+func (l *Locator) locate(point matrix.Matrix, steric matrix.Steric) int {
+	switch s := steric.(type) {
+	case matrix.Matrix:
+		if s.Equals(point) {
+			return calc.ImInterior
+		}
+	case matrix.LineMatrix:
+		for i := 1; i < len(s); i++ {
+			if onSegment(point, s[i-1], s[i]) {
+				return calc.ImInterior
+			}
+		}
+	case matrix.PolygonMatrix:
+		if len(s) > 0 {
+			return LocateInPolygon(point, s)
+		}
+	case matrix.MultiPolygonMatrix:
+		return l.locate(point, multiPolygonCollection(s))
+	case matrix.Collection:
+		location := calc.ImExterior
+		for _, v := range s {
+			switch l.locate(point, v) {
+			case calc.ImInterior:
+				return calc.ImInterior
+			case calc.ImBoundary:
+				location = calc.ImBoundary
+			}
+		}
+		return location
+	}
+	return calc.ImExterior
+}
+
+// onSegment tests whether the point is exactly on the segment a-b.
+func onSegment(p, a, b []float64) bool {
+	if (b[0]-a[0])*(p[1]-a[1])-(b[1]-a[1])*(p[0]-a[0]) != 0 {
+		return false
+	}
+	return p[0] >= math.Min(a[0], b[0]) && p[0] <= math.Max(a[0], b[0]) &&
+		p[1] >= math.Min(a[1], b[1]) && p[1] <= math.Max(a[1], b[1])
+}
+
+// lines returns the lines of the steric, the rings of polygons being left out.
+func lines(steric matrix.Steric) []matrix.LineMatrix {
+	switch s := steric.(type) {
+	case matrix.LineMatrix:
+		return []matrix.LineMatrix{s}
+	case matrix.Collection:
+		result := []matrix.LineMatrix{}
+		for _, v := range s {
+			result = append(result, lines(v)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// multiPolygonCollection returns the polygons of the multipolygon as a collection.
+func multiPolygonCollection(m matrix.MultiPolygonMatrix) matrix.Collection {
+	c := matrix.Collection{}
+	for _, v := range m {
+		c = append(c, matrix.PolygonMatrix(v))
+	}
+	return c
+}
@@ -0,0 +1,41 @@
+package relate
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestLocator_Locate(t *testing.T) {
+	network := matrix.Collection{
+		matrix.LineMatrix{{0, 0}, {1, 0}},
+		matrix.LineMatrix{{1, 0}, {2, 0}},
+		matrix.LineMatrix{{2, 0}, {2, 1}, {3, 1}, {2, 0}},
+		matrix.PolygonMatrix{{{-1, -1}, {-2, -1}, {-2, -2}, {-1, -1}}},
+	}
+	tests := []struct {
+		name  string
+		rule  BoundaryNodeRule
+		point matrix.Matrix
+		want  int
+	}{
+		{"mod2 end", Mod2, matrix.Matrix{0, 0}, calc.ImBoundary},
+		{"mod2 node", Mod2, matrix.Matrix{1, 0}, calc.ImInterior},
+		{"mod2 closed", Mod2, matrix.Matrix{2, 0}, calc.ImBoundary},
+		{"endpoint node", EndPoint, matrix.Matrix{1, 0}, calc.ImBoundary},
+		{"multivalent end", MultivalentEndPoint, matrix.Matrix{0, 0}, calc.ImInterior},
+		{"multivalent closed", MultivalentEndPoint, matrix.Matrix{2, 0}, calc.ImBoundary},
+		{"monovalent node", MonovalentEndPoint, matrix.Matrix{1, 0}, calc.ImInterior},
+		{"line", EndPoint, matrix.Matrix{0.5, 0}, calc.ImInterior},
+		{"ring", EndPoint, matrix.Matrix{-1.5, -1}, calc.ImBoundary},
+		{"exterior", EndPoint, matrix.Matrix{0.5, 0.5}, calc.ImExterior},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewLocator(network, tt.rule).Locate(tt.point); got != tt.want {
+				t.Errorf("Locate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
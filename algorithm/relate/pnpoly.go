@@ -1,6 +1,9 @@
 package relate
 
 import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -36,3 +39,52 @@ func rayIntersectsSegment(p, a, b matrix.Matrix) bool {
 	return (a[1] > p[1]) != (b[1] > p[1]) &&
 		p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0]
 }
+
+// LocateInRing returns the location of point relative to the closed ring,
+// one of calc.ImInterior, calc.ImBoundary or calc.ImExterior.
+// Unlike InPolygon, points exactly on a segment or vertex of ring are reported as boundary.
+func LocateInRing(point matrix.Matrix, ring matrix.LineMatrix) int {
+	if len(ring) < 3 {
+		return calc.ImExterior
+	}
+	crossings := 0
+	for i := 0; i < len(ring)-1; i++ {
+		a, b := matrix.Matrix(ring[i]), matrix.Matrix(ring[i+1])
+		orient := (b[0]-a[0])*(point[1]-a[1]) - (point[0]-a[0])*(b[1]-a[1])
+		if orient == 0 &&
+			point[0] >= math.Min(a[0], b[0]) && point[0] <= math.Max(a[0], b[0]) &&
+			point[1] >= math.Min(a[1], b[1]) && point[1] <= math.Max(a[1], b[1]) {
+			return calc.ImBoundary
+		}
+		if (a[1] > point[1]) != (b[1] > point[1]) {
+			if (b[1] > a[1]) == (orient > 0) {
+				crossings++
+			}
+		}
+	}
+	if crossings%2 == 1 {
+		return calc.ImInterior
+	}
+	return calc.ImExterior
+}
+
+// LocateInPolygon returns the location of point relative to the polygon with holes,
+// one of calc.ImInterior, calc.ImBoundary or calc.ImExterior.
+func LocateInPolygon(point matrix.Matrix, poly matrix.PolygonMatrix) int {
+	if len(poly) == 0 {
+		return calc.ImExterior
+	}
+	loc := LocateInRing(point, poly[0])
+	if loc != calc.ImInterior {
+		return loc
+	}
+	for _, hole := range poly[1:] {
+		switch LocateInRing(point, hole) {
+		case calc.ImInterior:
+			return calc.ImExterior
+		case calc.ImBoundary:
+			return calc.ImBoundary
+		}
+	}
+	return calc.ImInterior
+}
@@ -3,6 +3,7 @@ package relate
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -153,3 +154,29 @@ func Test_rayIntersectsSegment(t *testing.T) {
 		})
 	}
 }
+
+func TestLocateInPolygon(t *testing.T) {
+	poly := matrix.PolygonMatrix{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2.5, 2.5}, {7.5, 2.5}, {7.5, 7.5}, {2.5, 7.5}, {2.5, 2.5}},
+	}
+	tests := []struct {
+		name  string
+		point matrix.Matrix
+		want  int
+	}{
+		{"interior", matrix.Matrix{1, 1}, calc.ImInterior},
+		{"shell vertex", matrix.Matrix{0, 0}, calc.ImBoundary},
+		{"shell edge", matrix.Matrix{5, 10}, calc.ImBoundary},
+		{"hole edge", matrix.Matrix{2.5, 5}, calc.ImBoundary},
+		{"in hole", matrix.Matrix{5, 5}, calc.ImExterior},
+		{"exterior", matrix.Matrix{-10, 5}, calc.ImExterior},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocateInPolygon(tt.point, poly); got != tt.want {
+				t.Errorf("LocateInPolygon(%v) = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package subdivision
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"github.com/spatial-go/geoos/algorithm/subdivision/quadedge"
@@ -27,7 +29,7 @@ func (d *DelaunayTriangulation) create() {
 	d.computeEnvelope()
 	d.subdivision = quadedge.NewQuadEdgeSubdivision(d.sitesEnv, 0.0)
 	triangulator := NewIncrementalDelaunayTriangulator(d.subdivision)
-	triangulator.insertSites(d.sites)
+	_ = triangulator.insertSites(context.Background(), d.sites)
 }
 
 // Subdivision ...
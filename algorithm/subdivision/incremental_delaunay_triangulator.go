@@ -1,6 +1,8 @@
 package subdivision
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/subdivision/quadedge"
 )
@@ -17,10 +19,15 @@ func NewIncrementalDelaunayTriangulator(subdivision *quadedge.Subdivision) *Incr
 	}
 }
 
-func (t *IncrementalDelaunayTriangulator) insertSites(sites []matrix.Matrix) {
+// insertSites inserts the sites to subdivision, returning ctx.Err() if the context is done before all are inserted.
+func (t *IncrementalDelaunayTriangulator) insertSites(ctx context.Context, sites []matrix.Matrix) error {
 	for _, site := range sites {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		t.insertSite(site)
 	}
+	return nil
 }
 
 // insertSite insert a new point to subdivision representing a Delaunay Triangulator
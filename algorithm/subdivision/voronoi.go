@@ -2,6 +2,8 @@
 package subdivision
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
@@ -61,11 +63,18 @@ func (v *Voronoi) clearResult() {
 
 // GetResult return result of voronoi
 func (v *Voronoi) GetResult() []matrix.PolygonMatrix {
+	result, _ := v.GetResultContext(context.Background())
+	return result
+}
+
+// GetResultContext return result of voronoi as GetResult,
+// returning ctx.Err() if the context is done before the result is computed.
+func (v *Voronoi) GetResultContext(ctx context.Context) ([]matrix.PolygonMatrix, error) {
 	if v.result != nil {
-		return v.result
+		return v.result, nil
 	}
 	if len(v.sites) == 0 {
-		return v.result
+		return v.result, nil
 	}
 	if v.envelope.IsNil() {
 		v.envelope = envelope.Empty()
@@ -73,20 +82,26 @@ func (v *Voronoi) GetResult() []matrix.PolygonMatrix {
 			v.envelope.ExpandToIncludeMatrix(site)
 		}
 		if v.envelope.IsNil() {
-			return v.result
+			return v.result, nil
 		}
 	}
 	v.subdivision = quadedge.NewQuadEdgeSubdivision(v.envelope, 0.0)
 	triangulator := NewIncrementalDelaunayTriangulator(v.subdivision)
-	triangulator.insertSites(v.sites)
+	if err := triangulator.insertSites(ctx, v.sites); err != nil {
+		return nil, err
+	}
 
 	polygons := v.subdivision.GetVoronoiCellPolygons()
 
-	v.result = clipPolygons(polygons, v.envelope)
-	return v.result
+	result, err := clipPolygons(ctx, polygons, v.envelope)
+	if err != nil {
+		return nil, err
+	}
+	v.result = result
+	return v.result, nil
 }
 
-func clipPolygons(polygons []matrix.PolygonMatrix, env *envelope.Envelope) (clippedPolygons []matrix.PolygonMatrix) {
+func clipPolygons(ctx context.Context, polygons []matrix.PolygonMatrix, env *envelope.Envelope) (clippedPolygons []matrix.PolygonMatrix, err error) {
 	if env.IsNil() {
 		return
 	}
@@ -96,6 +111,9 @@ func clipPolygons(polygons []matrix.PolygonMatrix, env *envelope.Envelope) (clip
 	clippedPolygons = make([]matrix.PolygonMatrix, 0, len(polygons))
 	clipEnv := env.ToMatrix()
 	for _, polygon := range polygons {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		polygonEnv := envelope.Bound(polygon.Bound())
 		if env.Contains(polygonEnv) {
 			clippedPolygons = append(clippedPolygons, polygon)
@@ -1,7 +1,11 @@
 package subdivision
 
 import (
+	"context"
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
@@ -73,3 +77,38 @@ func TestVoronoi_GetResult(t *testing.T) {
 		})
 	}
 }
+
+func TestVoronoi_GetResultContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	sites := []matrix.Matrix{{4, 3}, {15, 0}, {0, 4}, {15, 11}, {10, 3}}
+	v := NewVoronoi()
+	v.AddSites(sites)
+	want := v.GetResult()
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    []matrix.PolygonMatrix
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), want: want},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVoronoi()
+			v.AddSites(sites)
+			got, err := v.GetResultContext(tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetResultContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetResultContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -50,6 +50,10 @@ func TestEncode(t *testing.T) {
 }
 
 func TestDecode(t *testing.T) {
+	multiPolygon := space.MultiPolygon{
+		{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, {{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+		{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}},
+	}
 	type args struct {
 		s        []byte
 		codeType int
@@ -87,6 +91,8 @@ func TestDecode(t *testing.T) {
 			[]byte{16, 2, 24, 9, 50, 14, 26, 12, 222, 144, 246, 201, 226, 6, 154, 190, 198, 171, 170, 2}, Geobuf},
 			want: space.Point{116.310066223145, 40.0425491333008},
 		},
+		{name: "geobuf malformed", args: args{[]byte{16, 2, 24, 9, 50, 14, 26}, Geobuf}, wantErr: true},
+		{name: "geobuf multipolygon", args: args{Encode(multiPolygon, Geobuf), Geobuf}, want: multiPolygon},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -95,6 +101,9 @@ func TestDecode(t *testing.T) {
 				t.Errorf("Decode() %v error = %v, wantErr %v", tt.name, err, tt.wantErr)
 				return
 			}
+			if err != nil {
+				return
+			}
 			if !got.EqualsExact(tt.want, 0.000001) {
 				t.Errorf("Decode()%v %T= %v, want %v", tt.name, got, got, tt.want)
 			}
@@ -432,3 +441,24 @@ func TestWriteGeoJSON(t *testing.T) {
 		})
 	}
 }
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte(`GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 1,2 2),POLYGON((0 0,1 0,1 1,0 0)))`), uint8(WKT))
+	f.Add([]byte("0102000020E610000002000000F7FFFF7F20155D40C9D9B446F6F843400F000020B51C5D409241C66566F94340"), uint8(WKB))
+	f.Add([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`), uint8(GeoJSON))
+	f.Add([]byte("way_id,pt_id,x,y\n0,0,116.31,40.04\n"), uint8(GeoCSV))
+	f.Add([]byte{16, 2, 24, 9, 50, 14, 26, 12, 222, 144, 246, 201, 226, 6, 154, 190, 198, 171, 170, 2}, uint8(Geobuf))
+	f.Add([]byte("0000000002000000000"), uint8(WKB))
+	f.Add([]byte("0"), uint8(GeoJSON))
+	f.Add([]byte("\xf5"), uint8(GeoCSV))
+	f.Add([]byte("*\x00"), uint8(Geobuf))
+	f.Fuzz(func(t *testing.T, data []byte, codeType uint8) {
+		codeType %= Geobuf + 1
+		geom, err := Decode(data, int(codeType))
+		if err != nil || geom == nil {
+			return
+		}
+		_ = geom.IsEmpty()
+		_ = Encode(geom, int(codeType))
+	})
+}
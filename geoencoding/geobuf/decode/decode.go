@@ -2,12 +2,17 @@
 package decode
 
 import (
+	"fmt"
+
 	"github.com/spatial-go/geoos/geoencoding/geobuf/protogeo"
 	"github.com/spatial-go/geoos/geoencoding/geojson"
 )
 
+// ErrInvalidGeobuf ...
+var ErrInvalidGeobuf = fmt.Errorf("Geobuf data is invalid")
+
 // Decode ...
-func Decode(msg *protogeo.Data) interface{} {
+func Decode(msg *protogeo.Data) (interface{}, error) {
 	switch v := msg.DataType.(type) {
 	case *protogeo.Data_Geometry_:
 		geo := v.Geometry
@@ -16,10 +21,14 @@ func Decode(msg *protogeo.Data) interface{} {
 		return Feature(msg, v.Feature)
 	case *protogeo.Data_FeatureCollection_:
 		collection := geojson.NewFeatureCollection()
-		for _, feature := range v.FeatureCollection.Features {
-			collection.Append(Feature(msg, feature))
+		for _, feature := range v.FeatureCollection.GetFeatures() {
+			f, err := Feature(msg, feature)
+			if err != nil {
+				return nil, err
+			}
+			collection.Append(f)
 		}
-		return collection
+		return collection, nil
 	}
-	return struct{}{}
+	return struct{}{}, nil
 }
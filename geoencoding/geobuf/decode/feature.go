@@ -7,9 +7,15 @@ import (
 )
 
 // Feature ...
-func Feature(data *protogeo.Data, feature *protogeo.Data_Feature) *geojson.Feature {
+func Feature(data *protogeo.Data, feature *protogeo.Data_Feature) (*geojson.Feature, error) {
+	if feature == nil {
+		return nil, ErrInvalidGeobuf
+	}
 	geo := feature.Geometry
-	decodedGeo := Geometry(geo, data.Precision, data.Dimensions)
+	decodedGeo, err := Geometry(geo, data.Precision, data.Dimensions)
+	if err != nil {
+		return nil, err
+	}
 	var geoFeature *geojson.Feature
 	switch decodedGeo.Type {
 	case space.TypeCollection:
@@ -25,6 +31,10 @@ func Feature(data *protogeo.Data, feature *protogeo.Data_Feature) *geojson.Featu
 	}
 
 	for i := 0; i < len(feature.Properties); i = i + 2 {
+		if i+1 >= len(feature.Properties) || int(feature.Properties[i]) >= len(data.Keys) ||
+			int(feature.Properties[i+1]) >= len(feature.Values) || feature.Values[feature.Properties[i+1]] == nil {
+			return nil, ErrInvalidGeobuf
+		}
 		valIdx := feature.Properties[i+1]
 		val := feature.Values[valIdx]
 		switch actualVal := val.ValueType.(type) {
@@ -48,5 +58,5 @@ func Feature(data *protogeo.Data, feature *protogeo.Data_Feature) *geojson.Featu
 	case *protogeo.Data_Feature_IntId:
 		geoFeature.ID = id.IntId
 	}
-	return geoFeature
+	return geoFeature, nil
 }
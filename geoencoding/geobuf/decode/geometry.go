@@ -8,22 +8,74 @@ import (
 )
 
 // Geometry ...
-func Geometry(geo *protogeo.Data_Geometry, precision, dimensions uint32) *geojson.Geometry {
+func Geometry(geo *protogeo.Data_Geometry, precision, dimensions uint32) (*geojson.Geometry, error) {
+	if geo == nil {
+		return &geojson.Geometry{}, nil
+	}
+	if dimensions != 2 || len(geo.Coords)%2 != 0 {
+		return nil, ErrInvalidGeobuf
+	}
 	switch geo.Type {
 	case protogeo.Data_Geometry_POINT:
-		return geojson.NewGeometry(makePoint(geo.Coords, precision))
+		if len(geo.Coords) != 2 {
+			return nil, ErrInvalidGeobuf
+		}
+		return geojson.NewGeometry(makePoint(geo.Coords, precision)), nil
 	case protogeo.Data_Geometry_MULTIPOINT:
-		return geojson.NewGeometry(makeMultiPoint(geo.Coords, precision, dimensions))
+		return geojson.NewGeometry(makeMultiPoint(geo.Coords, precision, dimensions)), nil
 	case protogeo.Data_Geometry_LINESTRING:
-		return geojson.NewGeometry(makeLineString(geo.Coords, precision, dimensions))
+		return geojson.NewGeometry(makeLineString(geo.Coords, precision, dimensions)), nil
 	case protogeo.Data_Geometry_MULTILINESTRING:
-		return geojson.NewGeometry(makeMultiLineString(geo.Lengths, geo.Coords, precision, dimensions))
+		if !validLengths(geo.Lengths, geo.Coords, dimensions, 0) {
+			return nil, ErrInvalidGeobuf
+		}
+		return geojson.NewGeometry(makeMultiLineString(geo.Lengths, geo.Coords, precision, dimensions)), nil
 	case protogeo.Data_Geometry_POLYGON:
-		return geojson.NewGeometry(makePolygon(geo.Lengths, geo.Coords, precision, dimensions))
+		if !validLengths(geo.Lengths, geo.Coords, dimensions, 1) {
+			return nil, ErrInvalidGeobuf
+		}
+		return geojson.NewGeometry(makePolygon(geo.Lengths, geo.Coords, precision, dimensions)), nil
 	case protogeo.Data_Geometry_MULTIPOLYGON:
-		return geojson.NewGeometry(makeMultiPolygon(geo.Lengths, geo.Coords, precision, dimensions))
+		if !validMultiPolygonLengths(geo.Lengths, geo.Coords, dimensions) {
+			return nil, ErrInvalidGeobuf
+		}
+		return geojson.NewGeometry(makeMultiPolygon(geo.Lengths, geo.Coords, precision, dimensions)), nil
+	}
+	return &geojson.Geometry{}, nil
+}
+
+// validLengths returns true if each length is at least minLength and the lengths fit in the coords.
+func validLengths(lengths []uint32, inCords []int64, dimension uint32, minLength uint32) bool {
+	count := 0
+	for _, length := range lengths {
+		if length < minLength {
+			return false
+		}
+		count += int(length) * int(dimension)
+		if count > len(inCords) {
+			return false
+		}
+	}
+	return true
+}
+
+// validMultiPolygonLengths returns true if the polygon and ring counts of lengths fit in lengths and the coords.
+func validMultiPolygonLengths(lengths []uint32, inCords []int64, dimension uint32) bool {
+	if len(lengths) == 0 {
+		return false
 	}
-	return &geojson.Geometry{}
+	polyCount := int(lengths[0])
+	lengths = lengths[1:]
+	rings := []uint32{}
+	for i := 0; i < polyCount; i++ {
+		if len(lengths) == 0 || int(lengths[0]) > len(lengths)-1 {
+			return false
+		}
+		ringCount := int(lengths[0])
+		rings = append(rings, lengths[1:ringCount+1]...)
+		lengths = lengths[ringCount+1:]
+	}
+	return validLengths(rings, inCords, dimension, 1)
 }
 
 func makePoint(inCords []int64, precision uint32) space.Point {
@@ -47,13 +99,14 @@ func makeMultiPolygon(lengths []uint32, inCords []int64, precision uint32, dimen
 	lengths = lengths[1:]
 	for i := 0; i < polyCount; i++ {
 		ringCount := lengths[0]
-		polygons[i] = makePolygon(lengths[1:ringCount+1], inCords, precision, dimension)
+		rings := lengths[1 : ringCount+1]
+		polygons[i] = makePolygon(rings, inCords, precision, dimension)
 		skip := 0
-		for i := 0; i < int(ringCount); i++ {
-			skip += int(lengths[i]) * int(dimension)
+		for _, length := range rings {
+			skip += int(length) * int(dimension)
 		}
 
-		lengths = lengths[ringCount:]
+		lengths = lengths[ringCount+1:]
 		inCords = inCords[skip:]
 	}
 	return polygons
@@ -62,7 +115,7 @@ func makeMultiPolygon(lengths []uint32, inCords []int64, precision uint32, dimen
 func makePolygon(lengths []uint32, inCords []int64, precision uint32, dimension uint32) space.Polygon {
 	lines := make(matrix.PolygonMatrix, len(lengths))
 	for i, length := range lengths {
-		l := int(length * dimension)
+		l := int(length) * int(dimension)
 		lines[i] = makeRing(inCords[:l], precision, dimension)
 		inCords = inCords[l:]
 	}
@@ -73,7 +126,7 @@ func makePolygon(lengths []uint32, inCords []int64, precision uint32, dimension
 func makeMultiLineString(lengths []uint32, inCords []int64, precision uint32, dimension uint32) space.MultiLineString {
 	lines := make([]space.LineString, len(lengths))
 	for i, length := range lengths {
-		l := int(length * dimension)
+		l := int(length) * int(dimension)
 		lines[i] = makeLineString(inCords[:l], precision, dimension)
 		inCords = inCords[l:]
 	}
@@ -87,13 +140,6 @@ func makeRing(inCords []int64, precision uint32, dimension uint32) space.Ring {
 }
 
 func makeLineString(inCords []int64, precision uint32, dimension uint32) space.LineString {
-	points := make(space.Ring, len(inCords)/int(dimension))
-	prevCords := [2]int64{}
-	for i, j := 0, 1; j < len(inCords); i, j = i+2, j+2 {
-		prevCords[0] += inCords[i]
-		prevCords[1] += inCords[j]
-		points[i/2] = makePoint(prevCords[:], precision)
-	}
 	return space.LineString(makeLine(inCords, precision, dimension))
 }
 
@@ -54,7 +54,10 @@ func TestEncodeFeature(t *testing.T) {
 	feature := Encode(f)
 	t.Log(feature)
 	fmt.Printf("%T,%v", feature, feature)
-	fe := decode.Decode(feature)
+	fe, err := decode.Decode(feature)
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
 	t.Log(fe)
 	fmt.Printf("%T,%v", fe, fe)
 }
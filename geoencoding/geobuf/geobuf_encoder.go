@@ -9,6 +9,7 @@ import (
 	"github.com/spatial-go/geoos/geoencoding/geobuf/protogeo"
 	"github.com/spatial-go/geoos/geoencoding/geojson"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -29,8 +30,13 @@ func (e *Encoder) Encode(g space.Geometry) []byte {
 // Decode Returns geometry of that decode string by codeType.
 func (e *Encoder) Decode(s []byte) (space.Geometry, error) {
 	protoGeo := &protogeo.Data{}
-	_ = proto.Unmarshal(s, protoGeo)
-	geom := decode.Decode(protoGeo)
+	if err := proto.Unmarshal(s, protoGeo); err != nil {
+		return nil, err
+	}
+	geom, err := decode.Decode(protoGeo)
+	if err != nil {
+		return nil, err
+	}
 	switch gj := geom.(type) {
 	case *geojson.FeatureCollection:
 		colls := space.Collection{}
@@ -43,7 +49,7 @@ func (e *Encoder) Decode(s []byte) (space.Geometry, error) {
 	case *geojson.Geometry:
 		return gj.Geometry(), nil
 	}
-	return nil, nil
+	return nil, spaceerr.ErrUnsupportedType
 }
 
 // Read Returns geometry from reader.
@@ -66,7 +72,10 @@ func (e *Encoder) WriteGeoJSON(w io.Writer, g *geojson.FeatureCollection) error
 
 	protoGeo := encode.Encode(g)
 
-	b, _ := proto.Marshal(protoGeo)
+	b, err := proto.Marshal(protoGeo)
+	if err != nil {
+		return err
+	}
 	return e.WriteBytes(w, b)
 }
 
@@ -77,8 +86,13 @@ func (e *Encoder) ReadGeoJSON(r io.Reader) (*geojson.FeatureCollection, error) {
 		return nil, err
 	}
 	protoGeo := &protogeo.Data{}
-	_ = proto.Unmarshal(b, protoGeo)
-	geom := decode.Decode(protoGeo)
+	if err := proto.Unmarshal(b, protoGeo); err != nil {
+		return nil, err
+	}
+	geom, err := decode.Decode(protoGeo)
+	if err != nil {
+		return nil, err
+	}
 	switch gj := geom.(type) {
 	case *geojson.FeatureCollection:
 		return gj, nil
@@ -96,5 +110,5 @@ func (e *Encoder) ReadGeoJSON(r io.Reader) (*geojson.FeatureCollection, error) {
 		fc.Features = features
 		return fc, nil
 	}
-	return nil, nil
+	return nil, spaceerr.ErrUnsupportedType
 }
@@ -1,9 +1,9 @@
 package geojson
 
 import (
+	"encoding/json"
 	"io"
 	"log"
-	"strings"
 
 	"github.com/spatial-go/geoos/space"
 )
@@ -22,7 +22,14 @@ func (e *Encoder) Encode(g space.Geometry) []byte {
 
 // Decode Returns geometry of that decode string by codeType.
 func (e *Encoder) Decode(s []byte) (space.Geometry, error) {
-	if strings.Contains(string(s[9:20]), "FeatureCollection") {
+	object := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(s, &object); err != nil {
+		return nil, err
+	}
+	switch object.Type {
+	case featureCollection:
 		colls, err := UnmarshalFeatureCollection(s)
 		if err != nil {
 			log.Println(err)
@@ -30,10 +37,13 @@ func (e *Encoder) Decode(s []byte) (space.Geometry, error) {
 		}
 		geom := space.Collection{}
 		for _, v := range colls.Features {
+			if v == nil {
+				continue
+			}
 			geom = append(geom, v.Geometry.Geometry())
 		}
 		return geom, nil
-	} else if strings.Contains(string(s[9:20]), "Feature") {
+	case "Feature":
 		feat, err := UnmarshalFeature(s)
 		if err != nil {
 			log.Println(err)
@@ -43,7 +53,10 @@ func (e *Encoder) Decode(s []byte) (space.Geometry, error) {
 		return geom, nil
 	}
 	geom, err := UnmarshalGeometry(s)
-	return geom.Geometry(), err
+	if err != nil {
+		return nil, err
+	}
+	return geom.Geometry(), nil
 }
 
 // Read Returns geometry from reader.
@@ -369,9 +369,7 @@ func GeomFromWKBHexStr(wkbHex string) (space.Geometry, error) {
 	//wkbStr, err := hex.DecodeString(wkbHex)
 
 	ewkb := &EWKBDecoder{r: bytes.NewReader(wkbStr)}
-	g, _ := ewkb.Decode()
-
-	return g, nil
+	return ewkb.Decode()
 }
 
 // GeomToWKBHexStr ...
@@ -45,19 +45,15 @@ type EWKBDecoder struct {
 
 func (d *EWKBDecoder) readByte() (byte, error) {
 	buf := make([]byte, 1)
-	if num, err := d.r.Read(buf); err == nil {
-		if num < len(buf) {
-			return '0', ErrAttempt
-		}
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return '0', ErrAttempt
 	}
 	return buf[0], nil
 }
 func (d *EWKBDecoder) readInt32() (uint32, error) {
 	buf := make([]byte, 4)
-	if num, err := d.r.Read(buf); err == nil {
-		if num < len(buf) {
-			return 0, ErrAttempt
-		}
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return 0, ErrAttempt
 	}
 	return d.order.Uint32(buf), nil
 }
@@ -66,7 +62,10 @@ func (d *EWKBDecoder) readInt32() (uint32, error) {
 func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 
 	// determine byte order
-	byteOrderWKB, _ := d.readByte()
+	byteOrderWKB, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
 
 	// always set byte order, since it may change from geometry to geometry
 	d.order = byteOrder(byteOrderWKB)
@@ -75,7 +74,10 @@ func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 	//start of the geometry (if a multi-geometry).  This  allows WBKReader to work
 	//with Spatialite native BLOB WKB, as well as other WKB variants that might just
 	//specify endian-ness at the start of the multigeometry.
-	typeInt, _ := d.readInt32()
+	typeInt, err := d.readInt32()
+	if err != nil {
+		return nil, err
+	}
 
 	// To get geometry type mask out EWKB flag bits,and use only low 3 digits of type word.
 	// This supports both EWKB and ISO/OGC.
@@ -100,15 +102,15 @@ func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 	// determine if SRID are present (EWKB only)
 	hasSRID := (typeInt & 0x20000000) != 0
 	if hasSRID {
-		d.Srid, _ = d.readInt32()
-		//fmt.Println(srid)
+		if d.Srid, err = d.readInt32(); err != nil {
+			return nil, err
+		}
 	}
 
 	var buf = make([]byte, 8)
 	order := byteOrder(d.order)
 
 	var geom space.Geometry
-	var err error
 	switch uint32(geometryType) {
 	case pointType:
 		geom, err = readPoint(d.r, order, buf)
@@ -131,7 +133,10 @@ func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 		return nil, err
 	}
 	valid, err := space.CreateElementValidWithCoordSys(geom, int(d.Srid))
-	return valid, err
+	if err != nil {
+		return nil, err
+	}
+	return valid, nil
 }
 
 // HexToBytes Converts a hexadecimal string to a byte array. The hexadecimal digit symbols are case-insensitive.
@@ -0,0 +1,90 @@
+package geohash
+
+import (
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// Cover returns the geohashes with precision characters of the cells intersecting the geometry,
+// in lexical order, cells only touching the geometry included. The geometry is in longitude and latitude.
+func Cover(geom space.Geometry, precision int) ([]string, error) {
+	return CoverMixed(geom, precision, precision)
+}
+
+// CoverMixed returns the fewest geohashes, of minPrecision to maxPrecision characters,
+// whose cells cover the geometry, in lexical order.
+// Cells covered by the geometry are not split into smaller cells,
+// cells of maxPrecision characters partly covered by the geometry are kept.
+func CoverMixed(geom space.Geometry, minPrecision, maxPrecision int) ([]string, error) {
+	if minPrecision < 1 || maxPrecision > MaxPrecision || minPrecision > maxPrecision {
+		return nil, ErrInvalidPrecision
+	}
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	c := &coverer{geom: geom, bound: geom.Bound(), minPrecision: minPrecision, maxPrecision: maxPrecision,
+		strategy: planar.NormalStrategy(), hashes: []string{}}
+	if geom.IsEmpty() {
+		return c.hashes, nil
+	}
+	world := space.Bound{Min: space.Point{-180, -90}, Max: space.Point{180, 90}}
+	if err := c.cover("", world); err != nil {
+		return nil, err
+	}
+	return c.hashes, nil
+}
+
+// coverer covers a geometry with cells, descending from the cells intersecting it to their children.
+type coverer struct {
+	geom                       space.Geometry
+	bound                      space.Bound
+	minPrecision, maxPrecision int
+	strategy                   planar.Algorithm
+	hashes                     []string
+}
+
+// cover adds the cells of the cell of the geohash covering the geometry.
+func (c *coverer) cover(hash string, bound space.Bound) error {
+	if !bound.IntersectsBound(c.bound) {
+		return nil
+	}
+	if hash != "" {
+		cell := bound.ToPolygon()
+		intersects, err := c.strategy.Intersects(cell, c.geom)
+		if err != nil || !intersects {
+			return err
+		}
+		if len(hash) == c.maxPrecision {
+			c.hashes = append(c.hashes, hash)
+			return nil
+		}
+		covered, err := c.strategy.Covers(c.geom, cell)
+		if err != nil {
+			return err
+		}
+		if covered {
+			c.addDescendants(hash)
+			return nil
+		}
+	}
+	for i := range base32 {
+		child := hash + base32[i:i+1]
+		childBound, _ := Bound(child)
+		if err := c.cover(child, childBound); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addDescendants adds the geohash, or its descendants with minPrecision characters if it is shorter.
+func (c *coverer) addDescendants(hash string) {
+	if len(hash) >= c.minPrecision {
+		c.hashes = append(c.hashes, hash)
+		return
+	}
+	for i := range base32 {
+		c.addDescendants(hash + base32[i:i+1])
+	}
+}
@@ -0,0 +1,197 @@
+// Package geohash encodes longitude and latitude as geohashes, and covers geometries with geohash cells.
+// A geohash interleaves the bits of the longitude and of the latitude, starting with the longitude,
+// and writes them five at a time in base 32. Each character splits a cell into 32 cells.
+package geohash
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spatial-go/geoos/grid"
+	"github.com/spatial-go/geoos/space"
+)
+
+// const ...
+const (
+	// MaxPrecision the largest number of characters of a geohash, about 3.7cm by 1.9cm.
+	MaxPrecision = 12
+
+	base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+// ErrInvalidPrecision ...
+var ErrInvalidPrecision = fmt.Errorf("geohash precision must be between 1 and %d", MaxPrecision)
+
+// ErrInvalidHash ...
+var ErrInvalidHash = fmt.Errorf("geohash is not valid")
+
+// ErrInvalidPoint ...
+var ErrInvalidPoint = fmt.Errorf("point is not a valid longitude and latitude")
+
+// ErrInvalidDirection ...
+var ErrInvalidDirection = fmt.Errorf("direction of a neighbour is not valid")
+
+// Direction of a neighbour of a cell.
+type Direction int
+
+// Directions of the neighbours, clockwise from the north.
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+// offsets the number of cells to the neighbour in each direction, in longitude and latitude.
+var offsets = [8][2]int{{0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}, {-1, 0}, {-1, 1}}
+
+// Encode returns the geohash of the point with precision characters, the point is longitude and latitude.
+func Encode(point space.Point, precision int) (string, error) {
+	if precision < 1 || precision > MaxPrecision {
+		return "", ErrInvalidPrecision
+	}
+	if len(point) < 2 || point[0] < -180 || point[0] > 180 || point[1] < -90 || point[1] > 90 {
+		return "", ErrInvalidPoint
+	}
+	lon, lat := [2]float64{-180, 180}, [2]float64{-90, 90}
+	hash := make([]byte, precision)
+	even := true
+	for i := range hash {
+		ch := 0
+		for bit := 0; bit < 5; bit++ {
+			interval, v := &lat, point[1]
+			if even {
+				interval, v = &lon, point[0]
+			}
+			mid := (interval[0] + interval[1]) / 2
+			ch <<= 1
+			if v >= mid {
+				ch |= 1
+				interval[0] = mid
+			} else {
+				interval[1] = mid
+			}
+			even = !even
+		}
+		hash[i] = base32[ch]
+	}
+	return string(hash), nil
+}
+
+// Decode returns the centre of the cell of the geohash.
+func Decode(hash string) (space.Point, error) {
+	bound, err := Bound(hash)
+	if err != nil {
+		return nil, err
+	}
+	return bound.Centroid(), nil
+}
+
+// Bound returns the cell of the geohash.
+func Bound(hash string) (space.Bound, error) {
+	if len(hash) < 1 || len(hash) > MaxPrecision {
+		return space.Bound{}, ErrInvalidHash
+	}
+	lon, lat := [2]float64{-180, 180}, [2]float64{-90, 90}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := strings.IndexByte(base32, lower(hash[i]))
+		if ch < 0 {
+			return space.Bound{}, ErrInvalidHash
+		}
+		for bit := 4; bit >= 0; bit-- {
+			interval := &lat
+			if even {
+				interval = &lon
+			}
+			mid := (interval[0] + interval[1]) / 2
+			if ch>>bit&1 == 1 {
+				interval[0] = mid
+			} else {
+				interval[1] = mid
+			}
+			even = !even
+		}
+	}
+	return space.Bound{Min: space.Point{lon[0], lat[0]}, Max: space.Point{lon[1], lat[1]}}, nil
+}
+
+// Neighbour returns the geohash of the cell next to the cell of the geohash in the direction,
+// with the same precision. Cells wrap around the antimeridian,
+// the neighbour beyond a pole is the empty string.
+func Neighbour(hash string, direction Direction) (string, error) {
+	if direction < North || direction > NorthWest {
+		return "", ErrInvalidDirection
+	}
+	bound, err := Bound(hash)
+	if err != nil {
+		return "", err
+	}
+	width, height := bound.Max[0]-bound.Min[0], bound.Max[1]-bound.Min[1]
+	centre := bound.Centroid()
+	lon := centre[0] + float64(offsets[direction][0])*width
+	lat := centre[1] + float64(offsets[direction][1])*height
+	if lat > 90 || lat < -90 {
+		return "", nil
+	}
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	return Encode(space.Point{lon, lat}, len(hash))
+}
+
+// Neighbours returns the geohashes of the 8 cells around the cell of the geohash,
+// clockwise from the north, as indexed by Direction.
+func Neighbours(hash string) ([]string, error) {
+	neighbours := make([]string, len(offsets))
+	for i := range neighbours {
+		n, err := Neighbour(hash, Direction(i))
+		if err != nil {
+			return nil, err
+		}
+		neighbours[i] = n
+	}
+	return neighbours, nil
+}
+
+// Children returns the geohashes of the 32 cells of the next precision in the cell of the geohash.
+func Children(hash string) ([]string, error) {
+	if len(hash) >= MaxPrecision {
+		return nil, ErrInvalidPrecision
+	}
+	if _, err := Bound(hash); err != nil {
+		return nil, err
+	}
+	children := make([]string, len(base32))
+	for i := range base32 {
+		children[i] = hash + base32[i:i+1]
+	}
+	return children, nil
+}
+
+// Grids returns the cells of the geohashes as grids.
+func Grids(hashes []string) ([]grid.Grid, error) {
+	grids := make([]grid.Grid, len(hashes))
+	for i, hash := range hashes {
+		bound, err := Bound(hash)
+		if err != nil {
+			return nil, err
+		}
+		grids[i] = grid.Grid{Geometry: bound.ToPolygon()}
+	}
+	return grids, nil
+}
+
+// lower returns the lower case of an ASCII letter.
+func lower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
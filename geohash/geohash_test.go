@@ -0,0 +1,188 @@
+package geohash
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name      string
+		point     space.Point
+		precision int
+		want      string
+		wantErr   error
+	}{
+		{"ezs42", space.Point{-5.6, 42.6}, 5, "ezs42", nil},
+		{"u4pruydqqvj", space.Point{10.40744, 57.64911}, 11, "u4pruydqqvj", nil},
+		{"origin", space.Point{0, 0}, 4, "s000", nil},
+		{"north east corner", space.Point{180, 90}, 3, "zzz", nil},
+		{"south west corner", space.Point{-180, -90}, 3, "000", nil},
+		{"precision", space.Point{0, 0}, 13, "", ErrInvalidPrecision},
+		{"latitude", space.Point{0, 91}, 5, "", ErrInvalidPoint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.point, tt.precision)
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("Encode() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name      string
+		hash      string
+		want      space.Point
+		tolerance float64
+		wantErr   error
+	}{
+		{"ezs42", "ezs42", space.Point{-5.6, 42.6}, 0.03, nil},
+		{"upper case", "EZS42", space.Point{-5.6, 42.6}, 0.03, nil},
+		{"u4pruydqqvj", "u4pruydqqvj", space.Point{10.40744, 57.64911}, 1e-5, nil},
+		{"invalid character", "ezs4a", nil, 0, ErrInvalidHash},
+		{"empty", "", nil, 0, ErrInvalidHash},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decode(tt.hash)
+			if err != tt.wantErr {
+				t.Fatalf("Decode() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (math.Abs(got[0]-tt.want[0]) > tt.tolerance || math.Abs(got[1]-tt.want[1]) > tt.tolerance) {
+				t.Errorf("Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	bound, _ := Bound("s")
+	if want := (space.Bound{Min: space.Point{0, 0}, Max: space.Point{45, 45}}); !bound.EqualsBound(want) {
+		t.Errorf("Bound() = %v, want %v", bound, want)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	tests := []struct {
+		name string
+		hash string
+		want []string
+	}{
+		{"dqcjq", "dqcjq", []string{"dqcjw", "dqcjx", "dqcjr", "dqcjp", "dqcjn", "dqcjj", "dqcjm", "dqcjt"}},
+		{"antimeridian", "8", []string{"b", "c", "9", "3", "2", "r", "x", "z"}},
+		{"north pole", "z", []string{"", "", "b", "8", "x", "w", "y", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Neighbours(tt.hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Neighbours() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if _, err := Neighbour("dqcjq", Direction(8)); err != ErrInvalidDirection {
+		t.Errorf("Neighbour() error = %v, want %v", err, ErrInvalidDirection)
+	}
+}
+
+func TestCover(t *testing.T) {
+	cell, _ := Bound("u4")
+	inside := space.Polygon{{
+		{cell.Min[0] + 1e-6, cell.Min[1] + 1e-6}, {cell.Max[0] - 1e-6, cell.Min[1] + 1e-6},
+		{cell.Max[0] - 1e-6, cell.Max[1] - 1e-6}, {cell.Min[0] + 1e-6, cell.Max[1] - 1e-6},
+		{cell.Min[0] + 1e-6, cell.Min[1] + 1e-6},
+	}}
+	triangle := space.Polygon{{{116.3, 39.9}, {116.5, 39.9}, {116.4, 40.05}, {116.3, 39.9}}}
+	tests := []struct {
+		name         string
+		geom         space.Geometry
+		minPrecision int
+		maxPrecision int
+		want         []string
+		wantCount    int
+		wantErr      error
+	}{
+		{"point", space.Point{-5.6, 42.6}, 5, 5, []string{"ezs42"}, 1, nil},
+		{"inside cell", inside, 2, 2, []string{"u4"}, 1, nil},
+		{"inside cell precision 3", inside, 3, 3, nil, 32, nil},
+		{"line", space.LineString{{0.1, 0.1}, {0.1, 44.9}}, 2, 2, []string{"s0", "s1", "s4", "s5", "sh", "sj", "sn", "sp"}, 8, nil},
+		{"triangle", triangle, 5, 5, nil, -1, nil},
+		{"triangle mixed", triangle, 3, 5, nil, -1, nil},
+		{"empty", space.Polygon{}, 5, 5, []string{}, 0, nil},
+		{"nil", nil, 5, 5, nil, 0, spaceerr.ErrNilGeometry},
+		{"precision", triangle, 5, 4, nil, 0, ErrInvalidPrecision},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CoverMixed(tt.geom, tt.minPrecision, tt.maxPrecision)
+			if err != tt.wantErr {
+				t.Fatalf("CoverMixed() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoverMixed() = %v, want %v", got, tt.want)
+			}
+			if tt.wantCount >= 0 && len(got) != tt.wantCount {
+				t.Errorf("CoverMixed() = %v geohashes, want %v", len(got), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestCoverMixed(t *testing.T) {
+	triangle := space.Polygon{{{116, 39.5}, {117, 39.5}, {116.5, 40.5}, {116, 39.5}}}
+	fixed, err := Cover(triangle, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed, err := CoverMixed(triangle, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mixed) >= len(fixed) {
+		t.Errorf("CoverMixed() = %v geohashes, Cover() = %v", len(mixed), len(fixed))
+	}
+	strategy := planar.NormalStrategy()
+	for _, hash := range mixed {
+		if len(hash) < 5 {
+			bound, _ := Bound(hash)
+			if covered, _ := strategy.Covers(triangle, bound.ToPolygon()); !covered {
+				t.Errorf("CoverMixed() cell %v is not covered", hash)
+			}
+		}
+	}
+	for _, hash := range fixed {
+		found := false
+		for _, v := range mixed {
+			if strings.HasPrefix(hash, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("CoverMixed() misses cell %v", hash)
+		}
+	}
+
+	grids, err := Grids(mixed)
+	if err != nil || len(grids) != len(mixed) {
+		t.Fatalf("Grids() = %v grids, %v", len(grids), err)
+	}
+	area := 0.0
+	for _, g := range grids {
+		a, _ := g.Geometry.(space.Polygon).Area()
+		area += a
+	}
+	if triangleArea, _ := triangle.Area(); area < triangleArea {
+		t.Errorf("Grids() area = %v, less than %v", area, triangleArea)
+	}
+}
@@ -0,0 +1,370 @@
+package grid
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/geoencoding/geojson"
+	"github.com/spatial-go/geoos/index/strtree"
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// AggregateOp the operation of an aggregator.
+type AggregateOp int
+
+// const operations of aggregators.
+const (
+	// Count the number of features in the cell.
+	Count AggregateOp = iota
+	// Sum the sum of the values.
+	Sum
+	// Mean the mean of the values, weighted by the shares of the features in the cell.
+	Mean
+	// Min the smallest value.
+	Min
+	// Max the largest value.
+	Max
+	// Distinct the number of distinct values, features missing the property are left out.
+	Distinct
+)
+
+var aggregateOpNames = []string{"count", "sum", "mean", "min", "max", "distinct"}
+
+// String returns the name of the operation.
+func (op AggregateOp) String() string {
+	if op < Count || op > Distinct {
+		return fmt.Sprintf("AggregateOp(%d)", int(op))
+	}
+	return aggregateOpNames[op]
+}
+
+// ErrInvalidAggregator ...
+var ErrInvalidAggregator = fmt.Errorf("aggregator operation or property is not valid")
+
+// Aggregator aggregates the values of a property of the features in each cell into a property of the cell.
+// Values which are not numbers are left out of Sum, Mean, Min and Max.
+type Aggregator struct {
+	Op AggregateOp
+	// Property the property of the features, not used by Count.
+	Property string
+	// Name the property of the cells, the operation and the property joined by "_" if empty.
+	Name string
+}
+
+// name returns the property of the cells of the aggregator.
+func (a Aggregator) name() string {
+	switch {
+	case a.Name != "":
+		return a.Name
+	case a.Op == Count:
+		return a.Op.String()
+	}
+	return a.Op.String() + "_" + a.Property
+}
+
+// Zones the cells features are aggregated onto.
+type Zones struct {
+	// Grids the polygons of custom cells, such as the grids of h3, s2 or geohash cells, if not nil.
+	Grids []Grid
+	// Options the options generating the cells over Bound if Grids is nil, over the bound of the features if Bound is empty.
+	Options Options
+	Bound   space.Bound
+
+	// AreaWeighted apportions polygon features to the cells by the fractions of their areas in the cells,
+	// Count and Sum adding up the fractions of the features and of their values.
+	// Otherwise, as other features but points, a polygon counts fully in each cell it intersects.
+	// A point counts in the first cell containing it.
+	AreaWeighted bool
+	// SkipEmpty leaves out the cells without features.
+	SkipEmpty bool
+}
+
+// zoneStat the aggregated values of a cell for an aggregator.
+type zoneStat struct {
+	count, sum, weight float64
+	min, max           float64
+	numbers            int
+	distinct           map[interface{}]bool
+}
+
+// Aggregate aggregates the properties of the features onto the cells of the zones,
+// the cells being found by a spatial index of their bounds.
+// It returns the cells as features with the ID of the cell, the row and column of generated cells
+// or the index of custom grids, and a property for each aggregator, in the order of the cells.
+func Aggregate(fc *geojson.FeatureCollection, zones Zones, aggregators ...Aggregator) (*geojson.FeatureCollection, error) {
+	for _, a := range aggregators {
+		if a.Op < Count || a.Op > Distinct || a.Op != Count && a.Property == "" {
+			return nil, ErrInvalidAggregator
+		}
+	}
+	features := []*geojson.Feature{}
+	if fc != nil {
+		for _, f := range fc.Features {
+			if f != nil && f.Geometry.Geometry() != nil && !f.Geometry.Geometry().IsEmpty() {
+				features = append(features, f)
+			}
+		}
+	}
+	cells, err := zones.cells(features)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := strtree.NewSTRtree()
+	for i, c := range cells {
+		b := c.Geometry.Bound()
+		_ = tree.Insert(envelope.FourFloat(b.Min[0], b.Max[0], b.Min[1], b.Max[1]), i)
+	}
+	stats := make([][]*zoneStat, len(cells))
+	for _, f := range features {
+		geom := f.Geometry.Geometry()
+		b := geom.Bound()
+		candidates := tree.Query(envelope.FourFloat(b.Min[0], b.Max[0], b.Min[1], b.Max[1])).([]interface{})
+		shares, err := zones.shares(geom, cells, candidates)
+		if err != nil {
+			return nil, err
+		}
+		for i, share := range shares {
+			if stats[i] == nil {
+				stats[i] = make([]*zoneStat, len(aggregators))
+				for j := range stats[i] {
+					stats[i][j] = &zoneStat{distinct: map[interface{}]bool{}}
+				}
+			}
+			for j, a := range aggregators {
+				stats[i][j].add(a, f.Properties[a.Property], share)
+			}
+		}
+	}
+
+	result := geojson.NewFeatureCollection()
+	for i, c := range cells {
+		if stats[i] == nil && zones.SkipEmpty {
+			continue
+		}
+		feature := geojson.NewFeature(*geojson.NewGeometry(c.Geometry))
+		feature.ID = c.ID()
+		if zones.Grids != nil {
+			feature.ID = i
+		}
+		for j, a := range aggregators {
+			var stat *zoneStat
+			if stats[i] != nil {
+				stat = stats[i][j]
+			}
+			if v := stat.value(a.Op); v != nil {
+				feature.Properties[a.name()] = v
+			}
+		}
+		result.Append(feature)
+	}
+	return result, nil
+}
+
+// cells returns the cells of the zones.
+func (z Zones) cells(features []*geojson.Feature) ([]Cell, error) {
+	if z.Grids != nil {
+		cells := make([]Cell, len(z.Grids))
+		for i, g := range z.Grids {
+			if g.Geometry == nil {
+				return nil, spaceerr.ErrNilGeometry
+			}
+			cells[i] = Cell{Grid: g}
+		}
+		return cells, nil
+	}
+	bound := z.Bound
+	if len(bound.Min) < 2 || len(bound.Max) < 2 {
+		if len(features) == 0 {
+			return []Cell{}, nil
+		}
+		bound = features[0].Geometry.Geometry().Bound()
+		for _, f := range features[1:] {
+			b := f.Geometry.Geometry().Bound()
+			bound = bound.Extend(b.Min).Extend(b.Max)
+		}
+	}
+	return Generate(bound, z.Options)
+}
+
+// shares returns the shares of the geometry in the candidate cells it is in.
+func (z Zones) shares(geom space.Geometry, cells []Cell, candidates []interface{}) (map[int]float64, error) {
+	shares := map[int]float64{}
+	switch g := geom.(type) {
+	case space.Point:
+		if i, ok := firstCellContaining(g, cells, candidates); ok {
+			shares[i] = 1
+		}
+		return shares, nil
+	case space.MultiPoint:
+		for _, p := range g {
+			if i, ok := firstCellContaining(p, cells, candidates); ok {
+				shares[i] = 1
+			}
+		}
+		return shares, nil
+	case space.Polygon, space.MultiPolygon:
+		if z.AreaWeighted {
+			area, _ := geom.Area()
+			if area == 0 {
+				break
+			}
+			for _, v := range candidates {
+				i := v.(int)
+				result, err := snapround.Intersection(cells[i].Geometry.ToMatrix(), geom.ToMatrix(), 0)
+				if err != nil {
+					return nil, err
+				}
+				if a, _ := space.TransGeometry(result).Area(); a > 0 {
+					shares[i] = a / area
+				}
+			}
+			return shares, nil
+		}
+	}
+	strategy := planar.NormalStrategy()
+	var err error
+	for _, v := range candidates {
+		i := v.(int)
+		for _, cell := range parts(cells[i].Geometry) {
+			intersects := false
+			for _, part := range parts(geom) {
+				cellPolygon, isPolygon := cell.(space.Polygon)
+				polygon, ok := part.(space.Polygon)
+				if isPolygon && ok {
+					intersects, _, err = relatePolygon(cellPolygon, polygon)
+				} else {
+					intersects, err = strategy.Intersects(cell, part)
+				}
+				if err != nil {
+					return nil, err
+				}
+				if intersects {
+					break
+				}
+			}
+			if intersects {
+				shares[i] = 1
+				break
+			}
+		}
+	}
+	return shares, nil
+}
+
+// firstCellContaining returns the lowest index of the candidate cells containing the point, on their boundaries or inside.
+func firstCellContaining(point space.Point, cells []Cell, candidates []interface{}) (int, bool) {
+	first := -1
+	for _, v := range candidates {
+		i := v.(int)
+		if first >= 0 && i > first {
+			continue
+		}
+		for _, cell := range parts(cells[i].Geometry) {
+			polygon, ok := cell.(space.Polygon)
+			if ok && relate.LocateInPolygon(matrix.Matrix(point), matrix.PolygonMatrix(polygon)) != calc.ImExterior ||
+				!ok && cell.Equals(point) {
+				first = i
+				break
+			}
+		}
+	}
+	return first, first >= 0
+}
+
+// add adds the value of a feature with its share in the cell.
+func (s *zoneStat) add(a Aggregator, value interface{}, share float64) {
+	s.count += share
+	if a.Op == Distinct {
+		if value == nil {
+			return
+		}
+		if !reflect.TypeOf(value).Comparable() {
+			value = fmt.Sprint(value)
+		}
+		s.distinct[value] = true
+		return
+	}
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case int:
+		number = float64(v)
+	default:
+		return
+	}
+	if s.numbers == 0 || number < s.min {
+		s.min = number
+	}
+	if s.numbers == 0 || number > s.max {
+		s.max = number
+	}
+	s.numbers++
+	s.sum += share * number
+	s.weight += share
+}
+
+// value returns the aggregated value of the operation, nil if it has none.
+func (s *zoneStat) value(op AggregateOp) interface{} {
+	switch op {
+	case Count:
+		if s == nil {
+			return 0.0
+		}
+		return s.count
+	case Sum:
+		if s == nil {
+			return 0.0
+		}
+		return s.sum
+	case Distinct:
+		if s == nil {
+			return 0
+		}
+		return len(s.distinct)
+	}
+	if s == nil || s.numbers == 0 {
+		return nil
+	}
+	switch op {
+	case Mean:
+		return s.sum / s.weight
+	case Min:
+		return s.min
+	}
+	return s.max
+}
+
+// parts returns the geometries of a multi geometry or a collection, the geometry itself otherwise.
+func parts(geom space.Geometry) []space.Geometry {
+	var geoms []space.Geometry
+	switch g := geom.(type) {
+	case space.MultiPoint:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.MultiLineString:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.MultiPolygon:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.Collection:
+		for _, v := range g {
+			geoms = append(geoms, parts(v)...)
+		}
+	default:
+		geoms = append(geoms, geom)
+	}
+	return geoms
+}
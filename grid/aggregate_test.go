@@ -0,0 +1,116 @@
+package grid
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/geoencoding/geojson"
+	"github.com/spatial-go/geoos/space"
+)
+
+func features(geoms []space.Geometry, props []geojson.Properties) *geojson.FeatureCollection {
+	fc := geojson.NewFeatureCollection()
+	for i, g := range geoms {
+		f := geojson.NewFeature(*geojson.NewGeometry(g))
+		f.Properties = props[i]
+		fc.Append(f)
+	}
+	return fc
+}
+
+func TestAggregate(t *testing.T) {
+	pings := features(
+		[]space.Geometry{space.Point{0.5, 0.5}, space.Point{0.2, 0.7}, space.Point{1.5, 0.5}, space.Point{1, 0.5}, space.Point{5, 5}},
+		[]geojson.Properties{{"speed": 10.0, "car": "a"}, {"speed": 20.0, "car": "a"}, {"speed": 5, "car": "b"}, {"car": "c"}, {"speed": 1.0}},
+	)
+	squares := Zones{Options: Options{Shape: Square, CellSize: 1, Unit: Degrees}, Bound: space.Bound{Min: space.Point{0, 0}, Max: space.Point{2, 1}}}
+	aggregators := []Aggregator{{Op: Count}, {Op: Sum, Property: "speed"}, {Op: Mean, Property: "speed"},
+		{Op: Min, Property: "speed"}, {Op: Max, Property: "speed", Name: "top"}, {Op: Distinct, Property: "car"}}
+
+	weighted := squares
+	weighted.AreaWeighted = true
+	parcels := features(
+		[]space.Geometry{space.Polygon{{{0.5, 0}, {1.5, 0}, {1.5, 1}, {0.5, 1}, {0.5, 0}}}, space.Polygon{{{1.2, 0.2}, {1.4, 0.2}, {1.4, 0.4}, {1.2, 0.2}}}},
+		[]geojson.Properties{{"people": 10.0}, {"people": 4.0}},
+	)
+	custom := Zones{Grids: []Grid{
+		{Geometry: space.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+		{Geometry: space.Polygon{{{0, 0}, {1, 1}, {0, 1}, {0, 0}}}},
+		{Geometry: space.Polygon{{{3, 3}, {4, 3}, {4, 4}, {3, 3}}}},
+	}, SkipEmpty: true}
+
+	tests := []struct {
+		name        string
+		fc          *geojson.FeatureCollection
+		zones       Zones
+		aggregators []Aggregator
+		want        []geojson.Properties
+		wantIDs     []interface{}
+		wantErr     error
+	}{
+		{"points", pings, squares, aggregators, []geojson.Properties{
+			{"count": 3.0, "sum_speed": 30.0, "mean_speed": 15.0, "min_speed": 10.0, "top": 20.0, "distinct_car": 2},
+			{"count": 1.0, "sum_speed": 5.0, "mean_speed": 5.0, "min_speed": 5.0, "top": 5.0, "distinct_car": 1},
+		}, []interface{}{"0:0", "0:1"}, nil},
+		{"area weighted", parcels, weighted, []Aggregator{{Op: Count}, {Op: Sum, Property: "people"}}, []geojson.Properties{
+			{"count": 0.5, "sum_people": 5.0},
+			{"count": 1.5, "sum_people": 9.0},
+		}, []interface{}{"0:0", "0:1"}, nil},
+		{"intersecting", parcels, squares, []Aggregator{{Op: Count}, {Op: Sum, Property: "people"}}, []geojson.Properties{
+			{"count": 1.0, "sum_people": 10.0},
+			{"count": 2.0, "sum_people": 14.0},
+		}, []interface{}{"0:0", "0:1"}, nil},
+		{"custom", pings, custom, []Aggregator{{Op: Count}, {Op: Max, Property: "speed"}}, []geojson.Properties{
+			{"count": 2.0, "max_speed": 10.0},
+			{"count": 1.0, "max_speed": 20.0},
+		}, []interface{}{0, 1}, nil},
+		{"empty", nil, squares, []Aggregator{{Op: Count}, {Op: Mean, Property: "speed"}}, []geojson.Properties{
+			{"count": 0.0}, {"count": 0.0},
+		}, []interface{}{"0:0", "0:1"}, nil},
+		{"distinct missing", features(
+			[]space.Geometry{space.Point{0.5, 0.5}, space.Point{0.2, 0.7}, space.Point{1.5, 0.5}},
+			[]geojson.Properties{{"car": "a"}, {}, {"speed": 5.0}},
+		), squares, []Aggregator{{Op: Count}, {Op: Distinct, Property: "car"}}, []geojson.Properties{
+			{"count": 2.0, "distinct_car": 1},
+			{"count": 1.0, "distinct_car": 0},
+		}, []interface{}{"0:0", "0:1"}, nil},
+		{"property", pings, squares, []Aggregator{{Op: Sum}}, nil, nil, ErrInvalidAggregator},
+		{"operation", pings, squares, []Aggregator{{Op: AggregateOp(7), Property: "speed"}}, nil, nil, ErrInvalidAggregator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Aggregate(tt.fc, tt.zones, tt.aggregators...)
+			if err != tt.wantErr {
+				t.Fatalf("Aggregate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(got.Features) != len(tt.want) {
+				t.Fatalf("Aggregate() = %v cells, want %v", len(got.Features), len(tt.want))
+			}
+			for i, f := range got.Features {
+				if f.ID != tt.wantIDs[i] || !equalProperties(f.Properties, tt.want[i]) {
+					t.Errorf("Aggregate() cell %v = %v, want %v %v", f.ID, f.Properties, tt.wantIDs[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func equalProperties(got, want geojson.Properties) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for k, v := range want {
+		if f, ok := v.(float64); ok {
+			if g, ok := got[k].(float64); !ok || math.Abs(g-f) > 1e-9 {
+				return false
+			}
+		} else if !reflect.DeepEqual(got[k], v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,307 @@
+package grid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space"
+)
+
+// Shape the shape of the cells of a grid.
+type Shape int
+
+// const shapes of cells.
+const (
+	// Square cells of the size as sides.
+	Square Shape = iota
+	// Hexagon flat topped hexagons, of the size as circumradius, odd columns shifted up by half a cell.
+	Hexagon
+	// Triangle equilateral triangles of the size as sides, pointing up and down in turn along a row.
+	Triangle
+	// PointShape points at the centres of the square cells of the size.
+	PointShape
+)
+
+// Unit the unit of the size of cells.
+type Unit int
+
+// const units of the size of cells.
+const (
+	// Meters the size in meters, approximated in degrees from the corners of the bound as SquareGrid does.
+	Meters Unit = iota
+	// Degrees the size in degrees of longitude and latitude.
+	Degrees
+	// Projected the size in the units of the coordinates of a projected bound.
+	Projected
+)
+
+// ErrInvalidCellSize ...
+var ErrInvalidCellSize = fmt.Errorf("grid cell size must be positive")
+
+// ErrInvalidBound ...
+var ErrInvalidBound = fmt.Errorf("grid bound is not valid")
+
+// ErrInvalidShape ...
+var ErrInvalidShape = fmt.Errorf("grid shape is not valid")
+
+// ErrInvalidMask ...
+var ErrInvalidMask = fmt.Errorf("grid mask must be a polygon or a multipolygon")
+
+// Options the options of generating a grid.
+type Options struct {
+	Shape    Shape
+	CellSize float64
+	Unit     Unit
+
+	// Origin the point the lattice of cells is anchored at, {0, 0} if nil.
+	// Row and column 0 is the cell at the origin, so the same cell keeps its row and column for any bound,
+	// as long as the size of cells in coordinates is the same.
+	Origin space.Point
+
+	// Mask drops the cells whose interiors do not intersect the polygon or multipolygon if not nil.
+	Mask space.Geometry
+	// Clip clips the cells kept by the mask to the mask.
+	Clip bool
+}
+
+// Cell a cell of a grid with its row and column in the lattice of cells.
+type Cell struct {
+	Grid
+	Row, Column int
+}
+
+// ID returns the identifier of the cell from its row and column.
+func (c Cell) ID() string {
+	return fmt.Sprintf("%d:%d", c.Row, c.Column)
+}
+
+// Generate returns the cells of the options whose interiors intersect the bound, column by column from the west,
+// each column from the south.
+func Generate(bound space.Bound, opts Options) ([]Cell, error) {
+	it, err := NewIterator(bound, opts)
+	if err != nil {
+		return nil, err
+	}
+	cells := []Cell{}
+	for it.Next() {
+		cells = append(cells, it.Cell())
+	}
+	return cells, it.Err()
+}
+
+// Iterator generates the cells of a grid one at a time, in the order of Generate,
+// so grids of large extents are streamed without holding their cells.
+type Iterator struct {
+	opts   Options
+	bound  space.Bound
+	sx, sy float64
+	ox, oy float64
+	mask   *mask
+
+	column, lastColumn int
+	row, lastRow       int
+
+	cell Cell
+	err  error
+}
+
+// NewIterator returns an iterator over the cells of the options whose interiors intersect the bound.
+// A bound of no width or height gets the cells containing it.
+func NewIterator(bound space.Bound, opts Options) (*Iterator, error) {
+	if len(bound.Min) < 2 || len(bound.Max) < 2 || !(bound.Min[0] <= bound.Max[0]) || !(bound.Min[1] <= bound.Max[1]) {
+		return nil, ErrInvalidBound
+	}
+	if !(opts.CellSize > 0) || math.IsInf(opts.CellSize, 1) {
+		return nil, ErrInvalidCellSize
+	}
+	if opts.Shape < Square || opts.Shape > PointShape {
+		return nil, ErrInvalidShape
+	}
+	it := &Iterator{opts: opts, sx: opts.CellSize, sy: opts.CellSize}
+	if opts.Unit == Meters {
+		it.sx, it.sy = metersToDegrees(bound, opts.CellSize)
+	}
+	if len(opts.Origin) >= 2 {
+		it.ox, it.oy = opts.Origin[0], opts.Origin[1]
+	}
+	if opts.Mask != nil {
+		m, err := newMask(opts.Mask)
+		if err != nil {
+			return nil, err
+		}
+		it.mask = m
+	}
+
+	// a bound of no width or height widened to touch the cells around it
+	west, south, east, north := bound.Min[0], bound.Min[1], bound.Max[0], bound.Max[1]
+	if west == east && opts.Shape != PointShape {
+		west, east = west-it.sx*1e-9, east+it.sx*1e-9
+	}
+	if south == north && opts.Shape != PointShape {
+		south, north = south-it.sy*1e-9, north+it.sy*1e-9
+	}
+	it.bound = space.Bound{Min: space.Point{west, south}, Max: space.Point{east, north}}
+
+	switch opts.Shape {
+	case Hexagon:
+		it.column = int(math.Floor((west-it.sx-it.ox)/(1.5*it.sx))) - 1
+		it.lastColumn = int(math.Ceil((east+it.sx-it.ox)/(1.5*it.sx))) + 1
+	case Triangle:
+		it.column = int(math.Floor((west-it.ox)/(it.sx/2))) - 2
+		it.lastColumn = int(math.Ceil((east-it.ox)/(it.sx/2))) + 1
+	case PointShape:
+		it.column = int(math.Ceil((west-it.ox)/it.sx - 0.5))
+		it.lastColumn = int(math.Floor((east-it.ox)/it.sx - 0.5))
+	default:
+		it.column = int(math.Floor((west-it.ox)/it.sx)) - 1
+		it.lastColumn = int(math.Ceil((east-it.ox)/it.sx)) + 1
+	}
+	it.column--
+	it.row, it.lastRow = 0, -1
+	return it, nil
+}
+
+// Next advances to the next cell, it returns false when there are no more cells or on an error.
+func (it *Iterator) Next() bool {
+	for it.err == nil {
+		it.row++
+		if it.row > it.lastRow {
+			it.column++
+			if it.column > it.lastColumn {
+				return false
+			}
+			it.row, it.lastRow = it.rows(it.column)
+		}
+		geom, ok := it.geometry(it.column, it.row)
+		if !ok {
+			continue
+		}
+		if it.mask != nil {
+			var err error
+			if geom, err = it.mask.apply(geom, it.opts.Clip); err != nil {
+				it.err = err
+				return false
+			}
+			if geom == nil {
+				continue
+			}
+		}
+		it.cell = Cell{Grid: Grid{Geometry: geom}, Row: it.row, Column: it.column}
+		return true
+	}
+	return false
+}
+
+// Cell returns the current cell.
+func (it *Iterator) Cell() Cell {
+	return it.cell
+}
+
+// Err returns the error that stopped the iteration, nil if it completed.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// rows returns the range of rows of the column which may intersect the bound.
+func (it *Iterator) rows(column int) (int, int) {
+	south, north := it.bound.Min[1]-it.oy, it.bound.Max[1]-it.oy
+	switch it.opts.Shape {
+	case Hexagon:
+		height := 2 * Sin60 * it.sy
+		shift := float64(column&1) * Sin60 * it.sy
+		return int(math.Floor((south-shift)/height)) - 1, int(math.Ceil((north-shift)/height)) + 1
+	case Triangle:
+		height := Sin60 * it.sy
+		return int(math.Floor(south/height)) - 1, int(math.Ceil(north / height))
+	case PointShape:
+		return int(math.Ceil(south/it.sy - 0.5)), int(math.Floor(north/it.sy - 0.5))
+	}
+	return int(math.Floor(south/it.sy)) - 1, int(math.Ceil(north / it.sy))
+}
+
+// geometry returns the geometry of the cell, false if its interior does not intersect the bound.
+func (it *Iterator) geometry(column, row int) (space.Geometry, bool) {
+	sx, sy := it.sx, it.sy
+	var ring space.Ring
+	switch it.opts.Shape {
+	case Hexagon:
+		x := it.ox + float64(column)*1.5*sx
+		y := it.oy + float64(2*row+(column&1))*Sin60*sy
+		// The directions of the point 0、1、2、3、4、5 of the hexagon are 1、3、5、7、9、11 o'clock direction by turn.
+		ring = space.Ring{
+			{x + Cos60*sx, y + Sin60*sy},
+			{x + sx, y},
+			{x + Cos60*sx, y - Sin60*sy},
+			{x - Cos60*sx, y - Sin60*sy},
+			{x - sx, y},
+			{x - Cos60*sx, y + Sin60*sy},
+		}
+	case Triangle:
+		x := it.ox + float64(column)*sx/2
+		y0 := it.oy + float64(row)*Sin60*sy
+		y1 := y0 + Sin60*sy
+		if (row+column)&1 == 0 {
+			ring = space.Ring{{x, y0}, {x + sx/2, y1}, {x + sx, y0}}
+		} else {
+			ring = space.Ring{{x, y1}, {x + sx, y1}, {x + sx/2, y0}}
+		}
+	case PointShape:
+		return space.Point{it.ox + (float64(column)+0.5)*sx, it.oy + (float64(row)+0.5)*sy}, true
+	default:
+		x0, y0 := it.ox+float64(column)*sx, it.oy+float64(row)*sy
+		ring = space.Ring{{x0, y0}, {x0, y0 + sy}, {x0 + sx, y0 + sy}, {x0 + sx, y0}}
+	}
+	if !overlapsBound(ring, it.bound) {
+		return nil, false
+	}
+	ring = append(ring, ring[0])
+	return space.Polygon{ring}, true
+}
+
+// overlapsBound tests whether the interiors of the convex ring, not closed, and of the bound intersect,
+// no edge of the ring or of the bound separating them.
+func overlapsBound(ring space.Ring, bound space.Bound) bool {
+	minX, minY, maxX, maxY := ring[0][0], ring[0][1], ring[0][0], ring[0][1]
+	for _, p := range ring[1:] {
+		minX, maxX = math.Min(minX, p[0]), math.Max(maxX, p[0])
+		minY, maxY = math.Min(minY, p[1]), math.Max(maxY, p[1])
+	}
+	if maxX <= bound.Min[0] || minX >= bound.Max[0] || maxY <= bound.Min[1] || minY >= bound.Max[1] {
+		return false
+	}
+	corners := [4][2]float64{{bound.Min[0], bound.Min[1]}, {bound.Max[0], bound.Min[1]},
+		{bound.Max[0], bound.Max[1]}, {bound.Min[0], bound.Max[1]}}
+	for i := range ring {
+		a, b, inner := ring[i], ring[(i+1)%len(ring)], ring[(i+2)%len(ring)]
+		side := orientation(a, b, inner)
+		separated := true
+		for _, c := range corners {
+			if orientation(a, b, c[:])*side > 0 {
+				separated = false
+				break
+			}
+		}
+		if separated {
+			return false
+		}
+	}
+	return true
+}
+
+// metersToDegrees returns the size in meters in degrees of longitude and latitude,
+// from the distances between the corners of the bound, or along a degree for a bound of no width or height.
+func metersToDegrees(bound space.Bound, size float64) (float64, float64) {
+	west, south, north := bound.Min[0], bound.Min[1], bound.Max[1]
+	width, height := bound.Max[0]-west, north-south
+	if width == 0 {
+		width = 1
+	}
+	if height == 0 {
+		height, north = 1, south+1
+	}
+	sx := size * width / measure.SpheroidDistance(matrix.Matrix{west, south}, matrix.Matrix{west + width, south})
+	sy := size * height / measure.SpheroidDistance(matrix.Matrix{west, north}, matrix.Matrix{west, south})
+	return sx, sy
+}
@@ -0,0 +1,162 @@
+package grid
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/space"
+)
+
+func ids(cells []Cell) []string {
+	got := []string{}
+	for _, c := range cells {
+		got = append(got, c.ID())
+	}
+	return got
+}
+
+func TestGenerate(t *testing.T) {
+	bound := space.Bound{Min: space.Point{0, 0}, Max: space.Point{2, 1}}
+	tests := []struct {
+		name    string
+		bound   space.Bound
+		opts    Options
+		want    []string
+		wantErr error
+	}{
+		{"square", bound, Options{Shape: Square, CellSize: 1, Unit: Degrees}, []string{"0:0", "0:1"}, nil},
+		{"square inside", space.Bound{Min: space.Point{1.5, 0.5}, Max: space.Point{1.7, 0.7}},
+			Options{Shape: Square, CellSize: 1, Unit: Degrees}, []string{"0:1"}, nil},
+		{"square origin", bound, Options{Shape: Square, CellSize: 1, Unit: Degrees, Origin: space.Point{0.5, 0.5}},
+			[]string{"-1:-1", "0:-1", "-1:0", "0:0", "-1:1", "0:1"}, nil},
+		{"point on a line", space.Bound{Min: space.Point{1, 0.5}, Max: space.Point{1, 0.5}},
+			Options{Shape: Square, CellSize: 1, Unit: Projected}, []string{"0:0", "0:1"}, nil},
+		{"points", bound, Options{Shape: PointShape, CellSize: 0.5, Unit: Degrees},
+			[]string{"0:0", "1:0", "0:1", "1:1", "0:2", "1:2", "0:3", "1:3"}, nil},
+		{"hexagon", space.Bound{Min: space.Point{0, 0}, Max: space.Point{1, 1}}, Options{Shape: Hexagon, CellSize: 1, Unit: Degrees},
+			[]string{"0:0", "1:0", "0:1"}, nil},
+		{"triangle", space.Bound{Min: space.Point{0, 0}, Max: space.Point{1, 0.5}}, Options{Shape: Triangle, CellSize: 1, Unit: Degrees},
+			[]string{"0:-1", "0:0", "0:1"}, nil},
+		{"cell size", bound, Options{Shape: Square, CellSize: 0}, nil, ErrInvalidCellSize},
+		{"shape", bound, Options{Shape: Shape(9), CellSize: 1}, nil, ErrInvalidShape},
+		{"bound", space.Bound{Min: space.Point{1, 1}, Max: space.Point{0, 0}}, Options{CellSize: 1}, nil, ErrInvalidBound},
+		{"mask", bound, Options{CellSize: 1, Mask: space.LineString{{0, 0}, {1, 1}}}, nil, ErrInvalidMask},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Generate(tt.bound, tt.opts)
+			if err != tt.wantErr {
+				t.Fatalf("Generate() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(ids(got), tt.want) {
+				t.Errorf("Generate() = %v, want %v", ids(got), tt.want)
+			}
+		})
+	}
+
+	got, _ := Generate(bound, Options{Shape: Square, CellSize: 1, Unit: Degrees})
+	want := space.Polygon{{{1, 0}, {1, 1}, {2, 1}, {2, 0}, {1, 0}}}
+	if !reflect.DeepEqual(got[1].Geometry, want) || got[1].Row != 0 || got[1].Column != 1 {
+		t.Errorf("Generate() = %v, want %v", got[1], want)
+	}
+}
+
+func TestGenerate_Tiling(t *testing.T) {
+	bound := space.Bound{Min: space.Point{116.3, 39.8}, Max: space.Point{116.5, 40.0}}
+	r := rand.New(rand.NewSource(1))
+	for _, shape := range []Shape{Square, Hexagon, Triangle} {
+		cells, err := Generate(bound, Options{Shape: shape, CellSize: 3000})
+		if err != nil || len(cells) == 0 {
+			t.Fatalf("Generate() = %v, %v", len(cells), err)
+		}
+		for n := 0; n < 200; n++ {
+			point := matrix.Matrix{116.3 + r.Float64()*0.2, 39.8 + r.Float64()*0.2}
+			count := 0
+			for _, c := range cells {
+				if relate.LocateInPolygon(point, c.Geometry.ToMatrix().(matrix.PolygonMatrix)) == calc.ImInterior {
+					count++
+				}
+			}
+			if count != 1 {
+				t.Fatalf("shape %v: %v is in %v cells, want 1", shape, point, count)
+			}
+		}
+	}
+
+	// sizes in meters as SquareGrid
+	cells, _ := Generate(space.Bound{Min: space.Point{1, 1}, Max: space.Point{1.5, 1.5}}, Options{Shape: Square, CellSize: 30000})
+	square := SquareGrid(space.Bound{Min: space.Point{1, 1}, Max: space.Point{1.5, 1.5}}, 30000)
+	got, want := cells[0].Geometry.(space.Polygon)[0], square[0][0].Geometry.(space.Polygon)[0]
+	if math.Abs((got[2][0]-got[0][0])-(want[2][0]-want[0][0])) > 1e-12 || math.Abs((got[2][1]-got[0][1])-(want[2][1]-want[0][1])) > 1e-12 {
+		t.Errorf("Generate() cell = %v, want the size of %v", got, want)
+	}
+}
+
+func TestGenerate_Mask(t *testing.T) {
+	bound := space.Bound{Min: space.Point{0, 0}, Max: space.Point{10, 10}}
+	tests := []struct {
+		name  string
+		shape Shape
+		mask  space.Geometry
+	}{
+		{"notch", Square, space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {5, 3}, {0, 10}, {0, 0}}}},
+		{"hole", Hexagon, space.Polygon{{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{4.5, 4.5}, {4.5, 5.5}, {5.5, 5.5}, {5.5, 4.5}, {4.5, 4.5}}}},
+		{"parts", Triangle, space.MultiPolygon{
+			{{{0.5, 0.5}, {3.5, 0.5}, {3.5, 3.5}, {0.5, 3.5}, {0.5, 0.5}}},
+			{{{6, 6}, {9.5, 6}, {9.5, 9.5}, {6, 6}}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masked, err := Generate(bound, Options{Shape: tt.shape, CellSize: 1, Unit: Degrees, Mask: tt.mask})
+			if err != nil {
+				t.Fatal(err)
+			}
+			clipped, err := Generate(bound, Options{Shape: tt.shape, CellSize: 1, Unit: Degrees, Mask: tt.mask, Clip: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ids(masked), ids(clipped)) {
+				t.Errorf("Generate() = %v, want the cells %v", ids(clipped), ids(masked))
+			}
+			area := 0.0
+			for _, c := range clipped {
+				a, _ := c.Geometry.Area()
+				area += a
+			}
+			want, _ := tt.mask.Area()
+			if math.Abs(area-want) > 1e-9 {
+				t.Errorf("Generate() area = %v, want %v", area, want)
+			}
+		})
+	}
+
+	points, _ := Generate(bound, Options{Shape: PointShape, CellSize: 1, Unit: Degrees, Mask: tests[0].mask})
+	if len(points) != 66 {
+		t.Errorf("Generate() = %v points, want 66", len(points))
+	}
+}
+
+func TestIterator(t *testing.T) {
+	bound := space.Bound{Min: space.Point{-10, -10}, Max: space.Point{10, 10}}
+	it, err := NewIterator(bound, Options{Shape: Hexagon, CellSize: 0.5, Unit: Degrees})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cells, _ := Generate(bound, Options{Shape: Hexagon, CellSize: 0.5, Unit: Degrees})
+	n := 0
+	for it.Next() {
+		if c := it.Cell(); c.ID() != cells[n].ID() {
+			t.Fatalf("Next() = %v, want %v", c.ID(), cells[n].ID())
+		}
+		n++
+	}
+	if it.Err() != nil || n != len(cells) {
+		t.Errorf("Next() = %v cells, %v, want %v", n, it.Err(), len(cells))
+	}
+}
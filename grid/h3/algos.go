@@ -0,0 +1,441 @@
+package h3
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// directions the directions to the neighbours of a cell, counter clockwise from the j-axis.
+var directions = [6]direction{jAxesDigit, jkAxesDigit, kAxesDigit, ikAxesDigit, iAxesDigit, ijAxesDigit}
+
+// neighborRotations returns the neighbouring cell in the direction, rotated by rotations,
+// and the number of rotations into the coordinate system of the neighbour.
+// ErrPentagon is returned for the deleted k-axes subsequence of a pentagon.
+func (c Cell) neighborRotations(dir direction, rotations int) (Cell, int, error) {
+	current := c
+	rotations %= 6
+	for i := 0; i < rotations; i++ {
+		dir = dir.rotate60ccw()
+	}
+	newRotations := 0
+	oldBaseCell := c.BaseCell()
+	if oldBaseCell >= numBaseCells {
+		return 0, 0, ErrInvalidCell
+	}
+	oldLeadingDigit := c.leadingNonZeroDigit()
+
+	// move up the resolutions until the digit does not carry over to the parent
+	r := c.Resolution() - 1
+	for {
+		if r == -1 {
+			current.setBaseCell(baseCellNeighbors[oldBaseCell][dir])
+			newRotations = baseCellNeighbor60CCWRots[oldBaseCell][dir]
+			if current.BaseCell() == invalidBaseCell {
+				// moving into the deleted k subsequence of a pentagon base cell
+				current.setBaseCell(baseCellNeighbors[oldBaseCell][ikAxesDigit])
+				newRotations = baseCellNeighbor60CCWRots[oldBaseCell][ikAxesDigit]
+				current = current.rotate60ccw()
+				rotations++
+			}
+			break
+		}
+		oldDigit := current.digit(r + 1)
+		var nextDir direction
+		if oldDigit == invalidDigit {
+			return 0, 0, ErrInvalidCell
+		} else if isResClassIII(r + 1) {
+			current.setDigit(r+1, newDigitII[oldDigit][dir])
+			nextDir = newAdjustmentII[oldDigit][dir]
+		} else {
+			current.setDigit(r+1, newDigitIII[oldDigit][dir])
+			nextDir = newAdjustmentIII[oldDigit][dir]
+		}
+		if nextDir == centerDigit {
+			break
+		}
+		dir = nextDir
+		r--
+	}
+
+	newBaseCell := current.BaseCell()
+	if isBaseCellPentagon(newBaseCell) {
+		alreadyAdjustedKSubsequence := false
+		// force rotation out of the missing k-axes subsequence
+		if current.leadingNonZeroDigit() == kAxesDigit {
+			if oldBaseCell != newBaseCell {
+				// moving into the pentagon from another base cell
+				if baseCellIsCwOffset(newBaseCell, baseCellData[oldBaseCell].homeFijk.face) {
+					current = current.rotate60cw()
+				} else {
+					current = current.rotate60ccw()
+				}
+				alreadyAdjustedKSubsequence = true
+			} else {
+				// moving inside the pentagon base cell
+				switch oldLeadingDigit {
+				case centerDigit:
+					return 0, 0, ErrPentagon
+				case jkAxesDigit:
+					current = current.rotate60ccw()
+					rotations++
+				case ikAxesDigit:
+					current = current.rotate60cw()
+					rotations += 5
+				default:
+					return 0, 0, ErrInvalidCell
+				}
+			}
+		}
+		for i := 0; i < newRotations; i++ {
+			current = current.rotatePent60ccw()
+		}
+		if oldBaseCell != newBaseCell {
+			if isBaseCellPolarPentagon(newBaseCell) {
+				if oldBaseCell != 118 && oldBaseCell != 8 && current.leadingNonZeroDigit() != jkAxesDigit {
+					rotations++
+				}
+			} else if current.leadingNonZeroDigit() == ikAxesDigit && !alreadyAdjustedKSubsequence {
+				rotations++
+			}
+		}
+	} else {
+		for i := 0; i < newRotations; i++ {
+			current = current.rotate60ccw()
+		}
+	}
+	return current, (rotations + newRotations) % 6, nil
+}
+
+// GridDisk returns the cells within k steps of the origin, the origin first and then by increasing distance.
+func GridDisk(origin Cell, k int) ([]Cell, error) {
+	if k < 0 {
+		return nil, ErrInvalidDistance
+	}
+	if !origin.IsValid() {
+		return nil, ErrInvalidCell
+	}
+	cells := []Cell{origin}
+	seen := map[Cell]bool{origin: true}
+	ring := []Cell{origin}
+	for i := 0; i < k; i++ {
+		next := []Cell{}
+		for _, c := range ring {
+			for _, dir := range directions {
+				neighbor, _, err := c.neighborRotations(dir, 0)
+				if err == ErrPentagon {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				if !seen[neighbor] {
+					seen[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		cells = append(cells, next...)
+		ring = next
+	}
+	return cells, nil
+}
+
+// Polyfill returns the cells of the resolution whose center is in the polygon or multi polygon, in increasing order.
+func Polyfill(geom space.Geometry, res int) ([]Cell, error) {
+	if res < 0 || res > MaxResolution {
+		return nil, ErrInvalidResolution
+	}
+	var polygons []space.Polygon
+	switch g := geom.(type) {
+	case space.Polygon:
+		polygons = []space.Polygon{g}
+	case space.MultiPolygon:
+		polygons = g
+	default:
+		return nil, spaceerr.ErrNotPolygon
+	}
+	seen := map[Cell]bool{}
+	cells := []Cell{}
+	for _, polygon := range polygons {
+		if len(polygon) == 0 || len(polygon[0]) == 0 {
+			continue
+		}
+		found, err := newGeoPolygon(polygon).fill(res)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range found {
+			if !seen[c] {
+				seen[c] = true
+				cells = append(cells, c)
+			}
+		}
+	}
+	sort.Slice(cells, func(i, j int) bool { return cells[i] < cells[j] })
+	return cells, nil
+}
+
+// geoLoop a ring of a polygon in radians, not closed, with its bounding box.
+type geoLoop struct {
+	verts []latLng
+	bbox  bbox
+}
+
+// geoPolygon a polygon in radians.
+type geoPolygon []geoLoop
+
+func newGeoPolygon(polygon space.Polygon) geoPolygon {
+	loops := make(geoPolygon, 0, len(polygon))
+	for _, ring := range polygon {
+		n := len(ring)
+		if n > 1 && space.Point(ring[0]).EqualsPoint(ring[n-1]) {
+			n--
+		}
+		loop := geoLoop{verts: make([]latLng, n)}
+		for i := 0; i < n; i++ {
+			loop.verts[i] = latLng{lat: degreesToRadian * ring[i][1], lng: degreesToRadian * ring[i][0]}
+		}
+		loop.bbox = newBBox(loop.verts)
+		loops = append(loops, loop)
+	}
+	return loops
+}
+
+// fill returns the cells whose center is in the polygon,
+// flooding from the cells along the rings of the polygon.
+func (p geoPolygon) fill(res int) ([]Cell, error) {
+	search := []Cell{}
+	traced := map[Cell]bool{}
+	for _, loop := range p {
+		if err := loop.traceCells(res, traced, &search); err != nil {
+			return nil, err
+		}
+	}
+	in := map[Cell]bool{}
+	cells := []Cell{}
+	for len(search) > 0 {
+		found := []Cell{}
+		for _, c := range search {
+			ring, err := GridDisk(c, 1)
+			if err != nil {
+				return nil, err
+			}
+			for _, hex := range ring {
+				if in[hex] || !p.contains(hex.center()) {
+					continue
+				}
+				in[hex] = true
+				cells = append(cells, hex)
+				found = append(found, hex)
+			}
+		}
+		search = found
+	}
+	return cells, nil
+}
+
+// traceCells adds the cells along the edges of the loop to search.
+func (l geoLoop) traceCells(res int, traced map[Cell]bool, search *[]Cell) error {
+	pentagonRadius := hexRadiusKm(newCell(res, 4, centerDigit))
+	for i, origin := range l.verts {
+		destination := l.verts[(i+1)%len(l.verts)]
+		dist := greatCircleDistance(origin, destination) * earthRadiusKm
+		distCeil := math.Ceil(dist / (2 * pentagonRadius))
+		if math.IsInf(distCeil, 0) || math.IsNaN(distCeil) {
+			return ErrInvalidPoint
+		}
+		n := int64(distCeil)
+		if n == 0 {
+			n = 1
+		}
+		for j := int64(0); j < n; j++ {
+			interpolate := latLng{
+				lat: origin.lat*float64(n-j)/float64(n) + destination.lat*float64(j)/float64(n),
+				lng: origin.lng*float64(n-j)/float64(n) + destination.lng*float64(j)/float64(n),
+			}
+			fijk := geoToFaceIjk(interpolate, res)
+			c := faceIjkToCell(fijk, res)
+			if c == 0 {
+				return ErrInvalidPoint
+			}
+			if !traced[c] {
+				traced[c] = true
+				*search = append(*search, c)
+			}
+		}
+	}
+	return nil
+}
+
+// hexRadiusKm returns the distance in kilometers from the center of the cell to its first vertex.
+func hexRadiusKm(c Cell) float64 {
+	return greatCircleDistance(c.center(), c.boundary()[0]) * earthRadiusKm
+}
+
+// contains tests whether the point is in the outer ring and in no hole of the polygon.
+func (p geoPolygon) contains(g latLng) bool {
+	if !p[0].contains(g) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.contains(g) {
+			return false
+		}
+	}
+	return true
+}
+
+// contains tests whether the point is in the loop by ray casting,
+// points on the edges of the loop are biased to the west and the north.
+func (l geoLoop) contains(g latLng) bool {
+	if !l.bbox.contains(g) {
+		return false
+	}
+	isTransmeridian := l.bbox.isTransmeridian()
+	contains := false
+	lat := g.lat
+	lng := normalizeLng(g.lng, isTransmeridian)
+	for i, a := range l.verts {
+		b := l.verts[(i+1)%len(l.verts)]
+		if a.lat > b.lat {
+			a, b = b, a
+		}
+		if lat == a.lat || lat == b.lat {
+			lat += float64Epsilon
+		}
+		if lat < a.lat || lat > b.lat {
+			continue
+		}
+		aLng := normalizeLng(a.lng, isTransmeridian)
+		bLng := normalizeLng(b.lng, isTransmeridian)
+		if aLng == lng || bLng == lng {
+			lng -= float64Epsilon
+		}
+		ratio := (lat - a.lat) / (b.lat - a.lat)
+		testLng := normalizeLng(aLng+(bLng-aLng)*ratio, isTransmeridian)
+		if testLng > lng {
+			contains = !contains
+		}
+	}
+	return contains
+}
+
+func normalizeLng(lng float64, isTransmeridian bool) float64 {
+	if isTransmeridian && lng < 0 {
+		return lng + twoPi
+	}
+	return lng
+}
+
+// bbox a bounding box in radians, east is less than west if it crosses the antimeridian.
+type bbox struct {
+	north, south, east, west float64
+}
+
+func newBBox(verts []latLng) bbox {
+	if len(verts) == 0 {
+		return bbox{}
+	}
+	b := bbox{south: math.MaxFloat64, west: math.MaxFloat64, north: -math.MaxFloat64, east: -math.MaxFloat64}
+	minPosLng, maxNegLng := math.MaxFloat64, -math.MaxFloat64
+	isTransmeridian := false
+	for i, v := range verts {
+		next := verts[(i+1)%len(verts)]
+		b.south = math.Min(b.south, v.lat)
+		b.west = math.Min(b.west, v.lng)
+		b.north = math.Max(b.north, v.lat)
+		b.east = math.Max(b.east, v.lng)
+		if v.lng > 0 && v.lng < minPosLng {
+			minPosLng = v.lng
+		}
+		if v.lng < 0 && v.lng > maxNegLng {
+			maxNegLng = v.lng
+		}
+		// arcs over 180 degrees of longitude cross the antimeridian
+		if math.Abs(v.lng-next.lng) > math.Pi {
+			isTransmeridian = true
+		}
+	}
+	if isTransmeridian {
+		b.east, b.west = maxNegLng, minPosLng
+	}
+	return b
+}
+
+func (b bbox) isTransmeridian() bool {
+	return b.east < b.west
+}
+
+func (b bbox) contains(g latLng) bool {
+	if g.lat < b.south || g.lat > b.north {
+		return false
+	}
+	if b.isTransmeridian() {
+		return g.lng >= b.west || g.lng <= b.east
+	}
+	return g.lng >= b.west && g.lng <= b.east
+}
+
+// Compact returns the fewest cells covering the same area as the cells,
+// replacing the children of a cell by the cell, in increasing order. Duplicated cells are ignored.
+func Compact(cells []Cell) ([]Cell, error) {
+	byRes := make([]map[Cell]bool, MaxResolution+1)
+	for _, c := range cells {
+		if !c.IsValid() {
+			return nil, ErrInvalidCell
+		}
+		res := c.Resolution()
+		if byRes[res] == nil {
+			byRes[res] = map[Cell]bool{}
+		}
+		byRes[res][c] = true
+	}
+	compacted := []Cell{}
+	for res := MaxResolution; res >= 0; res-- {
+		if res == 0 {
+			for c := range byRes[0] {
+				compacted = append(compacted, c)
+			}
+			break
+		}
+		counts := map[Cell]int{}
+		for c := range byRes[res] {
+			parent, _ := c.Parent(res - 1)
+			counts[parent]++
+		}
+		for c := range byRes[res] {
+			parent, _ := c.Parent(res - 1)
+			if counts[parent] == childrenSize(parent, res) {
+				if byRes[res-1] == nil {
+					byRes[res-1] = map[Cell]bool{}
+				}
+				byRes[res-1][parent] = true
+			} else {
+				compacted = append(compacted, c)
+			}
+		}
+	}
+	sort.Slice(compacted, func(i, j int) bool { return compacted[i] < compacted[j] })
+	return compacted, nil
+}
+
+// Uncompact returns the cells of the resolution in the cells, each cell replaced by its children.
+func Uncompact(cells []Cell, res int) ([]Cell, error) {
+	if res < 0 || res > MaxResolution {
+		return nil, ErrInvalidResolution
+	}
+	uncompacted := []Cell{}
+	for _, c := range cells {
+		if !c.IsValid() {
+			return nil, ErrInvalidCell
+		}
+		if c.Resolution() > res {
+			return nil, ErrResolutionMismatch
+		}
+		uncompacted = c.appendChildren(uncompacted, res)
+	}
+	return uncompacted, nil
+}
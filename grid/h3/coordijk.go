@@ -0,0 +1,470 @@
+package h3
+
+import "math"
+
+// const ...
+const (
+	numIcosaFaces = 20
+	numBaseCells  = 122
+	numHexVerts   = 6
+	numPentVerts  = 5
+
+	invalidBaseCell = 127
+	maxFaceCoord    = 2
+
+	epsilon         = 0.0000000000000001
+	float32Epsilon  = 0x1p-23
+	float64Epsilon  = 0x1p-52
+	degreesToRadian = math.Pi / 180.0
+	radiansToDegree = 180.0 / math.Pi
+)
+
+// numeric constants of the reference library, typed to round them to float64 before use.
+// Products in sums are converted to float64 in this package, so they are not fused into multiply-adds.
+const (
+	twoPi         float64 = 6.28318530717958647692528676655900576839433
+	sqrt3Over2    float64 = 0.8660254037844386467637231707529361834714
+	sqrt7         float64 = 2.6457513110645905905016157536392604257102
+	ap7RotRads    float64 = 0.333473172251832115336090755351601070065900389
+	res0UGnomonic float64 = 0.38196601125010500003
+	earthRadiusKm float64 = 6371.007180918475
+)
+
+// direction a digit of a cell, the direction from the center child of its parent.
+type direction int
+
+// directions ...
+const (
+	centerDigit direction = iota
+	kAxesDigit
+	jAxesDigit
+	jkAxesDigit
+	iAxesDigit
+	ikAxesDigit
+	ijAxesDigit
+	invalidDigit
+)
+
+// quadrants of a face towards its neighbouring faces.
+const (
+	ijQuadrant = 1
+	kiQuadrant = 2
+	jkQuadrant = 3
+)
+
+// overages of coordinates beyond a face.
+const (
+	noOverage = iota
+	faceEdge
+	newFace
+)
+
+// latLng latitude and longitude in radians.
+type latLng struct {
+	lat, lng float64
+}
+
+// vec2d a point in a hex2d coordinate system.
+type vec2d struct {
+	x, y float64
+}
+
+// vec3d a point on the unit sphere.
+type vec3d struct {
+	x, y, z float64
+}
+
+// coordIJK ijk+ hexagon coordinates, each axis 120 degrees apart.
+type coordIJK struct {
+	i, j, k int
+}
+
+// faceIJK a face of the icosahedron and ijk coordinates on it.
+type faceIJK struct {
+	face  int
+	coord coordIJK
+}
+
+// faceOrientIJK the face, translation and rotation into the coordinate system of a neighbouring face.
+type faceOrientIJK struct {
+	face      int
+	translate coordIJK
+	ccwRot60  int
+}
+
+// baseCellRotation a base cell and the number of 60 degree counter clockwise rotations into its coordinate system.
+type baseCellRotation struct {
+	baseCell int
+	ccwRot60 int
+}
+
+// baseCellInfo the home face and coordinates of a base cell.
+type baseCellInfo struct {
+	homeFijk     faceIJK
+	isPentagon   bool
+	cwOffsetPent [2]int
+}
+
+// unitVecs the unit vectors of the directions.
+var unitVecs = [7]coordIJK{{0, 0, 0}, {0, 0, 1}, {0, 1, 0}, {0, 1, 1}, {1, 0, 0}, {1, 0, 1}, {1, 1, 0}}
+
+func isResClassIII(res int) bool {
+	return res%2 == 1
+}
+
+// hex2dToCoordIJK returns the ijk+ coordinates of the hexagon containing the hex2d point.
+func hex2dToCoordIJK(v vec2d) coordIJK {
+	h := coordIJK{}
+	a1, a2 := math.Abs(v.x), math.Abs(v.y)
+	x2 := a2 / sqrt3Over2
+	x1 := a1 + x2/2.0
+	m1, m2 := int(x1), int(x2)
+	r1, r2 := x1-float64(m1), x2-float64(m2)
+
+	if r1 < 0.5 {
+		if r1 < 1.0/3.0 {
+			h.i = m1
+			if r2 < (1.0+r1)/2.0 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+		} else {
+			if r2 < 1.0-r1 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+			if 1.0-r1 <= r2 && r2 < 2.0*r1 {
+				h.i = m1 + 1
+			} else {
+				h.i = m1
+			}
+		}
+	} else {
+		if r1 < 2.0/3.0 {
+			if r2 < 1.0-r1 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+			if 2.0*r1-1.0 < r2 && r2 < 1.0-r1 {
+				h.i = m1
+			} else {
+				h.i = m1 + 1
+			}
+		} else {
+			h.i = m1 + 1
+			if r2 < r1/2.0 {
+				h.j = m2
+			} else {
+				h.j = m2 + 1
+			}
+		}
+	}
+
+	// fold across the axes if necessary
+	if v.x < 0.0 {
+		if h.j%2 == 0 {
+			axisi := h.j / 2
+			diff := h.i - axisi
+			h.i -= 2 * diff
+		} else {
+			axisi := (h.j + 1) / 2
+			diff := h.i - axisi
+			h.i -= 2*diff + 1
+		}
+	}
+	if v.y < 0.0 {
+		h.i -= (2*h.j + 1) / 2
+		h.j = -h.j
+	}
+	h.normalize()
+	return h
+}
+
+// toHex2d returns the center of the hexagon in hex2d coordinates.
+func (c coordIJK) toHex2d() vec2d {
+	i, j := c.i-c.k, c.j-c.k
+	return vec2d{float64(i) - 0.5*float64(j), float64(j) * sqrt3Over2}
+}
+
+func (c coordIJK) add(o coordIJK) coordIJK {
+	return coordIJK{c.i + o.i, c.j + o.j, c.k + o.k}
+}
+
+func (c coordIJK) sub(o coordIJK) coordIJK {
+	return coordIJK{c.i - o.i, c.j - o.j, c.k - o.k}
+}
+
+func (c coordIJK) scale(factor int) coordIJK {
+	return coordIJK{c.i * factor, c.j * factor, c.k * factor}
+}
+
+// normalize normalizes the coordinates to ijk+, with no negative and at least one zero component.
+func (c *coordIJK) normalize() {
+	if c.i < 0 {
+		c.j -= c.i
+		c.k -= c.i
+		c.i = 0
+	}
+	if c.j < 0 {
+		c.i -= c.j
+		c.k -= c.j
+		c.j = 0
+	}
+	if c.k < 0 {
+		c.i -= c.k
+		c.j -= c.k
+		c.k = 0
+	}
+	min := c.i
+	if c.j < min {
+		min = c.j
+	}
+	if c.k < min {
+		min = c.k
+	}
+	if min > 0 {
+		c.i -= min
+		c.j -= min
+		c.k -= min
+	}
+}
+
+// toDigit returns the direction of unit ijk coordinates, invalidDigit if not a unit vector.
+func (c coordIJK) toDigit() direction {
+	c.normalize()
+	for i, v := range unitVecs {
+		if c == v {
+			return direction(i)
+		}
+	}
+	return invalidDigit
+}
+
+// combine sets the coordinates to the sum of the vectors scaled by its components.
+func (c *coordIJK) combine(iVec, jVec, kVec coordIJK) {
+	*c = iVec.scale(c.i).add(jVec.scale(c.j)).add(kVec.scale(c.k))
+	c.normalize()
+}
+
+// upAp7 moves to the containing cell of the parent counter clockwise aperture 7 grid.
+func (c *coordIJK) upAp7() {
+	i, j := c.i-c.k, c.j-c.k
+	*c = coordIJK{int(math.Round(float64(3*i-j) / 7.0)), int(math.Round(float64(i+2*j) / 7.0)), 0}
+	c.normalize()
+}
+
+// upAp7r moves to the containing cell of the parent clockwise aperture 7 grid.
+func (c *coordIJK) upAp7r() {
+	i, j := c.i-c.k, c.j-c.k
+	*c = coordIJK{int(math.Round(float64(2*i+j) / 7.0)), int(math.Round(float64(3*j-i) / 7.0)), 0}
+	c.normalize()
+}
+
+// downAp7 moves to the center cell of the child counter clockwise aperture 7 grid.
+func (c *coordIJK) downAp7() {
+	c.combine(coordIJK{3, 0, 1}, coordIJK{1, 3, 0}, coordIJK{0, 1, 3})
+}
+
+// downAp7r moves to the center cell of the child clockwise aperture 7 grid.
+func (c *coordIJK) downAp7r() {
+	c.combine(coordIJK{3, 1, 0}, coordIJK{0, 3, 1}, coordIJK{1, 0, 3})
+}
+
+// downAp3 moves to the center cell of the child counter clockwise aperture 3 grid.
+func (c *coordIJK) downAp3() {
+	c.combine(coordIJK{2, 0, 1}, coordIJK{1, 2, 0}, coordIJK{0, 1, 2})
+}
+
+// downAp3r moves to the center cell of the child clockwise aperture 3 grid.
+func (c *coordIJK) downAp3r() {
+	c.combine(coordIJK{2, 1, 0}, coordIJK{0, 2, 1}, coordIJK{1, 0, 2})
+}
+
+// neighbor moves to the neighbouring cell in the direction.
+func (c *coordIJK) neighbor(digit direction) {
+	if digit > centerDigit && digit < invalidDigit {
+		*c = c.add(unitVecs[digit])
+		c.normalize()
+	}
+}
+
+// rotate60ccw rotates the coordinates 60 degrees counter clockwise.
+func (c *coordIJK) rotate60ccw() {
+	c.combine(coordIJK{1, 1, 0}, coordIJK{0, 1, 1}, coordIJK{1, 0, 1})
+}
+
+// rotate60cw rotates the coordinates 60 degrees clockwise.
+func (c *coordIJK) rotate60cw() {
+	c.combine(coordIJK{1, 0, 1}, coordIJK{1, 1, 0}, coordIJK{0, 1, 1})
+}
+
+// distance returns the grid distance between the coordinates.
+func (c coordIJK) distance(o coordIJK) int {
+	diff := c.sub(o)
+	diff.normalize()
+	max := abs(diff.i)
+	if v := abs(diff.j); v > max {
+		max = v
+	}
+	if v := abs(diff.k); v > max {
+		max = v
+	}
+	return max
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// rotate60ccw rotates the direction 60 degrees counter clockwise.
+func (d direction) rotate60ccw() direction {
+	switch d {
+	case kAxesDigit:
+		return ikAxesDigit
+	case ikAxesDigit:
+		return iAxesDigit
+	case iAxesDigit:
+		return ijAxesDigit
+	case ijAxesDigit:
+		return jAxesDigit
+	case jAxesDigit:
+		return jkAxesDigit
+	case jkAxesDigit:
+		return kAxesDigit
+	default:
+		return d
+	}
+}
+
+// rotate60cw rotates the direction 60 degrees clockwise.
+func (d direction) rotate60cw() direction {
+	switch d {
+	case kAxesDigit:
+		return jkAxesDigit
+	case jkAxesDigit:
+		return jAxesDigit
+	case jAxesDigit:
+		return ijAxesDigit
+	case ijAxesDigit:
+		return iAxesDigit
+	case iAxesDigit:
+		return ikAxesDigit
+	case ikAxesDigit:
+		return kAxesDigit
+	default:
+		return d
+	}
+}
+
+// magnitude returns the length of the vector.
+func (v vec2d) magnitude() float64 {
+	return math.Sqrt(float64(v.x*v.x) + float64(v.y*v.y))
+}
+
+// intersect returns the intersection of the lines through p0 and p1, and through p2 and p3.
+func intersect(p0, p1, p2, p3 vec2d) vec2d {
+	s1 := vec2d{p1.x - p0.x, p1.y - p0.y}
+	s2 := vec2d{p3.x - p2.x, p3.y - p2.y}
+	t := (float64(s2.x*(p0.y-p2.y)) - float64(s2.y*(p0.x-p2.x))) / (float64(-s2.x*s1.y) + float64(s1.x*s2.y))
+	return vec2d{p0.x + float64(t*s1.x), p0.y + float64(t*s1.y)}
+}
+
+// almostEquals tests whether the vectors are equal within float32 precision.
+func (v vec2d) almostEquals(o vec2d) bool {
+	return math.Abs(v.x-o.x) < float32Epsilon && math.Abs(v.y-o.y) < float32Epsilon
+}
+
+// toVec3d returns the point on the unit sphere.
+func (g latLng) toVec3d() vec3d {
+	r := math.Cos(g.lat)
+	return vec3d{math.Cos(g.lng) * r, math.Sin(g.lng) * r, math.Sin(g.lat)}
+}
+
+// squareDistance returns the square of the distance between the points.
+func (v vec3d) squareDistance(o vec3d) float64 {
+	dx, dy, dz := v.x-o.x, v.y-o.y, v.z-o.z
+	return float64(dx*dx) + float64(dy*dy) + float64(dz*dz)
+}
+
+// posAngleRads normalizes the angle in radians to [0, 2pi).
+func posAngleRads(rads float64) float64 {
+	tmp := rads
+	if rads < 0.0 {
+		tmp = rads + twoPi
+	}
+	if rads >= twoPi {
+		tmp -= twoPi
+	}
+	return tmp
+}
+
+// constrainLng normalizes the longitude in radians to [-pi, pi].
+func constrainLng(lng float64) float64 {
+	for lng > math.Pi {
+		lng -= 2 * math.Pi
+	}
+	for lng < -math.Pi {
+		lng += 2 * math.Pi
+	}
+	return lng
+}
+
+// greatCircleDistance returns the great circle distance in radians between the points.
+func greatCircleDistance(a, b latLng) float64 {
+	sinLat := math.Sin((b.lat - a.lat) / 2.0)
+	sinLng := math.Sin((b.lng - a.lng) / 2.0)
+	v := float64(sinLat*sinLat) + float64(math.Cos(a.lat)*math.Cos(b.lat)*sinLng*sinLng)
+	return 2 * math.Atan2(math.Sqrt(v), math.Sqrt(1-v))
+}
+
+// azimuth returns the azimuth in radians from p1 to p2.
+func azimuth(p1, p2 latLng) float64 {
+	return math.Atan2(math.Cos(p2.lat)*math.Sin(p2.lng-p1.lng),
+		float64(math.Cos(p1.lat)*math.Sin(p2.lat))-float64(math.Sin(p1.lat)*math.Cos(p2.lat)*math.Cos(p2.lng-p1.lng)))
+}
+
+// azDistance returns the point at the azimuth and distance in radians from p1.
+func azDistance(p1 latLng, az, distance float64) latLng {
+	if distance < epsilon {
+		return p1
+	}
+	p2 := latLng{}
+	az = posAngleRads(az)
+	if az < epsilon || math.Abs(az-math.Pi) < epsilon {
+		// due north or south
+		if az < epsilon {
+			p2.lat = p1.lat + distance
+		} else {
+			p2.lat = p1.lat - distance
+		}
+		if math.Abs(p2.lat-math.Pi/2) < epsilon {
+			p2.lat, p2.lng = math.Pi/2, 0.0
+		} else if math.Abs(p2.lat+math.Pi/2) < epsilon {
+			p2.lat, p2.lng = -math.Pi/2, 0.0
+		} else {
+			p2.lng = constrainLng(p1.lng)
+		}
+		return p2
+	}
+	sinlat := float64(math.Sin(p1.lat)*math.Cos(distance)) + float64(math.Cos(p1.lat)*math.Sin(distance)*math.Cos(az))
+	sinlat = math.Max(-1.0, math.Min(1.0, sinlat))
+	p2.lat = math.Asin(sinlat)
+	if math.Abs(p2.lat-math.Pi/2) < epsilon {
+		p2.lat, p2.lng = math.Pi/2, 0.0
+	} else if math.Abs(p2.lat+math.Pi/2) < epsilon {
+		p2.lat, p2.lng = -math.Pi/2, 0.0
+	} else {
+		sinlng := math.Sin(az) * math.Sin(distance) / math.Cos(p2.lat)
+		coslng := (math.Cos(distance) - float64(math.Sin(p1.lat)*math.Sin(p2.lat))) / math.Cos(p1.lat) / math.Cos(p2.lat)
+		sinlng = math.Max(-1.0, math.Min(1.0, sinlng))
+		coslng = math.Max(-1.0, math.Min(1.0, coslng))
+		p2.lng = constrainLng(p1.lng + math.Atan2(sinlng, coslng))
+	}
+	return p2
+}
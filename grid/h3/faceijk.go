@@ -0,0 +1,245 @@
+package h3
+
+import "math"
+
+// geoToFaceIjk returns the face and ijk coordinates of the cell of the resolution containing the point.
+func geoToFaceIjk(g latLng, res int) faceIJK {
+	face, v := geoToHex2d(g, res)
+	return faceIJK{face: face, coord: hex2dToCoordIJK(v)}
+}
+
+// geoToHex2d returns the closest face to the point and the hex2d coordinates of the point on it.
+func geoToHex2d(g latLng, res int) (int, vec2d) {
+	face, sqd := geoToClosestFace(g)
+	// math.Cos(r) = 1 - 2 * sin^2(r/2) = 1 - 2 * (sqd / 4) = 1 - sqd/2
+	r := math.Acos(1 - sqd/2)
+	if r < epsilon {
+		return face, vec2d{}
+	}
+	// counter clockwise theta from the Class II i-axis
+	theta := posAngleRads(faceAxesAzRadsCII[face][0] - posAngleRads(azimuth(faceCenterGeo[face], g)))
+	if isResClassIII(res) {
+		theta = posAngleRads(theta - ap7RotRads)
+	}
+	// gnomonic scaling of r, then scaling for the resolution
+	r = math.Tan(r)
+	r /= res0UGnomonic
+	for i := 0; i < res; i++ {
+		r *= sqrt7
+	}
+	return face, vec2d{r * math.Cos(theta), r * math.Sin(theta)}
+}
+
+// hex2dToGeo returns the point of the hex2d coordinates on the face,
+// substrate is true if the coordinates are in the aperture 3 substrate grid of the resolution.
+func hex2dToGeo(v vec2d, face, res int, substrate bool) latLng {
+	r := v.magnitude()
+	if r < epsilon {
+		return faceCenterGeo[face]
+	}
+	theta := math.Atan2(v.y, v.x)
+	for i := 0; i < res; i++ {
+		r /= sqrt7
+	}
+	if substrate {
+		r /= 3.0
+		if isResClassIII(res) {
+			r /= sqrt7
+		}
+	}
+	r *= res0UGnomonic
+	// inverse gnomonic scaling of r
+	r = math.Atan(r)
+	if !substrate && isResClassIII(res) {
+		theta = posAngleRads(theta + ap7RotRads)
+	}
+	theta = posAngleRads(faceAxesAzRadsCII[face][0] - theta)
+	return azDistance(faceCenterGeo[face], theta, r)
+}
+
+// geoToClosestFace returns the face whose center is the closest to the point,
+// and the square of the distance between them on the unit sphere.
+func geoToClosestFace(g latLng) (int, float64) {
+	v := g.toVec3d()
+	face, sqd := 0, 5.0
+	for f := 0; f < numIcosaFaces; f++ {
+		if d := faceCenterPoint[f].squareDistance(v); d < sqd {
+			face, sqd = f, d
+		}
+	}
+	return face, sqd
+}
+
+// toGeo returns the center of the cell.
+func (h faceIJK) toGeo(res int) latLng {
+	return hex2dToGeo(h.coord.toHex2d(), h.face, res, false)
+}
+
+// verts returns the vertices of the cell in the substrate grid and the resolution of the substrate grid,
+// n vertices for a pentagon or a hexagon.
+func (h faceIJK) verts(res, n int) ([]faceIJK, int) {
+	// vertices of an origin centered cell in the substrate grid, counter clockwise from the i-axis
+	vertsCII := []coordIJK{{2, 1, 0}, {1, 2, 0}, {0, 2, 1}, {0, 1, 2}, {1, 0, 2}, {2, 0, 1}}
+	vertsCIII := []coordIJK{{5, 4, 0}, {1, 5, 0}, {0, 5, 4}, {0, 1, 5}, {4, 0, 5}, {5, 0, 1}}
+	verts := vertsCII
+	if isResClassIII(res) {
+		verts = vertsCIII
+	}
+	// the center in the aperture 33r substrate grid, with a clockwise aperture 7 to Class II
+	h.coord.downAp3()
+	h.coord.downAp3r()
+	if isResClassIII(res) {
+		h.coord.downAp7r()
+		res++
+	}
+	fijkVerts := make([]faceIJK, n)
+	for v := range fijkVerts {
+		fijkVerts[v].face = h.face
+		fijkVerts[v].coord = h.coord.add(verts[v])
+		fijkVerts[v].coord.normalize()
+	}
+	return fijkVerts, res
+}
+
+// icosaEdge returns the vertices of the edge of the face in the quadrant, in hex2d coordinates.
+func icosaEdge(adjRes, quadrant int) (vec2d, vec2d) {
+	maxDim := float64(maxDimByCIIres[adjRes])
+	v0 := vec2d{3.0 * maxDim, 0.0}
+	v1 := vec2d{-1.5 * maxDim, 3.0 * sqrt3Over2 * maxDim}
+	v2 := vec2d{-1.5 * maxDim, -v1.y}
+	switch quadrant {
+	case ijQuadrant:
+		return v0, v1
+	case jkQuadrant:
+		return v1, v2
+	default:
+		return v2, v0
+	}
+}
+
+// toBoundary returns the vertices of the hexagon, with the points where its edges cross faces.
+func (h faceIJK) toBoundary(res int) []latLng {
+	fijkVerts, adjRes := h.verts(res, numHexVerts)
+	boundary := make([]latLng, 0, 10)
+	lastFace, lastOverage := -1, noOverage
+	// one more iteration for a crossing on the last edge
+	for vert := 0; vert < numHexVerts+1; vert++ {
+		v := vert % numHexVerts
+		fijk := fijkVerts[v]
+		overage := fijk.adjustOverageClassII(adjRes, false, true)
+		// Class III edges crossing an icosahedron edge have a vertex at the crossing,
+		// Class II cells have their vertices on the edges
+		if isResClassIII(res) && vert > 0 && fijk.face != lastFace && lastOverage != faceEdge {
+			lastV := (v + 5) % numHexVerts
+			orig2d0 := fijkVerts[lastV].coord.toHex2d()
+			orig2d1 := fijkVerts[v].coord.toHex2d()
+			face2 := lastFace
+			if lastFace == h.face {
+				face2 = fijk.face
+			}
+			edge0, edge1 := icosaEdge(adjRes, adjacentFaceDir[h.face][face2])
+			inter := intersect(orig2d0, orig2d1, edge0, edge1)
+			// a crossing at a vertex of the hexagon needs no additional vertex
+			if !orig2d0.almostEquals(inter) && !orig2d1.almostEquals(inter) {
+				boundary = append(boundary, hex2dToGeo(inter, h.face, adjRes, true))
+			}
+		}
+		if vert < numHexVerts {
+			boundary = append(boundary, hex2dToGeo(fijk.coord.toHex2d(), fijk.face, adjRes, true))
+		}
+		lastFace, lastOverage = fijk.face, overage
+	}
+	return boundary
+}
+
+// toPentBoundary returns the vertices of the pentagon, with the points where its edges cross faces.
+func (h faceIJK) toPentBoundary(res int) []latLng {
+	fijkVerts, adjRes := h.verts(res, numPentVerts)
+	boundary := make([]latLng, 0, 10)
+	var lastFijk faceIJK
+	for vert := 0; vert < numPentVerts+1; vert++ {
+		v := vert % numPentVerts
+		fijk := fijkVerts[v]
+		fijk.adjustPentVertOverage(adjRes)
+		// all Class III pentagon edges cross icosahedron edges
+		if isResClassIII(res) && vert > 0 {
+			tmpFijk := fijk
+			orig2d0 := lastFijk.coord.toHex2d()
+			fijkOrient := faceNeighbors[tmpFijk.face][adjacentFaceDir[tmpFijk.face][lastFijk.face]]
+			tmpFijk.face = fijkOrient.face
+			for i := 0; i < fijkOrient.ccwRot60; i++ {
+				tmpFijk.coord.rotate60ccw()
+			}
+			tmpFijk.coord = tmpFijk.coord.add(fijkOrient.translate.scale(unitScaleByCIIres[adjRes] * 3))
+			tmpFijk.coord.normalize()
+			orig2d1 := tmpFijk.coord.toHex2d()
+			edge0, edge1 := icosaEdge(adjRes, adjacentFaceDir[tmpFijk.face][fijk.face])
+			inter := intersect(orig2d0, orig2d1, edge0, edge1)
+			boundary = append(boundary, hex2dToGeo(inter, tmpFijk.face, adjRes, true))
+		}
+		if vert < numPentVerts {
+			boundary = append(boundary, hex2dToGeo(fijk.coord.toHex2d(), fijk.face, adjRes, true))
+		}
+		lastFijk = fijk
+	}
+	return boundary
+}
+
+// adjustOverageClassII moves the coordinates of a Class II cell beyond the face to the neighbouring face,
+// and returns the overage. pentLeading4 is true for a pentagon with leading digit 4,
+// substrate is true if the coordinates are in the substrate grid.
+func (h *faceIJK) adjustOverageClassII(res int, pentLeading4, substrate bool) int {
+	overage := noOverage
+	ijk := &h.coord
+	maxDim := maxDimByCIIres[res]
+	if substrate {
+		maxDim *= 3
+	}
+	if substrate && ijk.i+ijk.j+ijk.k == maxDim {
+		return faceEdge
+	}
+	if ijk.i+ijk.j+ijk.k > maxDim {
+		overage = newFace
+		var fijkOrient faceOrientIJK
+		if ijk.k > 0 {
+			if ijk.j > 0 {
+				fijkOrient = faceNeighbors[h.face][jkQuadrant]
+			} else {
+				fijkOrient = faceNeighbors[h.face][kiQuadrant]
+				// adjust for the pentagonal missing sequence
+				if pentLeading4 {
+					origin := coordIJK{maxDim, 0, 0}
+					tmp := ijk.sub(origin)
+					tmp.rotate60cw()
+					*ijk = tmp.add(origin)
+				}
+			}
+		} else {
+			fijkOrient = faceNeighbors[h.face][ijQuadrant]
+		}
+		h.face = fijkOrient.face
+		for i := 0; i < fijkOrient.ccwRot60; i++ {
+			ijk.rotate60ccw()
+		}
+		unitScale := unitScaleByCIIres[res]
+		if substrate {
+			unitScale *= 3
+		}
+		*ijk = ijk.add(fijkOrient.translate.scale(unitScale))
+		ijk.normalize()
+		// overage points on pentagon boundaries can end up on edges
+		if substrate && ijk.i+ijk.j+ijk.k == maxDim {
+			overage = faceEdge
+		}
+	}
+	return overage
+}
+
+// adjustPentVertOverage moves a vertex of a pentagon in the substrate grid to the face it is on.
+func (h *faceIJK) adjustPentVertOverage(res int) int {
+	for {
+		if overage := h.adjustOverageClassII(res, false, true); overage != newFace {
+			return overage
+		}
+	}
+}
@@ -0,0 +1,500 @@
+// Package h3 is a hierarchical hexagonal grid index compatible with the H3 library of Uber.
+// The earth is projected on the faces of an icosahedron, tiled with 122 base cells at resolution 0,
+// each cell being split into 7 cells at the next resolution, down to resolution 15.
+// Cells are identified by the same 64 bit indexes as H3.
+//
+// The algorithms and tables are ported from the reference C library of H3, https://github.com/uber/h3,
+// licensed under the Apache License, Version 2.0.
+// Cell indexes are the same as computed by the reference library, and coordinates of centers and boundaries
+// agree with it within about 1e-11 degrees, the trigonometric functions of package math and of C differing in the last bit.
+// Points are longitude and latitude in degrees.
+package h3
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/spatial-go/geoos/grid"
+	"github.com/spatial-go/geoos/space"
+)
+
+// MaxResolution the finest resolution of cells.
+const MaxResolution = 15
+
+// layout of the bits of an index.
+const (
+	maxOffset      = 63
+	modeOffset     = 59
+	baseCellOffset = 45
+	resOffset      = 52
+	reservedOffset = 56
+	perDigitOffset = 3
+
+	highBitMask  = uint64(1) << maxOffset
+	modeMask     = uint64(15) << modeOffset
+	baseCellMask = uint64(127) << baseCellOffset
+	resMask      = uint64(15) << resOffset
+	reservedMask = uint64(7) << reservedOffset
+	digitMask    = uint64(7)
+
+	initIndex = uint64(35184372088831)
+	cellMode  = 1
+)
+
+// ErrInvalidResolution ...
+var ErrInvalidResolution = fmt.Errorf("h3 resolution must be between 0 and %d", MaxResolution)
+
+// ErrInvalidCell ...
+var ErrInvalidCell = fmt.Errorf("h3 cell is not valid")
+
+// ErrInvalidPoint ...
+var ErrInvalidPoint = fmt.Errorf("point is not a valid longitude and latitude")
+
+// ErrResolutionMismatch ...
+var ErrResolutionMismatch = fmt.Errorf("h3 resolution does not match the cell")
+
+// ErrInvalidDistance ...
+var ErrInvalidDistance = fmt.Errorf("grid distance must not be negative")
+
+// ErrPentagon ...
+var ErrPentagon = fmt.Errorf("h3 cells distorted by a pentagon")
+
+// Cell an index of a cell of the grid.
+type Cell uint64
+
+// newCell returns the cell of the resolution and base cell, with all digits set to the digit.
+func newCell(res, baseCell int, digit direction) Cell {
+	h := Cell(initIndex)
+	h.setMode(cellMode)
+	h.setResolution(res)
+	h.setBaseCell(baseCell)
+	for r := 1; r <= res; r++ {
+		h.setDigit(r, digit)
+	}
+	return h
+}
+
+func (c Cell) mode() int {
+	return int((uint64(c) & modeMask) >> modeOffset)
+}
+
+func (c *Cell) setMode(mode int) {
+	*c = Cell(uint64(*c)&^modeMask | uint64(mode)<<modeOffset)
+}
+
+// BaseCell returns the base cell of the cell, between 0 and 121.
+func (c Cell) BaseCell() int {
+	return int((uint64(c) & baseCellMask) >> baseCellOffset)
+}
+
+func (c *Cell) setBaseCell(baseCell int) {
+	*c = Cell(uint64(*c)&^baseCellMask | uint64(baseCell)<<baseCellOffset)
+}
+
+// Resolution returns the resolution of the cell.
+func (c Cell) Resolution() int {
+	return int((uint64(c) & resMask) >> resOffset)
+}
+
+func (c *Cell) setResolution(res int) {
+	*c = Cell(uint64(*c)&^resMask | uint64(res)<<resOffset)
+}
+
+func (c Cell) reserved() int {
+	return int((uint64(c) & reservedMask) >> reservedOffset)
+}
+
+func (c *Cell) setReserved(v int) {
+	*c = Cell(uint64(*c)&^reservedMask | uint64(v)<<reservedOffset)
+}
+
+func (c Cell) digit(res int) direction {
+	return direction((uint64(c) >> ((MaxResolution - res) * perDigitOffset)) & digitMask)
+}
+
+func (c *Cell) setDigit(res int, digit direction) {
+	shift := (MaxResolution - res) * perDigitOffset
+	*c = Cell(uint64(*c)&^(digitMask<<shift) | uint64(digit)<<shift)
+}
+
+// leadingNonZeroDigit returns the first digit of the cell which is not the center, centerDigit if none.
+func (c Cell) leadingNonZeroDigit() direction {
+	for r := 1; r <= c.Resolution(); r++ {
+		if d := c.digit(r); d != centerDigit {
+			return d
+		}
+	}
+	return centerDigit
+}
+
+// rotate60ccw rotates the digits of the cell 60 degrees counter clockwise.
+func (c Cell) rotate60ccw() Cell {
+	for r, res := 1, c.Resolution(); r <= res; r++ {
+		c.setDigit(r, c.digit(r).rotate60ccw())
+	}
+	return c
+}
+
+// rotate60cw rotates the digits of the cell 60 degrees clockwise.
+func (c Cell) rotate60cw() Cell {
+	for r, res := 1, c.Resolution(); r <= res; r++ {
+		c.setDigit(r, c.digit(r).rotate60cw())
+	}
+	return c
+}
+
+// rotatePent60ccw rotates the digits of a cell in a pentagon 60 degrees counter clockwise,
+// skipping the missing k-axes subsequence.
+func (c Cell) rotatePent60ccw() Cell {
+	foundFirstNonZeroDigit := false
+	for r, res := 1, c.Resolution(); r <= res; r++ {
+		c.setDigit(r, c.digit(r).rotate60ccw())
+		if !foundFirstNonZeroDigit && c.digit(r) != centerDigit {
+			foundFirstNonZeroDigit = true
+			if c.leadingNonZeroDigit() == kAxesDigit {
+				c = c.rotate60ccw()
+			}
+		}
+	}
+	return c
+}
+
+// String returns the index of the cell in hexadecimal.
+func (c Cell) String() string {
+	return strconv.FormatUint(uint64(c), 16)
+}
+
+// CellFromString returns the cell of the index in hexadecimal.
+func CellFromString(s string) (Cell, error) {
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil || !Cell(v).IsValid() {
+		return 0, ErrInvalidCell
+	}
+	return Cell(v), nil
+}
+
+// IsValid tests whether the index is a valid cell.
+func (c Cell) IsValid() bool {
+	if uint64(c)&highBitMask != 0 || c.mode() != cellMode || c.reserved() != 0 {
+		return false
+	}
+	baseCell := c.BaseCell()
+	if baseCell >= numBaseCells {
+		return false
+	}
+	res := c.Resolution()
+	foundFirstNonZeroDigit := false
+	for r := 1; r <= res; r++ {
+		digit := c.digit(r)
+		if !foundFirstNonZeroDigit && digit != centerDigit {
+			foundFirstNonZeroDigit = true
+			if baseCellData[baseCell].isPentagon && digit == kAxesDigit {
+				return false
+			}
+		}
+		if digit >= invalidDigit {
+			return false
+		}
+	}
+	for r := res + 1; r <= MaxResolution; r++ {
+		if c.digit(r) != invalidDigit {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPentagon tests whether the cell is one of the 12 pentagons of its resolution.
+func (c Cell) IsPentagon() bool {
+	return isBaseCellPentagon(c.BaseCell()) && c.leadingNonZeroDigit() == centerDigit
+}
+
+func isBaseCellPentagon(baseCell int) bool {
+	return baseCell >= 0 && baseCell < numBaseCells && baseCellData[baseCell].isPentagon
+}
+
+func isBaseCellPolarPentagon(baseCell int) bool {
+	return baseCell == 4 || baseCell == 117
+}
+
+func baseCellIsCwOffset(baseCell, testFace int) bool {
+	return baseCellData[baseCell].cwOffsetPent[0] == testFace || baseCellData[baseCell].cwOffsetPent[1] == testFace
+}
+
+// LatLngToCell returns the cell of the resolution containing the point.
+func LatLngToCell(point space.Point, res int) (Cell, error) {
+	if res < 0 || res > MaxResolution {
+		return 0, ErrInvalidResolution
+	}
+	if len(point) < 2 || math.IsInf(point[0], 0) || math.IsNaN(point[0]) ||
+		math.IsInf(point[1], 0) || math.IsNaN(point[1]) {
+		return 0, ErrInvalidPoint
+	}
+	g := latLng{lat: degreesToRadian * point[1], lng: degreesToRadian * point[0]}
+	c := faceIjkToCell(geoToFaceIjk(g, res), res)
+	if c == 0 {
+		return 0, ErrInvalidPoint
+	}
+	return c, nil
+}
+
+// faceIjkToCell returns the cell of the resolution at the face coordinates, 0 if they are beyond the face.
+func faceIjkToCell(fijk faceIJK, res int) Cell {
+	h := Cell(initIndex)
+	h.setMode(cellMode)
+	h.setResolution(res)
+	if res == 0 {
+		if fijk.coord.i > maxFaceCoord || fijk.coord.j > maxFaceCoord || fijk.coord.k > maxFaceCoord {
+			return 0
+		}
+		h.setBaseCell(faceIjkToBaseCell(fijk).baseCell)
+		return h
+	}
+
+	// build the cell from the finest resolution up, the coordinates ending as the base cell coordinates
+	ijk := &fijk.coord
+	for r := res - 1; r >= 0; r-- {
+		lastIJK := *ijk
+		var lastCenter coordIJK
+		if isResClassIII(r + 1) {
+			ijk.upAp7()
+			lastCenter = *ijk
+			lastCenter.downAp7()
+		} else {
+			ijk.upAp7r()
+			lastCenter = *ijk
+			lastCenter.downAp7r()
+		}
+		diff := lastIJK.sub(lastCenter)
+		diff.normalize()
+		h.setDigit(r+1, diff.toDigit())
+	}
+	if ijk.i > maxFaceCoord || ijk.j > maxFaceCoord || ijk.k > maxFaceCoord {
+		return 0
+	}
+
+	bc := faceIjkToBaseCell(fijk)
+	h.setBaseCell(bc.baseCell)
+	if isBaseCellPentagon(bc.baseCell) {
+		// force rotation out of the missing k-axes subsequence
+		if h.leadingNonZeroDigit() == kAxesDigit {
+			if baseCellIsCwOffset(bc.baseCell, fijk.face) {
+				h = h.rotate60cw()
+			} else {
+				h = h.rotate60ccw()
+			}
+		}
+		for i := 0; i < bc.ccwRot60; i++ {
+			h = h.rotatePent60ccw()
+		}
+	} else {
+		for i := 0; i < bc.ccwRot60; i++ {
+			h = h.rotate60ccw()
+		}
+	}
+	return h
+}
+
+func faceIjkToBaseCell(fijk faceIJK) baseCellRotation {
+	return faceIjkBaseCells[fijk.face][fijk.coord.i][fijk.coord.j][fijk.coord.k]
+}
+
+// toFaceIjk returns the face and coordinates of the cell.
+func (c Cell) toFaceIjk() faceIJK {
+	baseCell := c.BaseCell()
+	// a pentagon with leading digit 5 is rotated out of the deleted k subsequence
+	if isBaseCellPentagon(baseCell) && c.leadingNonZeroDigit() == ikAxesDigit {
+		c = c.rotate60cw()
+	}
+	fijk := baseCellData[baseCell].homeFijk
+	if !c.toFaceIjkFrom(&fijk) {
+		// no overage is possible, the cell lies on the home face
+		return fijk
+	}
+
+	// the cell may be beyond the home face
+	origIJK := fijk.coord
+	res := c.Resolution()
+	if isResClassIII(res) {
+		// Class II grid for the overage
+		fijk.coord.downAp7r()
+		res++
+	}
+	pentLeading4 := isBaseCellPentagon(baseCell) && c.leadingNonZeroDigit() == iAxesDigit
+	if fijk.adjustOverageClassII(res, pentLeading4, false) != noOverage {
+		// a pentagon may need more than one adjustment
+		if isBaseCellPentagon(baseCell) {
+			for fijk.adjustOverageClassII(res, false, false) != noOverage {
+			}
+		}
+		if res != c.Resolution() {
+			fijk.coord.upAp7r()
+		}
+	} else if res != c.Resolution() {
+		fijk.coord = origIJK
+	}
+	return fijk
+}
+
+// toFaceIjkFrom moves the coordinates of the base cell to the cell,
+// and returns whether the cell may be beyond the face.
+func (c Cell) toFaceIjkFrom(fijk *faceIJK) bool {
+	res := c.Resolution()
+	possibleOverage := true
+	if !isBaseCellPentagon(c.BaseCell()) && (res == 0 || fijk.coord == coordIJK{}) {
+		possibleOverage = false
+	}
+	for r := 1; r <= res; r++ {
+		if isResClassIII(r) {
+			fijk.coord.downAp7()
+		} else {
+			fijk.coord.downAp7r()
+		}
+		fijk.coord.neighbor(c.digit(r))
+	}
+	return possibleOverage
+}
+
+// Center returns the center of the cell, ErrInvalidCell if the cell is not valid.
+func (c Cell) Center() (space.Point, error) {
+	if !c.IsValid() {
+		return nil, ErrInvalidCell
+	}
+	return c.center().toPoint(), nil
+}
+
+func (c Cell) center() latLng {
+	return c.toFaceIjk().toGeo(c.Resolution())
+}
+
+// Boundary returns the polygon of the cell, with vertices where its edges cross faces of the icosahedron.
+// Vertices are counter clockwise, the ring is closed. It returns ErrInvalidCell if the cell is not valid.
+func (c Cell) Boundary() (space.Polygon, error) {
+	if !c.IsValid() {
+		return nil, ErrInvalidCell
+	}
+	verts := c.boundary()
+	ring := make(space.Ring, 0, len(verts)+1)
+	for _, v := range verts {
+		ring = append(ring, v.toPoint())
+	}
+	ring = append(ring, ring[0])
+	return space.Polygon{ring}, nil
+}
+
+func (c Cell) boundary() []latLng {
+	fijk := c.toFaceIjk()
+	if c.IsPentagon() {
+		return fijk.toPentBoundary(c.Resolution())
+	}
+	return fijk.toBoundary(c.Resolution())
+}
+
+// Grid returns the polygon of the cell as a grid, ErrInvalidCell if the cell is not valid.
+func (c Cell) Grid() (grid.Grid, error) {
+	boundary, err := c.Boundary()
+	if err != nil {
+		return grid.Grid{}, err
+	}
+	return grid.Grid{Geometry: boundary}, nil
+}
+
+// Grids returns the polygons of the cells as grids, ErrInvalidCell if a cell is not valid.
+func Grids(cells []Cell) ([]grid.Grid, error) {
+	grids := make([]grid.Grid, len(cells))
+	for i, c := range cells {
+		g, err := c.Grid()
+		if err != nil {
+			return nil, err
+		}
+		grids[i] = g
+	}
+	return grids, nil
+}
+
+// Parent returns the cell of the coarser resolution containing the cell.
+func (c Cell) Parent(res int) (Cell, error) {
+	childRes := c.Resolution()
+	if res < 0 || res > MaxResolution {
+		return 0, ErrInvalidResolution
+	} else if res > childRes {
+		return 0, ErrResolutionMismatch
+	}
+	c.setResolution(res)
+	for r := res + 1; r <= childRes; r++ {
+		c.setDigit(r, invalidDigit)
+	}
+	return c, nil
+}
+
+// Children returns the cells of the finer resolution in the cell, in increasing order.
+func (c Cell) Children(res int) ([]Cell, error) {
+	if res < c.Resolution() || res > MaxResolution {
+		return nil, ErrResolutionMismatch
+	}
+	children := make([]Cell, 0, childrenSize(c, res))
+	return c.appendChildren(children, res), nil
+}
+
+func (c Cell) appendChildren(children []Cell, res int) []Cell {
+	if c.Resolution() == res {
+		return append(children, c)
+	}
+	pentagon := c.IsPentagon()
+	childRes := c.Resolution() + 1
+	for d := centerDigit; d < invalidDigit; d++ {
+		// a pentagon has no child in the k-axes direction
+		if pentagon && d == kAxesDigit {
+			continue
+		}
+		child := c
+		child.setResolution(childRes)
+		child.setDigit(childRes, d)
+		children = child.appendChildren(children, res)
+	}
+	return children
+}
+
+// childrenSize returns the number of children of the cell at the resolution.
+func childrenSize(c Cell, res int) int {
+	n := 1
+	for i := c.Resolution(); i < res; i++ {
+		n *= 7
+	}
+	if c.IsPentagon() {
+		return 1 + 5*(n-1)/6
+	}
+	return n
+}
+
+// CenterChild returns the cell of the finer resolution at the center of the cell.
+func (c Cell) CenterChild(res int) (Cell, error) {
+	if res < c.Resolution() || res > MaxResolution {
+		return 0, ErrResolutionMismatch
+	}
+	for r := c.Resolution() + 1; r <= res; r++ {
+		c.setDigit(r, centerDigit)
+	}
+	c.setResolution(res)
+	return c, nil
+}
+
+// Pentagons returns the 12 pentagons of the resolution.
+func Pentagons(res int) ([]Cell, error) {
+	if res < 0 || res > MaxResolution {
+		return nil, ErrInvalidResolution
+	}
+	cells := make([]Cell, 0, 12)
+	for bc := 0; bc < numBaseCells; bc++ {
+		if isBaseCellPentagon(bc) {
+			cells = append(cells, newCell(res, bc, centerDigit))
+		}
+	}
+	return cells, nil
+}
+
+// toPoint returns the longitude and latitude in degrees.
+func (g latLng) toPoint() space.Point {
+	return space.Point{radiansToDegree * g.lng, radiansToDegree * g.lat}
+}
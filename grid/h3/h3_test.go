@@ -0,0 +1,292 @@
+package h3
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestLatLngToCell(t *testing.T) {
+	tests := []struct {
+		name       string
+		point      space.Point
+		res        int
+		want       string
+		wantCenter space.Point
+		wantParent string
+		wantErr    error
+	}{
+		{"beijing", space.Point{116.3912, 39.9073}, 9, "8931aa428cfffff",
+			space.Point{116.39127372853585, 39.90809950308138}, "8431aa5ffffffff", nil},
+		{"san francisco", space.Point{-122.4194, 37.7749}, 7, "872830828ffffff",
+			space.Point{-122.41827103692466, 37.77351509723813}, "832830fffffffff", nil},
+		{"pentagon", space.Point{0, 0}, 0, "8075fffffffffff",
+			space.Point{-5.245390296777327, 2.300882111626747}, "8075fffffffffff", nil},
+		{"finest", space.Point{151.2093, -33.8688}, 15, "8fbe0e35cbad0a8",
+			space.Point{151.20929403855575, -33.86879984694093}, "87be0e35cffffff", nil},
+		{"antimeridian", space.Point{-179.9999, 65.5}, 5, "850d916ffffffff",
+			space.Point{179.96318813274763, 65.46573390065907}, "820d97fffffffff", nil},
+		{"north pole", space.Point{10, 90}, 3, "830326fffffffff",
+			space.Point{112.8043214892232, 89.61904301305196}, "81033ffffffffff", nil},
+		{"south pole", space.Point{0, -90}, 2, "82f297fffffffff",
+			space.Point{144.46768215019625, -89.24152402631138}, "81f2bffffffffff", nil},
+		{"resolution", space.Point{0, 0}, 16, "", nil, "", ErrInvalidResolution},
+		{"nan", space.Point{math.NaN(), 0}, 5, "", nil, "", ErrInvalidPoint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LatLngToCell(tt.point, tt.res)
+			if err != tt.wantErr {
+				t.Fatalf("LatLngToCell() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want || !got.IsValid() || got.Resolution() != tt.res {
+				t.Errorf("LatLngToCell() = %v, want %v", got, tt.want)
+			}
+			if center, _ := got.Center(); math.Abs(center[0]-tt.wantCenter[0]) > 1e-9 || math.Abs(center[1]-tt.wantCenter[1]) > 1e-9 {
+				t.Errorf("Center() = %v, want %v", center, tt.wantCenter)
+			}
+			if parent, _ := got.Parent(tt.res / 2); parent.String() != tt.wantParent {
+				t.Errorf("Parent() = %v, want %v", parent, tt.wantParent)
+			}
+		})
+	}
+}
+
+func TestCellFromString(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Cell
+		wantErr error
+	}{
+		{"cell", "8931aa428cfffff", 0x8931aa428cfffff, nil},
+		{"upper case", "8931AA428CFFFFF", 0x8931aa428cfffff, nil},
+		{"digits after resolution", "8931aa428cffff0", 0, ErrInvalidCell},
+		{"not hex", "cell", 0, ErrInvalidCell},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CellFromString(tt.s)
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("CellFromString() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCell_Boundary(t *testing.T) {
+	tests := []struct {
+		name         string
+		cell         Cell
+		want         int
+		antimeridian bool
+	}{
+		{"hexagon", 0x8931aa428cfffff, 7, false},
+		{"pentagon", 0x8009fffffffffff, 6, false},
+		{"class III pentagon", 0x81083ffffffffff, 11, false},
+		{"antimeridian", 0x850d916ffffffff, 7, true},
+	}
+	strategy := planar.NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cell.Boundary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || len(got[0]) != tt.want || !space.Point(got[0][0]).EqualsPoint(got[0][len(got[0])-1]) {
+				t.Fatalf("Boundary() = %v, want a closed ring of %v points", got, tt.want)
+			}
+			center, _ := tt.cell.Center()
+			if contains, _ := strategy.Contains(got, center); !contains && !tt.antimeridian {
+				t.Errorf("Boundary() = %v, does not contain the center %v", got, center)
+			}
+			if grid, _ := tt.cell.Grid(); !grid.Geometry.Equals(got) {
+				t.Errorf("Grid() = %v, want %v", grid.Geometry, got)
+			}
+		})
+	}
+}
+
+func TestCell_Children(t *testing.T) {
+	cell := Cell(0x8931aa428cfffff)
+	children, err := cell.Children(11)
+	if err != nil || len(children) != 49 || children[0] != 0x8b31aa428cc0fff || children[48] != 0x8b31aa428cf6fff {
+		t.Errorf("Children() = %v, %v, want 49 children", len(children), err)
+	}
+	if center, _ := cell.CenterChild(11); center != children[0] {
+		t.Errorf("CenterChild() = %v, want %v", center, children[0])
+	}
+	for _, c := range children {
+		if parent, _ := c.Parent(9); parent != cell {
+			t.Errorf("Parent() = %v, want %v", parent, cell)
+		}
+	}
+	if _, err := cell.Children(8); err != ErrResolutionMismatch {
+		t.Errorf("Children() error = %v, want %v", err, ErrResolutionMismatch)
+	}
+	if _, err := cell.Parent(10); err != ErrResolutionMismatch {
+		t.Errorf("Parent() error = %v, want %v", err, ErrResolutionMismatch)
+	}
+}
+
+func TestPentagons(t *testing.T) {
+	tests := []struct {
+		name      string
+		res       int
+		wantFirst Cell
+		wantLast  Cell
+	}{
+		{"res 0", 0, 0x8009fffffffffff, 0x80ebfffffffffff},
+		{"res 1", 1, 0x81083ffffffffff, 0x81ea3ffffffffff},
+		{"res 8", 8, 0x8808000001fffff, 0x88ea000001fffff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Pentagons(tt.res)
+			if err != nil || len(got) != 12 || got[0] != tt.wantFirst || got[11] != tt.wantLast {
+				t.Fatalf("Pentagons() = %v, %v, want 12 from %v to %v", got, err, tt.wantFirst, tt.wantLast)
+			}
+			for _, p := range got {
+				if !p.IsPentagon() {
+					t.Errorf("IsPentagon() = false, want true for %v", p)
+				}
+			}
+			children, _ := got[0].Children(tt.res + 2)
+			disk1, _ := GridDisk(got[0], 1)
+			disk2, _ := GridDisk(got[0], 2)
+			if len(children) != 41 || len(disk1) != 6 || len(disk2) != 16 {
+				t.Errorf("Children(), GridDisk() = %v, %v, %v, want 41, 6, 16", len(children), len(disk1), len(disk2))
+			}
+		})
+	}
+}
+
+func TestGridDisk(t *testing.T) {
+	origin := Cell(0x8931aa428cfffff)
+	tests := []struct {
+		name    string
+		k       int
+		want    int
+		wantErr error
+	}{
+		{"k 0", 0, 1, nil},
+		{"k 1", 1, 7, nil},
+		{"k 2", 2, 19, nil},
+		{"k 3", 3, 37, nil},
+		{"negative", -1, 0, ErrInvalidDistance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GridDisk(origin, tt.k)
+			if err != tt.wantErr || len(got) != tt.want {
+				t.Fatalf("GridDisk() = %v, %v, want %v cells, %v", len(got), err, tt.want, tt.wantErr)
+			}
+			if err == nil && got[0] != origin {
+				t.Errorf("GridDisk() = %v, want the origin first", got)
+			}
+		})
+	}
+
+	got, _ := GridDisk(origin, 1)
+	want := []Cell{0x8931aa428cfffff, 0x8931aa428cbffff, 0x8931aa42853ffff, 0x8931aa42857ffff,
+		0x8931aa4281bffff, 0x8931aa428c7ffff, 0x8931aa428c3ffff}
+	if !sameCells(got, want) {
+		t.Errorf("GridDisk() = %v, want %v", got, want)
+	}
+}
+
+func TestPolyfill(t *testing.T) {
+	polygon := space.Polygon{
+		{{116.3, 39.9}, {116.5, 39.9}, {116.5, 40.0}, {116.3, 40.0}, {116.3, 39.9}},
+		{{116.36, 39.94}, {116.36, 39.96}, {116.44, 39.96}, {116.44, 39.94}, {116.36, 39.94}},
+	}
+	antimeridian := space.Polygon{{{179, -1}, {-179, -1}, {-179, 1}, {179, 1}, {179, -1}}}
+	tests := []struct {
+		name          string
+		geom          space.Geometry
+		res           int
+		want          int
+		wantCompacted int
+		wantErr       error
+	}{
+		{"res 6", polygon, 6, 5, 5, nil},
+		{"res 7", polygon, 7, 46, 34, nil},
+		{"res 8", polygon, 8, 331, 133, nil},
+		{"multi polygon", space.MultiPolygon{polygon}, 7, 46, 34, nil},
+		{"antimeridian", antimeridian, 4, 39, 27, nil},
+		{"point", space.Point{116.3, 39.9}, 7, 0, 0, spaceerr.ErrNotPolygon},
+		{"resolution", polygon, 16, 0, 0, ErrInvalidResolution},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Polyfill(tt.geom, tt.res)
+			if err != tt.wantErr || len(got) != tt.want {
+				t.Fatalf("Polyfill() = %v, %v, want %v cells, %v", len(got), err, tt.want, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			compacted, err := Compact(got)
+			if err != nil || len(compacted) != tt.wantCompacted {
+				t.Fatalf("Compact() = %v, %v, want %v cells", len(compacted), err, tt.wantCompacted)
+			}
+			uncompacted, err := Uncompact(compacted, tt.res)
+			if err != nil || !sameCells(uncompacted, got) {
+				t.Errorf("Uncompact() = %v, %v, want %v", len(uncompacted), err, len(got))
+			}
+		})
+	}
+}
+
+func TestGrids(t *testing.T) {
+	cells, _ := GridDisk(0x8931aa428cfffff, 1)
+	got, err := Grids(cells)
+	if err != nil || len(got) != len(cells) {
+		t.Fatalf("Grids() = %v, %v, want %v grids", len(got), err, len(cells))
+	}
+	for i, g := range got {
+		if want, _ := cells[i].Boundary(); !reflect.DeepEqual(g.Geometry, want) {
+			t.Errorf("Grids() = %v, want %v", g.Geometry, want)
+		}
+	}
+	if _, err := Grids(append(cells, 0)); err != ErrInvalidCell {
+		t.Errorf("Grids() error = %v, want %v", err, ErrInvalidCell)
+	}
+}
+
+func TestCell_Invalid(t *testing.T) {
+	for _, cell := range []Cell{0, 0xffffffffffffffff} {
+		if _, err := cell.Center(); err != ErrInvalidCell {
+			t.Errorf("Center() error = %v, want %v", err, ErrInvalidCell)
+		}
+		if _, err := cell.Boundary(); err != ErrInvalidCell {
+			t.Errorf("Boundary() error = %v, want %v", err, ErrInvalidCell)
+		}
+		if _, err := cell.Grid(); err != ErrInvalidCell {
+			t.Errorf("Grid() error = %v, want %v", err, ErrInvalidCell)
+		}
+	}
+}
+
+func sameCells(cells, want []Cell) bool {
+	if len(cells) != len(want) {
+		return false
+	}
+	set := map[Cell]bool{}
+	for _, c := range cells {
+		set[c] = true
+	}
+	for _, c := range want {
+		if !set[c] {
+			return false
+		}
+	}
+	return true
+}
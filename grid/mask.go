@@ -0,0 +1,155 @@
+package grid
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/space"
+)
+
+// mask the polygons cells are masked or clipped to, with their bounds.
+type mask struct {
+	polygons []space.Polygon
+	bounds   []space.Bound
+}
+
+// newMask returns the mask of the polygon or multipolygon.
+func newMask(geom space.Geometry) (*mask, error) {
+	m := &mask{}
+	switch g := geom.(type) {
+	case space.Polygon:
+		m.add(g)
+	case space.MultiPolygon:
+		for _, v := range g {
+			m.add(v)
+		}
+	default:
+		return nil, ErrInvalidMask
+	}
+	return m, nil
+}
+
+// add adds the polygon to the mask if not empty.
+func (m *mask) add(polygon space.Polygon) {
+	if polygon.IsEmpty() {
+		return
+	}
+	m.polygons = append(m.polygons, polygon)
+	m.bounds = append(m.bounds, polygon.Bound())
+}
+
+// apply returns the cell if it intersects the mask, clipped to the mask if clip, nil if it is outside the mask.
+func (m *mask) apply(cell space.Geometry, clip bool) (space.Geometry, error) {
+	if point, ok := cell.(space.Point); ok {
+		for i, polygon := range m.polygons {
+			if m.bounds[i].Contains(point) &&
+				relate.LocateInPolygon(matrix.Matrix(point), matrix.PolygonMatrix(polygon)) != calc.ImExterior {
+				return point, nil
+			}
+		}
+		return nil, nil
+	}
+	polygon := cell.(space.Polygon)
+	bound := polygon.Bound()
+	pieces := []space.Polygon{}
+	for i, part := range m.polygons {
+		if !m.bounds[i].IntersectsBound(bound) {
+			continue
+		}
+		intersects, covered, err := relatePolygon(polygon, part)
+		if err != nil {
+			return nil, err
+		}
+		if !intersects {
+			continue
+		}
+		if !clip || covered {
+			return polygon, nil
+		}
+		result, err := snapround.Intersection(polygon.ToMatrix(), part.ToMatrix(), 0)
+		if err != nil {
+			return nil, err
+		}
+		switch g := space.TransGeometry(result).(type) {
+		case space.Polygon:
+			pieces = append(pieces, g)
+		case space.Collection:
+			for _, v := range g {
+				if p, ok := v.(space.Polygon); ok {
+					pieces = append(pieces, p)
+				}
+			}
+		}
+	}
+	switch len(pieces) {
+	case 0:
+		return nil, nil
+	case 1:
+		return pieces[0], nil
+	}
+	return space.MultiPolygon(pieces), nil
+}
+
+// relatePolygon tests whether the interiors of the convex cell and of the polygon intersect,
+// and whether the polygon covers the cell: the vertices of the cell are in the polygon,
+// and no edge of the polygon enters the cell.
+// Cells whose vertices are all on the boundary of the polygon, or outside, are related by the area of their intersection.
+func relatePolygon(cell, polygon space.Polygon) (intersects, covered bool, err error) {
+	ring := matrix.LineMatrix(cell[0])
+	covered = true
+	for _, p := range ring {
+		switch relate.LocateInPolygon(p, matrix.PolygonMatrix(polygon)) {
+		case calc.ImInterior:
+			intersects = true
+		case calc.ImExterior:
+			covered = false
+		}
+	}
+	for _, v := range polygon {
+		for i, p := range v {
+			if relate.LocateInRing(p, ring) == calc.ImInterior || i > 0 && crossesRing(v[i-1], p, ring) {
+				return true, false, nil
+			}
+		}
+	}
+	if intersects {
+		return true, covered, nil
+	}
+	result, err := snapround.Intersection(cell.ToMatrix(), polygon.ToMatrix(), 0)
+	if err != nil {
+		return false, false, err
+	}
+	area, _ := space.TransGeometry(result).Area()
+	cellArea, _ := cell.Area()
+	return area > 0, area >= cellArea*(1-1e-12), nil
+}
+
+// crossesRing tests whether the segment properly crosses an edge of the ring.
+func crossesRing(a, b []float64, ring matrix.LineMatrix) bool {
+	for i := 1; i < len(ring); i++ {
+		c, d := ring[i-1], ring[i]
+		if math.Max(a[0], b[0]) < math.Min(c[0], d[0]) || math.Min(a[0], b[0]) > math.Max(c[0], d[0]) ||
+			math.Max(a[1], b[1]) < math.Min(c[1], d[1]) || math.Min(a[1], b[1]) > math.Max(c[1], d[1]) {
+			continue
+		}
+		if orientation(a, b, c)*orientation(a, b, d) < 0 && orientation(c, d, a)*orientation(c, d, b) < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// orientation returns the sign of the turn from a, b to c.
+func orientation(a, b, c []float64) int {
+	v := (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
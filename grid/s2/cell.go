@@ -0,0 +1,204 @@
+package s2
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/grid"
+	"github.com/spatial-go/geoos/space"
+)
+
+// edgeSamples the points per edge approximating the geodesic edges of a cell in longitude and latitude.
+const edgeSamples = 8
+
+// Polygon returns the polygon of the 4 vertices of the cell, counter clockwise, the ring is closed.
+// Edges of cells are geodesics, the polygon approximates them by segments in longitude and latitude.
+func (c CellID) Polygon() space.Polygon {
+	face, u0, u1, v0, v1 := c.uvBound()
+	ring := space.Ring{
+		xyzToPoint(faceUVToXYZ(face, u0, v0)),
+		xyzToPoint(faceUVToXYZ(face, u1, v0)),
+		xyzToPoint(faceUVToXYZ(face, u1, v1)),
+		xyzToPoint(faceUVToXYZ(face, u0, v1)),
+	}
+	ring = append(ring, ring[0])
+	return space.Polygon{ring}
+}
+
+// Grid returns the polygon of the cell as a grid.
+func (c CellID) Grid() grid.Grid {
+	return grid.Grid{Geometry: c.Polygon()}
+}
+
+// Grids returns the polygons of the cells as grids.
+func Grids(ids []CellID) []grid.Grid {
+	grids := make([]grid.Grid, len(ids))
+	for i, c := range ids {
+		grids[i] = c.Grid()
+	}
+	return grids
+}
+
+// uvBound returns the face of the cell and its bounds in (u, v) coordinates on the face.
+func (c CellID) uvBound() (face int, u0, u1, v0, v1 float64) {
+	face, i, j, _ := c.faceIJOrientation()
+	size := 1 << (MaxLevel - c.Level())
+	i, j = i&-size, j&-size
+	u0, u1 = stToUV(float64(i)/maxSize), stToUV(float64(i+size)/maxSize)
+	v0, v1 = stToUV(float64(j)/maxSize), stToUV(float64(j+size)/maxSize)
+	return
+}
+
+// cellRegion the polygons of a cell in longitude and latitude for planar tests, with their bounds.
+type cellRegion struct {
+	polygons []space.Polygon
+	bounds   []space.Bound
+	bound    space.Bound
+}
+
+// region returns the cell in longitude and latitude, with densified edges.
+// A cell crossing the antimeridian is the polygons of its parts on both sides,
+// a cell containing a pole is the band of latitudes from its lowest point to the pole.
+func (c CellID) region() *cellRegion {
+	face, u0, u1, v0, v1 := c.uvBound()
+	corners := [5][2]float64{{u0, v0}, {u1, v0}, {u1, v1}, {u0, v1}, {u0, v0}}
+	ring := make(space.Ring, 0, 4*edgeSamples+1)
+	for k := 0; k < 4; k++ {
+		for n := 0; n < edgeSamples; n++ {
+			t := float64(n) / edgeSamples
+			u := corners[k][0] + t*(corners[k+1][0]-corners[k][0])
+			v := corners[k][1] + t*(corners[k+1][1]-corners[k][1])
+			ring = append(ring, xyzToPoint(faceUVToXYZ(face, u, v)))
+		}
+	}
+	if (face == 2 || face == 5) && u0 <= 0 && u1 >= 0 && v0 <= 0 && v1 >= 0 {
+		lat := 90.0
+		for _, p := range ring {
+			lat = math.Min(lat, math.Abs(p[1]))
+		}
+		bound := space.Bound{Min: space.Point{-180, lat}, Max: space.Point{180, 90}}
+		if face == 5 {
+			bound = space.Bound{Min: space.Point{-180, -90}, Max: space.Point{180, -lat}}
+		}
+		return &cellRegion{polygons: []space.Polygon{bound.ToPolygon()}, bounds: []space.Bound{bound}, bound: bound}
+	}
+	bound := space.Bound{Min: space.Point{ring[0][0], ring[0][1]}, Max: space.Point{ring[0][0], ring[0][1]}}
+	for i := 1; i < len(ring); i++ {
+		// longitudes continuous across the antimeridian
+		if d := ring[i][0] - ring[i-1][0]; d > 180 {
+			ring[i][0] -= 360
+		} else if d < -180 {
+			ring[i][0] += 360
+		}
+		bound.Min[0], bound.Max[0] = math.Min(bound.Min[0], ring[i][0]), math.Max(bound.Max[0], ring[i][0])
+		bound.Min[1], bound.Max[1] = math.Min(bound.Min[1], ring[i][1]), math.Max(bound.Max[1], ring[i][1])
+	}
+	ring = append(ring, ring[0])
+	r := &cellRegion{polygons: []space.Polygon{{ring}}, bounds: []space.Bound{bound}, bound: bound}
+	shift := 0.0
+	switch {
+	case bound.Min[0] < -180:
+		shift = 360
+	case bound.Max[0] > 180:
+		shift = -360
+	default:
+		return r
+	}
+	shifted := space.Bound{Min: space.Point{bound.Min[0] + shift, bound.Min[1]}, Max: space.Point{bound.Max[0] + shift, bound.Max[1]}}
+	r.polygons = append(r.polygons, space.Polygon{shiftRing(ring, shift)})
+	r.bounds = append(r.bounds, shifted)
+	r.bound = space.Bound{Min: space.Point{math.Max(-180, math.Min(bound.Min[0], shifted.Min[0])), bound.Min[1]},
+		Max: space.Point{math.Min(180, math.Max(bound.Max[0], shifted.Max[0])), bound.Max[1]}}
+	return r
+}
+
+// shiftRing returns the ring moved by the longitude.
+func shiftRing(ring space.Ring, lng float64) space.Ring {
+	shifted := make(space.Ring, len(ring))
+	for i, p := range ring {
+		shifted[i] = []float64{p[0] + lng, p[1]}
+	}
+	return shifted
+}
+
+// pointToXYZ returns the point on the unit sphere of the longitude and latitude.
+func pointToXYZ(point space.Point) [3]float64 {
+	lng, lat := point[0]*math.Pi/180, point[1]*math.Pi/180
+	return [3]float64{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+}
+
+// xyzToPoint returns the longitude and latitude of the point, which need not be of unit length.
+func xyzToPoint(p [3]float64) space.Point {
+	lat := math.Atan2(p[2], math.Sqrt(p[0]*p[0]+p[1]*p[1]))
+	lng := math.Atan2(p[1], p[0])
+	return space.Point{lng * 180 / math.Pi, lat * 180 / math.Pi}
+}
+
+// xyzToFaceUV returns the face the point projects on and its (u, v) coordinates on the face.
+func xyzToFaceUV(p [3]float64) (face int, u, v float64) {
+	face = 0
+	if math.Abs(p[1]) > math.Abs(p[face]) {
+		face = 1
+	}
+	if math.Abs(p[2]) > math.Abs(p[face]) {
+		face = 2
+	}
+	if p[face] < 0 {
+		face += 3
+	}
+	x, y, z := p[0], p[1], p[2]
+	switch face {
+	case 0:
+		u, v = y/x, z/x
+	case 1:
+		u, v = -x/y, z/y
+	case 2:
+		u, v = -x/z, -y/z
+	case 3:
+		u, v = z/x, y/x
+	case 4:
+		u, v = z/y, -x/y
+	default:
+		u, v = -y/z, -x/z
+	}
+	return
+}
+
+// faceUVToXYZ returns the point of the (u, v) coordinates on the face, not of unit length.
+func faceUVToXYZ(face int, u, v float64) [3]float64 {
+	switch face {
+	case 0:
+		return [3]float64{1, u, v}
+	case 1:
+		return [3]float64{-u, 1, v}
+	case 2:
+		return [3]float64{-u, -v, 1}
+	case 3:
+		return [3]float64{-1, -v, -u}
+	case 4:
+		return [3]float64{v, -1, -u}
+	default:
+		return [3]float64{v, u, -1}
+	}
+}
+
+// uvToST returns the (s, t) coordinate in [0, 1] of the (u, v) coordinate in [-1, 1],
+// the quadratic projection making cells of a level of similar areas.
+func uvToST(u float64) float64 {
+	if u >= 0 {
+		return 0.5 * math.Sqrt(1+3*u)
+	}
+	return 1 - 0.5*math.Sqrt(1-3*u)
+}
+
+// stToUV returns the (u, v) coordinate of the (s, t) coordinate.
+func stToUV(s float64) float64 {
+	if s >= 0.5 {
+		return (1 / 3.) * (4*s*s - 1)
+	}
+	return (1 / 3.) * (1 - 4*(1-s)*(1-s))
+}
+
+// stToIJ returns the (i, j) coordinate of the leaf cell of the (s, t) coordinate.
+func stToIJ(s float64) int {
+	return int(math.Max(0, math.Min(maxSize-1, math.Floor(maxSize*s))))
+}
@@ -0,0 +1,556 @@
+package s2
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// DefaultMaxCells the default number of cells of a covering.
+const DefaultMaxCells = 8
+
+// ErrInvalidCoverer ...
+var ErrInvalidCoverer = fmt.Errorf("s2 region coverer needs a level mod of 1 to 3 and at least 1 cell")
+
+// RegionCoverer covers geometries with cells of MinLevel to MaxLevel, every LevelMod levels from MinLevel,
+// with at most MaxCells cells when the levels allow it.
+type RegionCoverer struct {
+	MinLevel int
+	MaxLevel int
+	LevelMod int
+	MaxCells int
+}
+
+// NewRegionCoverer returns a coverer with cells of any level, at most DefaultMaxCells of them.
+func NewRegionCoverer() *RegionCoverer {
+	return &RegionCoverer{MinLevel: 0, MaxLevel: MaxLevel, LevelMod: 1, MaxCells: DefaultMaxCells}
+}
+
+// Covering returns the cells covering the geometry, cells only touching the geometry included.
+// The geometry is in longitude and latitude, the cells are tested against it in the plane of
+// longitude and latitude, their geodesic edges approximated, across the antimeridian and up to the poles.
+// An edge of the geometry whose longitude jumps by more than 180 degrees crosses the antimeridian.
+func (rc *RegionCoverer) Covering(geom space.Geometry) (CellUnion, error) {
+	return rc.covering(geom, false)
+}
+
+// InteriorCovering returns the cells covered by the geometry.
+func (rc *RegionCoverer) InteriorCovering(geom space.Geometry) (CellUnion, error) {
+	return rc.covering(geom, true)
+}
+
+func (rc *RegionCoverer) covering(geom space.Geometry, interior bool) (CellUnion, error) {
+	if rc.MinLevel < 0 || rc.MaxLevel > MaxLevel || rc.MinLevel > rc.MaxLevel {
+		return nil, ErrInvalidLevel
+	}
+	if rc.LevelMod < 1 || rc.LevelMod > 3 || rc.MaxCells < 1 {
+		return nil, ErrInvalidCoverer
+	}
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	c := &coverer{
+		geom: geom, parts: unwrap(parts(geom)), strategy: planar.NormalStrategy(), interior: interior,
+		minLevel: rc.MinLevel, levelMod: rc.LevelMod, maxCells: rc.MaxCells,
+		// the finest level reachable from the min level by steps of level mod
+		maxLevel: rc.MaxLevel - (rc.MaxLevel-rc.MinLevel)%rc.LevelMod,
+	}
+	if geom.IsEmpty() {
+		return CellUnion{}, nil
+	}
+	for i, part := range c.parts {
+		bound := part.Bound()
+		c.partBounds = append(c.partBounds, bound)
+		if i == 0 {
+			c.bound = bound
+		} else {
+			c.bound = c.bound.Extend(bound.Min).Extend(bound.Max)
+		}
+	}
+	if err := c.cover(); err != nil {
+		return nil, err
+	}
+	cells := CellUnion(c.result).Normalize()
+	if c.minLevel > 0 || c.levelMod > 1 {
+		cells = cells.Denormalize(c.minLevel, c.levelMod)
+	}
+	return cells, nil
+}
+
+// coverer covers a geometry with cells, expanding the candidate cells intersecting it with the fewest children first.
+type coverer struct {
+	geom                                   space.Geometry
+	parts                                  []space.Geometry
+	partBounds                             []space.Bound
+	bound                                  space.Bound
+	strategy                               planar.Algorithm
+	interior                               bool
+	minLevel, maxLevel, levelMod, maxCells int
+	queue                                  candidateQueue
+	result                                 []CellID
+	err                                    error
+}
+
+// candidate a cell intersecting the geometry, with its children intersecting it.
+type candidate struct {
+	id       CellID
+	terminal bool
+	children []*candidate
+	priority int
+}
+
+func (c *coverer) cover() error {
+	for face := 0; face < numFaces; face++ {
+		c.addCandidate(c.newCandidate(CellIDFromFace(face)))
+	}
+	for c.queue.Len() > 0 && c.err == nil && (!c.interior || len(c.result) < c.maxCells) {
+		cand := heap.Pop(&c.queue).(*candidate)
+		if cand.id.Level() < c.minLevel || len(cand.children) == 1 ||
+			len(c.result)+c.queue.Len()+len(cand.children) <= c.maxCells {
+			for _, child := range cand.children {
+				if !c.interior || len(c.result) < c.maxCells {
+					c.addCandidate(child)
+				}
+			}
+		} else if !c.interior {
+			cand.terminal = true
+			c.addCandidate(cand)
+		}
+	}
+	return c.err
+}
+
+// newCandidate returns the candidate of the cell, nil if the cell does not intersect the geometry.
+func (c *coverer) newCandidate(id CellID) *candidate {
+	region := id.region()
+	if !c.mayIntersect(region) {
+		return nil
+	}
+	cand := &candidate{id: id}
+	level := id.Level()
+	if level < c.minLevel {
+		return cand
+	}
+	contains := c.contains(region)
+	if c.interior {
+		if contains {
+			cand.terminal = true
+		} else if level+c.levelMod > c.maxLevel {
+			return nil
+		}
+	} else if contains || level+c.levelMod > c.maxLevel {
+		cand.terminal = true
+	}
+	return cand
+}
+
+// addCandidate adds a terminal candidate to the result, or queues it with its children.
+func (c *coverer) addCandidate(cand *candidate) {
+	if cand == nil {
+		return
+	}
+	if cand.terminal {
+		c.result = append(c.result, cand.id)
+		return
+	}
+	numLevels := c.levelMod
+	if cand.id.Level() < c.minLevel {
+		numLevels = 1
+	}
+	numTerminals := c.expandChildren(cand, cand.id, numLevels)
+	if len(cand.children) == 0 {
+		return
+	}
+	if !c.interior && numTerminals == 1<<(2*numLevels) && cand.id.Level() >= c.minLevel {
+		// the cell is covered by its children, it is used instead of them
+		cand.terminal = true
+		c.addCandidate(cand)
+		return
+	}
+	// larger cells first, then the cells with fewer children and fewer terminal children
+	shift := 2 * c.levelMod
+	cand.priority = -((cand.id.Level()<<shift+len(cand.children))<<shift + numTerminals)
+	heap.Push(&c.queue, cand)
+}
+
+// expandChildren adds the descendants of the cell numLevels below it to the children of the candidate,
+// returns the number of terminal ones.
+func (c *coverer) expandChildren(cand *candidate, id CellID, numLevels int) int {
+	numLevels--
+	numTerminals := 0
+	for _, childID := range id.Children() {
+		if numLevels > 0 {
+			if c.mayIntersect(childID.region()) {
+				numTerminals += c.expandChildren(cand, childID, numLevels)
+			}
+			continue
+		}
+		if child := c.newCandidate(childID); child != nil {
+			cand.children = append(cand.children, child)
+			if child.terminal {
+				numTerminals++
+			}
+		}
+	}
+	return numTerminals
+}
+
+// mayIntersect tests whether the cell intersects the geometry.
+func (c *coverer) mayIntersect(region *cellRegion) bool {
+	if c.err != nil || !region.bound.IntersectsBound(c.bound) {
+		return false
+	}
+	for i, cell := range region.polygons {
+		for j, part := range c.parts {
+			if !region.bounds[i].IntersectsBound(c.partBounds[j]) {
+				continue
+			}
+			intersects, err := c.intersects(cell, part)
+			if err != nil {
+				c.err = err
+				return false
+			}
+			if intersects {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contains tests whether the cell is covered by the geometry, each part of the cell by a part of the geometry.
+func (c *coverer) contains(region *cellRegion) bool {
+	if c.err != nil || !c.bound.ContainsBound(region.bound) {
+		return false
+	}
+	for i, cell := range region.polygons {
+		covered := false
+		for j, part := range c.parts {
+			if !c.partBounds[j].ContainsBound(region.bounds[i]) {
+				continue
+			}
+			covers, err := c.covers(part, cell)
+			if err != nil {
+				c.err = err
+				return false
+			}
+			if covers {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// intersects tests whether the part of the geometry intersects the ring of the cell,
+// directly for points, lines and polygons.
+func (c *coverer) intersects(cell space.Polygon, part space.Geometry) (bool, error) {
+	ring := matrix.LineMatrix(cell[0])
+	var rings []matrix.LineMatrix
+	switch g := part.(type) {
+	case space.Point:
+		return relate.LocateInRing(matrix.Matrix(g), ring) != calc.ImExterior, nil
+	case space.LineString:
+		rings = []matrix.LineMatrix{matrix.LineMatrix(g)}
+	case space.Polygon:
+		if len(g) == 0 {
+			return false, nil
+		}
+		if relate.LocateInPolygon(matrix.Matrix(ring[0]), matrix.PolygonMatrix(g)) != calc.ImExterior {
+			return true, nil
+		}
+		for _, v := range g {
+			rings = append(rings, matrix.LineMatrix(v))
+		}
+	default:
+		return c.strategy.Intersects(cell, part)
+	}
+	for _, line := range rings {
+		for i, p := range line {
+			if relate.LocateInRing(p, ring) != calc.ImExterior {
+				return true, nil
+			}
+			if i > 0 && crossesRing(line[i-1], p, ring, false) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// covers tests whether the part of the geometry covers the ring of the cell, directly for polygons:
+// the vertices of the cell are in the polygon, and no edge of the polygon enters the cell.
+func (c *coverer) covers(part space.Geometry, cell space.Polygon) (bool, error) {
+	g, ok := part.(space.Polygon)
+	if !ok {
+		return c.strategy.Covers(part, cell)
+	}
+	ring := matrix.LineMatrix(cell[0])
+	for _, p := range ring {
+		if relate.LocateInPolygon(p, matrix.PolygonMatrix(g)) == calc.ImExterior {
+			return false, nil
+		}
+	}
+	for _, v := range g {
+		for i, p := range v {
+			if relate.LocateInRing(p, ring) == calc.ImInterior {
+				return false, nil
+			}
+			if i > 0 && crossesRing(v[i-1], p, ring, true) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// crossesRing tests whether the segment intersects an edge of the ring, properly crosses it if proper.
+func crossesRing(a, b []float64, ring matrix.LineMatrix, proper bool) bool {
+	for i := 1; i < len(ring); i++ {
+		c, d := ring[i-1], ring[i]
+		if math.Max(a[0], b[0]) < math.Min(c[0], d[0]) || math.Min(a[0], b[0]) > math.Max(c[0], d[0]) ||
+			math.Max(a[1], b[1]) < math.Min(c[1], d[1]) || math.Min(a[1], b[1]) > math.Max(c[1], d[1]) {
+			continue
+		}
+		o1, o2 := orientation(a, b, c), orientation(a, b, d)
+		o3, o4 := orientation(c, d, a), orientation(c, d, b)
+		if o1*o2 < 0 && o3*o4 < 0 {
+			return true
+		}
+		if !proper && (o1 == 0 || o2 == 0 || o3 == 0 || o4 == 0) {
+			// touching, the bounds of the segments overlapping
+			if o1 != 0 && o1 == o2 || o3 != 0 && o3 == o4 {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// orientation returns the sign of the turn from a, b to c.
+func orientation(a, b, c []float64) int {
+	v := (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
+
+// parts returns the geometries of a multi geometry or a collection, the geometry itself otherwise.
+func parts(geom space.Geometry) []space.Geometry {
+	var geoms []space.Geometry
+	switch g := geom.(type) {
+	case space.MultiPoint:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.MultiLineString:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.MultiPolygon:
+		for _, v := range g {
+			geoms = append(geoms, v)
+		}
+	case space.Collection:
+		for _, v := range g {
+			geoms = append(geoms, parts(v)...)
+		}
+	default:
+		geoms = append(geoms, geom)
+	}
+	return geoms
+}
+
+// unwrap returns the parts with longitudes continuous along their edges, an edge jumping by more than
+// 180 degrees crossing the antimeridian, and the parts then reaching it also shifted to the other side.
+func unwrap(parts []space.Geometry) []space.Geometry {
+	var geoms []space.Geometry
+	for _, part := range parts {
+		switch g := part.(type) {
+		case space.LineString:
+			if len(g) == 0 {
+				break
+			}
+			part = space.LineString(unwrapLine(g, g[0][0]))
+		case space.Polygon:
+			if len(g) == 0 || len(g[0]) == 0 {
+				break
+			}
+			poly := make(space.Polygon, len(g))
+			for i, v := range g {
+				poly[i] = unwrapLine(v, g[0][0][0])
+			}
+			part = poly
+		case space.Point:
+		default:
+			geoms = append(geoms, part)
+			continue
+		}
+		geoms = append(geoms, part)
+		bound := part.Bound()
+		switch {
+		case bound.Min[0] <= -180 && bound.Max[0] < 180:
+			geoms = append(geoms, shiftPart(part, 360))
+		case bound.Max[0] >= 180 && bound.Min[0] > -180:
+			geoms = append(geoms, shiftPart(part, -360))
+		}
+	}
+	return geoms
+}
+
+// unwrapLine returns the line with longitudes continuous along its edges, its first point
+// moved by whole turns to within 180 degrees of the longitude.
+// An edge of a whole turn or more, along a parallel around a pole, is left as it is.
+func unwrapLine(line [][]float64, lng float64) [][]float64 {
+	if len(line) == 0 {
+		return line
+	}
+	unwrapped := make([][]float64, len(line))
+	offset := -360 * math.Round((line[0][0]-lng)/360)
+	for i, p := range line {
+		x := p[0] + offset
+		if i > 0 {
+			if d := x - unwrapped[i-1][0]; math.Abs(d) > 180 && math.Abs(d) < 360 {
+				offset -= math.Copysign(360, d)
+				x = p[0] + offset
+			}
+		}
+		unwrapped[i] = []float64{x, p[1]}
+	}
+	return unwrapped
+}
+
+// shiftPart returns the point, the line or the polygon moved by the longitude.
+func shiftPart(part space.Geometry, lng float64) space.Geometry {
+	switch g := part.(type) {
+	case space.Point:
+		return space.Point{g[0] + lng, g[1]}
+	case space.LineString:
+		return space.LineString(shiftRing(space.Ring(g), lng))
+	case space.Polygon:
+		poly := make(space.Polygon, len(g))
+		for i, v := range g {
+			poly[i] = shiftRing(space.Ring(v), lng)
+		}
+		return poly
+	}
+	return part
+}
+
+// candidateQueue a priority queue of candidates.
+type candidateQueue []*candidate
+
+func (q candidateQueue) Len() int           { return len(q) }
+func (q candidateQueue) Less(i, j int) bool { return q[i].priority > q[j].priority }
+func (q candidateQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *candidateQueue) Push(x interface{}) {
+	*q = append(*q, x.(*candidate))
+}
+
+func (q *candidateQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// CellUnion a set of cells, normalized when in increasing order without any cell containing another one
+// or 4 cells of the same parent.
+type CellUnion []CellID
+
+// Normalize returns the union sorted, without the cells contained by others, 4 cells of a parent replaced by it.
+func (u CellUnion) Normalize() CellUnion {
+	ids := append(CellUnion{}, u...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	output := ids[:0]
+	for _, id := range ids {
+		if len(output) > 0 && output[len(output)-1].Contains(id) {
+			continue
+		}
+		for len(output) > 0 && id.Contains(output[len(output)-1]) {
+			output = output[:len(output)-1]
+		}
+		for len(output) >= 3 && areSiblings(output[len(output)-3], output[len(output)-2], output[len(output)-1], id) {
+			output = output[:len(output)-3]
+			id = id.immediateParent()
+		}
+		output = append(output, id)
+	}
+	return output
+}
+
+// areSiblings tests whether the 4 cells are the children of a cell.
+func areSiblings(a, b, c, d CellID) bool {
+	if a^b^c != d || d.IsFace() {
+		return false
+	}
+	// the bits above the positions of the children
+	mask := d.lsb() << 1
+	mask = ^(mask + mask<<1)
+	masked := uint64(d) & mask
+	return uint64(a)&mask == masked && uint64(b)&mask == masked && uint64(c)&mask == masked
+}
+
+// Denormalize returns the cells of the union replaced by their descendants of minLevel or finer,
+// at levels every levelMod levels from minLevel.
+func (u CellUnion) Denormalize(minLevel, levelMod int) CellUnion {
+	output := make(CellUnion, 0, len(u))
+	for _, id := range u {
+		level := id.Level()
+		newLevel := level
+		if newLevel < minLevel {
+			newLevel = minLevel
+		}
+		if levelMod > 1 {
+			newLevel += (MaxLevel - (newLevel - minLevel)) % levelMod
+			if newLevel > MaxLevel {
+				newLevel = MaxLevel
+			}
+		}
+		if newLevel == level {
+			output = append(output, id)
+			continue
+		}
+		for child, end := id.ChildBegin(newLevel), id.ChildEnd(newLevel); child != end; child = child.Next() {
+			output = append(output, child)
+		}
+	}
+	return output
+}
+
+// Contains tests whether the cell is in a cell of the normalized union.
+func (u CellUnion) Contains(id CellID) bool {
+	i := sort.Search(len(u), func(i int) bool { return u[i] >= id })
+	if i < len(u) && u[i].RangeMin() <= id {
+		return true
+	}
+	return i > 0 && u[i-1].RangeMax() >= id
+}
+
+// Intersects tests whether the cell shares a leaf cell with a cell of the normalized union.
+func (u CellUnion) Intersects(id CellID) bool {
+	i := sort.Search(len(u), func(i int) bool { return u[i] >= id })
+	if i < len(u) && u[i].RangeMin() <= id.RangeMax() {
+		return true
+	}
+	return i > 0 && u[i-1].RangeMax() >= id.RangeMin()
+}
@@ -0,0 +1,172 @@
+package s2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestRegionCoverer_Covering(t *testing.T) {
+	newYork := space.Polygon{{{-74.1, 40.6}, {-73.9, 40.6}, {-73.9, 40.8}, {-74.1, 40.8}, {-74.1, 40.6}}}
+	antimeridian := space.MultiPolygon{
+		{{{179, -1}, {180, -1}, {180, 1}, {179, 1}, {179, -1}}},
+		{{{-180, -1}, {-179, -1}, {-179, 1}, {-180, 1}, {-180, -1}}},
+	}
+	arctic := space.Polygon{{{-180, 85}, {180, 85}, {180, 90}, {-180, 90}, {-180, 85}}}
+	tests := []struct {
+		name     string
+		coverer  RegionCoverer
+		geom     space.Geometry
+		inside   []space.Point
+		outside  []space.Point
+		maxCells int
+		wantErr  error
+	}{
+		{"new york", RegionCoverer{0, 30, 1, 8}, newYork,
+			[]space.Point{{-74, 40.7}, {-74.09, 40.61}, {-73.91, 40.79}}, []space.Point{{-74.5, 40.7}}, 8, nil},
+		{"new york levels", RegionCoverer{10, 14, 2, 20}, newYork,
+			[]space.Point{{-74, 40.7}, {-74.09, 40.61}, {-73.91, 40.79}}, []space.Point{{-74.5, 40.7}}, 0, nil},
+		{"point", RegionCoverer{0, 16, 1, 8}, space.Point{-74, 40.7},
+			[]space.Point{{-74, 40.7}}, []space.Point{{-74.1, 40.7}}, 1, nil},
+		{"line", RegionCoverer{0, 30, 1, 12}, space.LineString{{100, 10}, {120, 15}},
+			[]space.Point{{100, 10}, {110, 12.5}, {120, 15}}, []space.Point{{110, 20}}, 12, nil},
+		{"antimeridian", RegionCoverer{0, 30, 1, 8}, antimeridian,
+			[]space.Point{{179.5, 0}, {-179.5, 0}, {180, 0.5}}, []space.Point{{0, 0}, {170, 0}}, 8, nil},
+		{"north pole", RegionCoverer{0, 30, 1, 8}, arctic,
+			[]space.Point{{0, 90}, {45, 86}, {-135, 86}, {180, 89}}, []space.Point{{0, 80}}, 8, nil},
+		{"empty", RegionCoverer{0, 30, 1, 8}, space.Polygon{}, nil, nil, 0, nil},
+		{"nil", RegionCoverer{0, 30, 1, 8}, nil, nil, nil, 0, spaceerr.ErrNilGeometry},
+		{"levels", RegionCoverer{10, 5, 1, 8}, newYork, nil, nil, 0, ErrInvalidLevel},
+		{"level mod", RegionCoverer{0, 30, 4, 8}, newYork, nil, nil, 0, ErrInvalidCoverer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.coverer.Covering(tt.geom)
+			if err != tt.wantErr {
+				t.Fatalf("Covering() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.maxCells > 0 && len(got) > tt.maxCells {
+				t.Errorf("Covering() = %v cells, want at most %v", len(got), tt.maxCells)
+			}
+			for _, id := range got {
+				level := id.Level()
+				if level < tt.coverer.MinLevel || level > tt.coverer.MaxLevel || (level-tt.coverer.MinLevel)%tt.coverer.LevelMod != 0 {
+					t.Errorf("Covering() cell %v of level %v", id, level)
+				}
+			}
+			if !reflect.DeepEqual(got, got.Normalize()) && tt.coverer.MinLevel == 0 && tt.coverer.LevelMod == 1 {
+				t.Errorf("Covering() = %v, is not normalized", got)
+			}
+			for _, p := range tt.inside {
+				if leaf, _ := CellIDFromPoint(p); !got.Contains(leaf) {
+					t.Errorf("Covering() does not contain %v", p)
+				}
+			}
+			for _, p := range tt.outside {
+				if leaf, _ := CellIDFromPoint(p); got.Contains(leaf) {
+					t.Errorf("Covering() contains %v", p)
+				}
+			}
+		})
+	}
+}
+
+func TestRegionCoverer_CoveringTransmeridian(t *testing.T) {
+	coverer := NewRegionCoverer()
+	want, err := coverer.Covering(space.Polygon{{{179, 0}, {181, 0}, {181, 1}, {179, 1}, {179, 0}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		geom space.Geometry
+	}{
+		{"polygon", space.Polygon{{{179, 0}, {-179, 0}, {-179, 1}, {179, 1}, {179, 0}}}},
+		{"west", space.Polygon{{{-181, 0}, {-179, 0}, {-179, 1}, {-181, 1}, {-181, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coverer.Covering(tt.geom)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Covering() = %v, want %v", got, want)
+			}
+			for _, id := range got {
+				if id.IsFace() {
+					t.Errorf("Covering() has the face cell %v", id)
+				}
+			}
+		})
+	}
+	line, err := coverer.Covering(space.LineString{{179.5, 0.5}, {-179.5, 0.5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []space.Point{{179.9, 0.5}, {-179.9, 0.5}} {
+		if leaf, _ := CellIDFromPoint(p); !line.Contains(leaf) {
+			t.Errorf("Covering() does not contain %v", p)
+		}
+	}
+	if leaf, _ := CellIDFromPoint(space.Point{0, 0.5}); line.Contains(leaf) {
+		t.Errorf("Covering() contains %v", space.Point{0, 0.5})
+	}
+}
+
+func TestRegionCoverer_InteriorCovering(t *testing.T) {
+	polygon := space.Polygon{{{-74.1, 40.6}, {-73.9, 40.6}, {-73.9, 40.8}, {-74.1, 40.8}, {-74.1, 40.6}}}
+	coverer := &RegionCoverer{MinLevel: 0, MaxLevel: 16, LevelMod: 1, MaxCells: 20}
+	got, err := coverer.InteriorCovering(polygon)
+	if err != nil || len(got) == 0 || len(got) > 20 {
+		t.Fatalf("InteriorCovering() = %v, %v, want 1 to 20 cells", got, err)
+	}
+	strategy := planar.NormalStrategy()
+	for _, id := range got {
+		if covers, _ := strategy.Covers(polygon, id.region().polygons[0]); !covers {
+			t.Errorf("InteriorCovering() cell %v is not covered", id)
+		}
+	}
+	covering, _ := NewRegionCoverer().Covering(polygon)
+	for _, id := range got {
+		if !covering.Intersects(id) {
+			t.Errorf("Covering() does not intersect %v", id)
+		}
+	}
+}
+
+func TestCellUnion_Normalize(t *testing.T) {
+	id, _ := CellIDFromToken("89c25")
+	children := id.Children()
+	tests := []struct {
+		name  string
+		union CellUnion
+		want  CellUnion
+	}{
+		{"siblings", CellUnion{children[3], children[1], children[0], children[2]}, CellUnion{id}},
+		{"grandchildren", append(CellUnion{children[0], children[1], children[2]}, children[3].Children()...), CellUnion{id}},
+		{"contained", CellUnion{children[2].ChildBegin(12), id, id}, CellUnion{id}},
+		{"disjoint", CellUnion{id.Next(), children[0]}, CellUnion{children[0], id.Next()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.union.Normalize(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	denormalized := CellUnion{id}.Denormalize(10, 1)
+	if len(denormalized) != 16 || denormalized[0] != id.ChildBegin(10) || !reflect.DeepEqual(denormalized.Normalize(), CellUnion{id}) {
+		t.Errorf("Denormalize() = %v, want the 16 cells of level 10", denormalized)
+	}
+	if got := (CellUnion{children[0]}).Denormalize(7, 3); len(got) != 4 || got[0].Level() != 10 {
+		t.Errorf("Denormalize() = %v, want the 4 cells of level 10", got)
+	}
+}
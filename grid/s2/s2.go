@@ -0,0 +1,284 @@
+// Package s2 is a hierarchical spherical grid compatible with the cells of the S2 geometry library.
+// The sphere is projected on the 6 faces of a cube, each face being split into 4 cells at the next level,
+// down to level 30, and the cells of a face are ordered along a Hilbert curve.
+// Cells are identified by the same 64 bit ids as S2, so the cells of a cell are a range of ids.
+// Points are longitude and latitude in degrees.
+package s2
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// MaxLevel the finest level of cells.
+const MaxLevel = 30
+
+// const ...
+const (
+	numFaces   = 6
+	posBits    = 2*MaxLevel + 1
+	maxSize    = 1 << MaxLevel
+	lookupBits = 4
+	swapMask   = 1
+	invertMask = 2
+)
+
+// ErrInvalidLevel ...
+var ErrInvalidLevel = fmt.Errorf("s2 level must be between 0 and %d", MaxLevel)
+
+// ErrInvalidCellID ...
+var ErrInvalidCellID = fmt.Errorf("s2 cell id is not valid")
+
+// ErrInvalidPoint ...
+var ErrInvalidPoint = fmt.Errorf("point is not a valid longitude and latitude")
+
+// posToIJ the (i, j) quadrant of the positions along the Hilbert curve, for each orientation.
+var posToIJ = [4][4]int{
+	{0, 1, 3, 2}, // canonical order:    (0,0), (0,1), (1,1), (1,0)
+	{0, 2, 3, 1}, // axes swapped:       (0,0), (1,0), (1,1), (0,1)
+	{3, 2, 0, 1}, // bits inverted:      (1,1), (1,0), (0,0), (0,1)
+	{3, 1, 0, 2}, // swapped & inverted: (1,1), (0,1), (0,0), (1,0)
+}
+
+// posToOrientation the change of orientation of the child at each position.
+var posToOrientation = [4]int{swapMask, 0, 0, invertMask | swapMask}
+
+// lookupPos and lookupIJ convert lookupBits of i and j to 2*lookupBits of position and back, with the orientation.
+var (
+	lookupPos [1 << (2*lookupBits + 2)]int
+	lookupIJ  [1 << (2*lookupBits + 2)]int
+)
+
+func init() {
+	initLookupCell(0, 0, 0, 0, 0, 0)
+	initLookupCell(0, 0, 0, swapMask, 0, swapMask)
+	initLookupCell(0, 0, 0, invertMask, 0, invertMask)
+	initLookupCell(0, 0, 0, swapMask|invertMask, 0, swapMask|invertMask)
+}
+
+func initLookupCell(level, i, j, origOrientation, pos, orientation int) {
+	if level == lookupBits {
+		ij := (i << lookupBits) + j
+		lookupPos[(ij<<2)+origOrientation] = (pos << 2) + orientation
+		lookupIJ[(pos<<2)+origOrientation] = (ij << 2) + orientation
+		return
+	}
+	level++
+	i <<= 1
+	j <<= 1
+	pos <<= 2
+	r := posToIJ[orientation]
+	for k := 0; k < 4; k++ {
+		initLookupCell(level, i+(r[k]>>1), j+(r[k]&1), origOrientation, pos+k, orientation^posToOrientation[k])
+	}
+}
+
+// CellID an id of a cell of the grid: 3 bits of face, 2 bits per level of position along the Hilbert curve, then a 1 bit.
+type CellID uint64
+
+// CellIDFromFace returns the cell of level 0 of the face.
+func CellIDFromFace(face int) CellID {
+	return CellID(uint64(face)<<posBits + lsbForLevel(0))
+}
+
+// CellIDFromPoint returns the cell of level 30 containing the point.
+func CellIDFromPoint(point space.Point) (CellID, error) {
+	if len(point) < 2 || math.IsInf(point[0], 0) || math.IsNaN(point[0]) ||
+		math.IsNaN(point[1]) || math.Abs(point[1]) > 90 {
+		return 0, ErrInvalidPoint
+	}
+	face, u, v := xyzToFaceUV(pointToXYZ(point))
+	return cellIDFromFaceIJ(face, stToIJ(uvToST(u)), stToIJ(uvToST(v))), nil
+}
+
+// CellIDFromToken returns the cell of the token.
+func CellIDFromToken(token string) (CellID, error) {
+	if len(token) > 16 || token == "" {
+		return 0, ErrInvalidCellID
+	}
+	n, err := strconv.ParseUint(token, 16, 64)
+	if err != nil {
+		return 0, ErrInvalidCellID
+	}
+	c := CellID(n << (4 * (16 - len(token))))
+	if !c.IsValid() {
+		return 0, ErrInvalidCellID
+	}
+	return c, nil
+}
+
+func cellIDFromFaceIJ(face, i, j int) CellID {
+	n := uint64(face) << (posBits - 1)
+	lookup := face & swapMask
+	mask := (1 << lookupBits) - 1
+	for k := 7; k >= 0; k-- {
+		lookup += ((i >> (k * lookupBits)) & mask) << (lookupBits + 2)
+		lookup += ((j >> (k * lookupBits)) & mask) << 2
+		lookup = lookupPos[lookup]
+		n |= uint64(lookup>>2) << (k * 2 * lookupBits)
+		lookup &= swapMask | invertMask
+	}
+	return CellID(n*2 + 1)
+}
+
+// lsbForLevel returns the lowest bit set of the cells of the level.
+func lsbForLevel(level int) uint64 {
+	return 1 << (2 * (MaxLevel - level))
+}
+
+func (c CellID) lsb() uint64 {
+	return uint64(c) & -uint64(c)
+}
+
+// Face returns the face of the cell, 0 to 5.
+func (c CellID) Face() int {
+	return int(uint64(c) >> posBits)
+}
+
+// Level returns the level of the cell, 0 to 30.
+func (c CellID) Level() int {
+	return MaxLevel - bits.TrailingZeros64(uint64(c))>>1
+}
+
+// IsValid tests whether the id is the id of a cell.
+func (c CellID) IsValid() bool {
+	return c.Face() < numFaces && c.lsb()&0x1555555555555555 != 0
+}
+
+// IsLeaf tests whether the cell is of level 30.
+func (c CellID) IsLeaf() bool {
+	return uint64(c)&1 != 0
+}
+
+// IsFace tests whether the cell is of level 0.
+func (c CellID) IsFace() bool {
+	return uint64(c)&(lsbForLevel(0)-1) == 0
+}
+
+// Parent returns the cell of the coarser level containing the cell.
+func (c CellID) Parent(level int) (CellID, error) {
+	if level < 0 || level > c.Level() {
+		return 0, ErrInvalidLevel
+	}
+	lsb := lsbForLevel(level)
+	return CellID(uint64(c)&-lsb | lsb), nil
+}
+
+// immediateParent returns the parent of the cell, which is not a face.
+func (c CellID) immediateParent() CellID {
+	lsb := c.lsb() << 2
+	return CellID(uint64(c)&-lsb | lsb)
+}
+
+// Children returns the 4 cells of the next level in the cell, along the Hilbert curve.
+// It returns nil for a leaf.
+func (c CellID) Children() []CellID {
+	if c.IsLeaf() {
+		return nil
+	}
+	lsb := c.lsb()
+	children := make([]CellID, 4)
+	child := CellID(uint64(c) - lsb + lsb>>2)
+	for k := range children {
+		children[k] = child
+		child += CellID(lsb >> 1)
+	}
+	return children
+}
+
+// ChildBegin returns the first cell of the level in the cell.
+func (c CellID) ChildBegin(level int) CellID {
+	return CellID(uint64(c) - c.lsb() + lsbForLevel(level))
+}
+
+// ChildEnd returns the cell following the last cell of the level in the cell.
+func (c CellID) ChildEnd(level int) CellID {
+	return CellID(uint64(c) + c.lsb() + lsbForLevel(level))
+}
+
+// Next returns the next cell of the same level along the Hilbert curve, across faces.
+func (c CellID) Next() CellID {
+	return CellID(uint64(c) + c.lsb()<<1)
+}
+
+// RangeMin returns the first leaf cell in the cell.
+func (c CellID) RangeMin() CellID {
+	return CellID(uint64(c) - (c.lsb() - 1))
+}
+
+// RangeMax returns the last leaf cell in the cell.
+func (c CellID) RangeMax() CellID {
+	return CellID(uint64(c) + (c.lsb() - 1))
+}
+
+// Contains tests whether the other cell is in the cell.
+func (c CellID) Contains(other CellID) bool {
+	return c.RangeMin() <= other && other <= c.RangeMax()
+}
+
+// Intersects tests whether the cells share a leaf cell, one containing the other.
+func (c CellID) Intersects(other CellID) bool {
+	return other.RangeMin() <= c.RangeMax() && other.RangeMax() >= c.RangeMin()
+}
+
+// Token returns the id in hexadecimal, without trailing zeros.
+func (c CellID) Token() string {
+	if c == 0 {
+		return "X"
+	}
+	return strings.TrimRight(fmt.Sprintf("%016x", uint64(c)), "0")
+}
+
+// String returns the face and the positions of the cell, as "face/positions".
+func (c CellID) String() string {
+	if !c.IsValid() {
+		return "Invalid: " + strconv.FormatUint(uint64(c), 16)
+	}
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(c.Face()))
+	b.WriteByte('/')
+	for level := 1; level <= c.Level(); level++ {
+		b.WriteByte('0' + byte(uint64(c)>>(posBits-1-2*level)&3))
+	}
+	return b.String()
+}
+
+// Point returns the longitude and latitude of the center of the cell.
+func (c CellID) Point() space.Point {
+	face, i, j, _ := c.faceIJOrientation()
+	// the center is at the corner of the 4 children, the leaf cell at (i, j) being one of them
+	size := 1 << (MaxLevel - c.Level())
+	si, ti := 2*(i&-size)+size, 2*(j&-size)+size
+	if c.IsLeaf() {
+		si, ti = 2*i+1, 2*j+1
+	}
+	u := stToUV(float64(si) / (2 * maxSize))
+	v := stToUV(float64(ti) / (2 * maxSize))
+	return xyzToPoint(faceUVToXYZ(face, u, v))
+}
+
+// faceIJOrientation returns the face, the (i, j) of the leaf cell at the start of the cell along the Hilbert curve
+// and the orientation of the Hilbert curve in the cell.
+func (c CellID) faceIJOrientation() (face, i, j, orientation int) {
+	face = c.Face()
+	orientation = face & swapMask
+	nbits := MaxLevel - 7*lookupBits
+	for k := 7; k >= 0; k-- {
+		orientation += (int(uint64(c)>>(k*2*lookupBits+1)) & ((1 << (2 * nbits)) - 1)) << 2
+		orientation = lookupIJ[orientation]
+		i += (orientation >> (lookupBits + 2)) << (k * lookupBits)
+		j += ((orientation >> 2) & ((1 << lookupBits) - 1)) << (k * lookupBits)
+		orientation &= swapMask | invertMask
+		nbits = lookupBits
+	}
+	// the trailing bits 10(00)*0 of a cell which is not a leaf reverse the swap bit for every 00
+	if c.lsb()&0x1111111111111110 != 0 {
+		orientation ^= swapMask
+	}
+	return
+}
@@ -0,0 +1,131 @@
+package s2
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/planar"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestCellIDFromPoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		point     space.Point
+		level     int
+		want      string
+		wantFace  int
+		wantError error
+	}{
+		{"new york level 5", space.Point{-74.0060, 40.7128}, 5, "89c4", 4, nil},
+		{"new york level 8", space.Point{-74.0060, 40.7128}, 8, "89c25", 4, nil},
+		{"new york level 12", space.Point{-74.0060, 40.7128}, 12, "89c25a3", 4, nil},
+		{"new york leaf", space.Point{-74.0060, 40.7128}, 30, "89c25a220cf80969", 4, nil},
+		{"face 0", space.Point{0, 0}, 0, "1", 0, nil},
+		{"face 1", space.Point{90, 0}, 0, "3", 1, nil},
+		{"north pole", space.Point{0, 90}, 0, "5", 2, nil},
+		{"antimeridian", space.Point{180, 0}, 0, "7", 3, nil},
+		{"face 4", space.Point{-90, 0}, 0, "9", 4, nil},
+		{"south pole", space.Point{0, -90}, 0, "b", 5, nil},
+		{"latitude", space.Point{0, 91}, 0, "", 0, ErrInvalidPoint},
+		{"nan", space.Point{math.NaN(), 0}, 0, "", 0, ErrInvalidPoint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leaf, err := CellIDFromPoint(tt.point)
+			if err != tt.wantError {
+				t.Fatalf("CellIDFromPoint() error = %v, want %v", err, tt.wantError)
+			}
+			if err != nil {
+				return
+			}
+			got, err := leaf.Parent(tt.level)
+			if err != nil || got.Token() != tt.want || got.Face() != tt.wantFace || got.Level() != tt.level || !got.IsValid() {
+				t.Errorf("Parent() = %v, %v, want %v", got.Token(), err, tt.want)
+			}
+			if id, err := CellIDFromToken(tt.want); err != nil || id != got {
+				t.Errorf("CellIDFromToken() = %v, %v, want %v", id, err, got)
+			}
+		})
+	}
+}
+
+func TestCellIDFromToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    CellID
+		wantErr error
+	}{
+		{"face", "1", 0x1000000000000000, nil},
+		{"cell", "89c25", 0x89c2500000000000, nil},
+		{"upper case", "89C25", 0x89c2500000000000, nil},
+		{"face 6", "d", 0, ErrInvalidCellID},
+		{"not a level", "89c2", 0, ErrInvalidCellID},
+		{"zero", "X", 0, ErrInvalidCellID},
+		{"too long", "89c25a220cf809690", 0, ErrInvalidCellID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CellIDFromToken(tt.token)
+			if err != tt.wantErr || got != tt.want {
+				t.Errorf("CellIDFromToken() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCellID_Hierarchy(t *testing.T) {
+	id, _ := CellIDFromToken("89c25")
+	if id.String() != "4/21300211" {
+		t.Errorf("String() = %v, want 4/21300211", id.String())
+	}
+	children := id.Children()
+	if len(children) != 4 || children[0] != id.ChildBegin(9) || children[3].Next() != id.ChildEnd(9) {
+		t.Fatalf("Children() = %v, want from %v to %v", children, id.ChildBegin(9), id.ChildEnd(9))
+	}
+	for _, child := range children {
+		if parent, err := child.Parent(8); err != nil || parent != id || !id.Contains(child) || !child.Intersects(id) {
+			t.Errorf("Parent() = %v, %v, want %v", parent, err, id)
+		}
+	}
+	if id.Contains(id.Next()) || id.Intersects(id.Next()) {
+		t.Errorf("Contains() = true, want false for %v", id.Next())
+	}
+	if id.RangeMin() != id.ChildBegin(MaxLevel) || id.RangeMax().Next() != id.ChildEnd(MaxLevel) {
+		t.Errorf("RangeMin(), RangeMax() = %v, %v", id.RangeMin(), id.RangeMax())
+	}
+	if _, err := id.Parent(9); err != ErrInvalidLevel {
+		t.Errorf("Parent() error = %v, want %v", err, ErrInvalidLevel)
+	}
+	leaf := id.RangeMin()
+	if !leaf.IsLeaf() || leaf.Children() != nil || !CellIDFromFace(4).IsFace() {
+		t.Errorf("IsLeaf(), Children() = %v, %v", leaf.IsLeaf(), leaf.Children())
+	}
+}
+
+func TestCellID_Point(t *testing.T) {
+	strategy := planar.NormalStrategy()
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		point := space.Point{r.Float64()*360 - 180, r.Float64()*170 - 85}
+		leaf, _ := CellIDFromPoint(point)
+		for _, level := range []int{4, 12, 20} {
+			id, _ := leaf.Parent(level)
+			center, _ := CellIDFromPoint(id.Point())
+			if parent, _ := center.Parent(level); parent != id {
+				t.Fatalf("CellIDFromPoint() = %v, want %v for the center of the cell", parent, id)
+			}
+			polygon := id.Polygon()
+			if len(polygon[0]) != 5 || id.Grid().Geometry == nil {
+				t.Fatalf("Polygon() = %v, want 4 vertices", polygon)
+			}
+			if level == 12 {
+				if contains, _ := strategy.Covers(id.region().polygons[0], point); !contains {
+					t.Errorf("region() = %v, does not contain %v", id.region(), point)
+				}
+			}
+		}
+	}
+}
@@ -1,5 +1,6 @@
 // Package grid is used to generate grid data.
-// include square and hexagon.
+// include square, hexagon, triangle and point grids, masked or clipped to polygons and streamed by an iterator,
+// and aggregates the properties of features onto the cells of grids.
 package grid
 
 import (
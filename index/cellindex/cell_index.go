@@ -0,0 +1,209 @@
+// Package cellindex a spatial index keyed by the ranges of the S2 cells covering the items.
+// Envelopes are in longitude and latitude, the cells of the sphere being continuous across the antimeridian
+// and up to the poles.
+package cellindex
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/grid/s2"
+	"github.com/spatial-go/geoos/index"
+	"github.com/spatial-go/geoos/space"
+)
+
+// CellIndex A spatial index of items keyed by the cells covering their envelopes.
+// An item is found by a query when one of its cells contains, or is in, one of the cells covering the search envelope,
+// items whose envelopes do not intersect the search envelope, their longitudes compared modulo 360, being filtered out.
+//
+// The index is safe for concurrent use, queries share a read lock and updates hold the write lock.
+type CellIndex struct {
+	mu      sync.RWMutex
+	coverer *s2.RegionCoverer
+	records map[int]*record
+	next    int
+	cells   []cellEntry
+	sorted  bool
+}
+
+// record an item with its envelope.
+type record struct {
+	env  *envelope.Envelope
+	item interface{}
+}
+
+// cellEntry a cell of a record.
+type cellEntry struct {
+	id     s2.CellID
+	record int
+}
+
+// NewCellIndex returns an index covering items with the default region coverer.
+func NewCellIndex() *CellIndex {
+	return NewCellIndexWithCoverer(s2.NewRegionCoverer())
+}
+
+// NewCellIndexWithCoverer returns an index covering items with the region coverer.
+func NewCellIndexWithCoverer(coverer *s2.RegionCoverer) *CellIndex {
+	return &CellIndex{coverer: coverer, records: map[int]*record{}, sorted: true}
+}
+
+// Size Gets the number of items in the index.
+func (t *CellIndex) Size() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.records)
+}
+
+// Insert Adds a spatial item with an extent specified by the given Envelope to the index
+func (t *CellIndex) Insert(itemEnv *envelope.Envelope, item interface{}) error {
+	if itemEnv == nil || itemEnv.IsNil() {
+		return index.ErrNotMatchType
+	}
+	cells, err := t.coverer.Covering(envGeometry(itemEnv))
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.records[t.next] = &record{env: envelope.Env(itemEnv), item: item}
+	for _, id := range cells {
+		t.cells = append(t.cells, cellEntry{id: id, record: t.next})
+	}
+	t.next++
+	t.sorted = false
+	return nil
+}
+
+// Query Queries the index for all items whose extents intersect the given search  Envelope
+func (t *CellIndex) Query(searchEnv *envelope.Envelope) interface{} {
+	visitor := &index.ArrayVisitor{ItemsArray: []interface{}{}}
+	_ = t.QueryVisitor(searchEnv, visitor)
+	return visitor.Items()
+}
+
+// QueryVisitor Queries the index for all items whose extents intersect the given search Envelope,
+// and applies an  ItemVisitor to them, in the order of their insertion.
+func (t *CellIndex) QueryVisitor(searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	if searchEnv == nil || searchEnv.IsNil() {
+		return index.ErrNotMatchType
+	}
+	cells, err := t.coverer.Covering(envGeometry(searchEnv))
+	if err != nil {
+		return err
+	}
+	t.mu.RLock()
+	for !t.sorted {
+		t.mu.RUnlock()
+		t.sort()
+		t.mu.RLock()
+	}
+	defer t.mu.RUnlock()
+	found := map[int]bool{}
+	for _, id := range cells {
+		// the cells containing the cell
+		for level := 0; level < id.Level(); level++ {
+			parent, _ := id.Parent(level)
+			for i := t.search(parent); i < len(t.cells) && t.cells[i].id == parent; i++ {
+				found[t.cells[i].record] = true
+			}
+		}
+		// the cells in the cell
+		for i := t.search(id.RangeMin()); i < len(t.cells) && t.cells[i].id <= id.RangeMax(); i++ {
+			found[t.cells[i].record] = true
+		}
+	}
+	records := make([]int, 0, len(found))
+	for r := range found {
+		records = append(records, r)
+	}
+	sort.Ints(records)
+	for _, r := range records {
+		if index.IsDone(visitor) {
+			break
+		}
+		if v := t.records[r]; intersects(v.env, searchEnv) {
+			visitor.VisitItem(v.item)
+		}
+	}
+	return nil
+}
+
+// Remove Removes a single item from the tree.
+func (t *CellIndex) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
+	if itemEnv == nil || itemEnv.IsNil() {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	removed := -1
+	for r, v := range t.records {
+		if v.env.Equals(itemEnv) && reflect.DeepEqual(v.item, item) && (removed < 0 || r < removed) {
+			removed = r
+		}
+	}
+	if removed < 0 {
+		return false
+	}
+	delete(t.records, removed)
+	cells := t.cells[:0]
+	for _, v := range t.cells {
+		if v.record != removed {
+			cells = append(cells, v)
+		}
+	}
+	t.cells = cells
+	return true
+}
+
+// sort sorts the cells of the records by id.
+func (t *CellIndex) sort() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.sorted {
+		sort.Slice(t.cells, func(i, j int) bool {
+			if t.cells[i].id != t.cells[j].id {
+				return t.cells[i].id < t.cells[j].id
+			}
+			return t.cells[i].record < t.cells[j].record
+		})
+		t.sorted = true
+	}
+}
+
+// search returns the index of the first cell not less than the id.
+func (t *CellIndex) search(id s2.CellID) int {
+	return sort.Search(len(t.cells), func(i int) bool { return t.cells[i].id >= id })
+}
+
+// intersects tests whether the envelopes intersect, their longitudes compared modulo 360.
+func intersects(env, searchEnv *envelope.Envelope) bool {
+	for _, shift := range []float64{0, -360, 360} {
+		if env.IsIntersects(envelope.FourFloat(searchEnv.MinX+shift, searchEnv.MaxX+shift, searchEnv.MinY, searchEnv.MaxY)) {
+			return true
+		}
+	}
+	return false
+}
+
+// envGeometry returns the envelope, clamped to the valid latitudes, as a point, a line or a polygon.
+func envGeometry(env *envelope.Envelope) space.Geometry {
+	minY, maxY := math.Max(env.MinY, -90), math.Min(env.MaxY, 90)
+	switch {
+	case minY > maxY:
+		return space.Polygon{}
+	case env.MinX == env.MaxX && minY == maxY:
+		return space.Point{env.MinX, minY}
+	case env.MinX == env.MaxX || minY == maxY:
+		return space.LineString{{env.MinX, minY}, {env.MaxX, maxY}}
+	}
+	return space.Bound{Min: space.Point{env.MinX, minY}, Max: space.Point{env.MaxX, maxY}}.ToPolygon()
+}
+
+// compile time checks
+var (
+	_ index.SpatialIndex = &CellIndex{}
+)
@@ -0,0 +1,144 @@
+package cellindex
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/grid/s2"
+	"github.com/spatial-go/geoos/index"
+)
+
+// brute returns the items whose envelopes intersect the search envelope, their longitudes compared modulo 360.
+func brute(envs []*envelope.Envelope, search *envelope.Envelope) []int {
+	items := []int{}
+	for i, env := range envs {
+		if intersects(env, search) {
+			items = append(items, i)
+		}
+	}
+	return items
+}
+
+func ints(items interface{}) []int {
+	got := []int{}
+	for _, v := range items.([]interface{}) {
+		got = append(got, v.(int))
+	}
+	sort.Ints(got)
+	return got
+}
+
+func TestCellIndex_Query(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	envs := []*envelope.Envelope{}
+	for i := 0; i < 300; i++ {
+		x, y := r.Float64()*358-179, r.Float64()*170-85
+		envs = append(envs, envelope.FourFloat(x, x+r.Float64(), y, y+r.Float64()))
+	}
+	envs = append(envs,
+		envelope.FourFloat(179.5, 180, -1, 1),
+		envelope.FourFloat(-180, -179.5, -1, 1),
+		envelope.FourFloat(-180, 180, 88, 90),
+		envelope.FourFloat(10, 10, 20, 20),
+	)
+	tests := []struct {
+		name    string
+		coverer *s2.RegionCoverer
+	}{
+		{"default", s2.NewRegionCoverer()},
+		{"levels", &s2.RegionCoverer{MinLevel: 4, MaxLevel: 12, LevelMod: 2, MaxCells: 16}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := NewCellIndexWithCoverer(tt.coverer)
+			for i, env := range envs {
+				if err := tree.Insert(env, i); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tree.Size() != len(envs) {
+				t.Errorf("Size() = %v, want %v", tree.Size(), len(envs))
+			}
+			searches := []*envelope.Envelope{
+				envelope.FourFloat(-10, 10, -10, 10),
+				envelope.FourFloat(179, 180, -2, 2),
+				envelope.FourFloat(-180, 180, 89, 90),
+				envelope.FourFloat(10, 10, 20, 20),
+				envelope.FourFloat(100, 140, 30, 60),
+			}
+			for i := 0; i < 20; i++ {
+				x, y := r.Float64()*340-170, r.Float64()*160-80
+				searches = append(searches, envelope.FourFloat(x, x+r.Float64()*10, y, y+r.Float64()*10))
+			}
+			for _, search := range searches {
+				if got, want := ints(tree.Query(search)), brute(envs, search); !reflect.DeepEqual(got, want) {
+					t.Errorf("Query(%v) = %v, want %v", search.ToString(), got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCellIndex_QueryTransmeridian(t *testing.T) {
+	tree := NewCellIndex()
+	if err := tree.Insert(envelope.FourFloat(179, 181, -1, 1), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Insert(envelope.FourFloat(170, 175, -1, 1), 1); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		search *envelope.Envelope
+		want   []int
+	}{
+		{"west", envelope.FourFloat(-180, -179.5, -0.5, 0.5), []int{0}},
+		{"east", envelope.FourFloat(179.5, 180, -0.5, 0.5), []int{0}},
+		{"both", envelope.FourFloat(174, 180, -0.5, 0.5), []int{0, 1}},
+		{"outside", envelope.FourFloat(-178, -177, -0.5, 0.5), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ints(tree.Query(tt.search)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCellIndex_Remove(t *testing.T) {
+	tree := NewCellIndex()
+	env := envelope.FourFloat(116.3, 116.5, 39.9, 40.0)
+	_ = tree.Insert(env, "a")
+	_ = tree.Insert(env, "b")
+	_ = tree.Insert(envelope.FourFloat(116.4, 116.6, 39.8, 39.95), "c")
+	if err := tree.Insert(nil, "d"); err != index.ErrNotMatchType {
+		t.Errorf("Insert() error = %v, want %v", err, index.ErrNotMatchType)
+	}
+	if got := tree.Query(envelope.FourFloat(116.45, 116.46, 39.92, 39.93)); !reflect.DeepEqual(got, []interface{}{"a", "b", "c"}) {
+		t.Errorf("Query() = %v, want [a b c]", got)
+	}
+	if !tree.Remove(env, "a") || tree.Remove(env, "a") || tree.Size() != 2 {
+		t.Errorf("Remove() = false, want true once")
+	}
+	if got := tree.Query(envelope.FourFloat(116.45, 116.46, 39.92, 39.93)); !reflect.DeepEqual(got, []interface{}{"b", "c"}) {
+		t.Errorf("Query() = %v, want [b c]", got)
+	}
+	visitor := &stopVisitor{}
+	_ = tree.Insert(envelope.FourFloat(116.44, 116.47, 39.91, 39.94), "e")
+	if err := tree.QueryVisitor(envelope.FourFloat(116.45, 116.46, 39.92, 39.93), visitor); err != nil || len(visitor.ItemsArray) != 2 {
+		t.Errorf("QueryVisitor() = %v, %v, want 2 items", visitor.ItemsArray, err)
+	}
+}
+
+// stopVisitor a visitor stopping after two items.
+type stopVisitor struct {
+	index.ArrayVisitor
+}
+
+func (s *stopVisitor) IsDone() bool {
+	return len(s.ItemsArray) >= 2
+}
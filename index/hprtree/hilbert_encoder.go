@@ -28,7 +28,7 @@ func NewHilbertEncoder(level int, extent *envelope.Envelope) *HilbertEncoder {
 	extentX := extent.Width()
 	h.strideX = extentX / hSide
 
-	h.miny = extent.MinX
+	h.miny = extent.MinY
 	extentY := extent.Height()
 	h.strideY = extentY / hSide
 	return h
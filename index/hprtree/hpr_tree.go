@@ -1,13 +1,17 @@
 // Package hprtree  a hprtree is a spatial index structure .
 // This is a static R-tree which is packed by using the Hilbert ordering of the tree items.
+// Removing items packs the tree again on the next query, use rtree for deletion-heavy workloads.
 package hprtree
 
 import (
 	"log"
 	"math"
+	"reflect"
 	"sort"
 
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/index"
 )
 
@@ -29,13 +33,14 @@ type HPRTree struct {
 	layerStartIndex []int
 	nodeBounds      []float64
 	isBuilt         bool
+	isDirty         bool
 }
 
 // NewHPRTree return default NewHPRTree.
 func NewHPRTree() *HPRTree {
 	h := &HPRTree{}
 	h.nodeCapacity = DefaultNodeCapacity
-	h.totalExtent = &envelope.Envelope{}
+	h.totalExtent = envelope.Empty()
 	return h
 }
 
@@ -60,15 +65,14 @@ func (h *HPRTree) Insert(itemEnv *envelope.Envelope, item interface{}) error {
 func (h *HPRTree) Query(searchEnv *envelope.Envelope) interface{} {
 	h.build()
 
+	visitor := &index.ArrayVisitor{ItemsArray: []interface{}{}}
 	if !h.totalExtent.IsIntersects(searchEnv) {
-		return h.Items
+		return visitor.Items()
 	}
-
-	visitor := &index.ArrayVisitor{}
 	if err := h.QueryVisitor(searchEnv, visitor); err != nil {
 		log.Println(err)
 	}
-	return visitor.Items
+	return visitor.Items()
 }
 
 // QueryVisitor Queries the index for all items whose extents intersect the given search Envelope,
@@ -99,7 +103,7 @@ func (h *HPRTree) queryTopLayer(searchEnv *envelope.Envelope, visitor index.Item
 func (h *HPRTree) queryNode(layerIndex, nodeOffset int, searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
 	layerStart := h.layerStartIndex[layerIndex]
 	nodeIndex := layerStart + nodeOffset
-	if !h.isIntersects(nodeIndex, searchEnv) {
+	if !h.isIntersects(nodeIndex, searchEnv) || index.IsDone(visitor) {
 		return
 	}
 	if layerIndex == 0 {
@@ -135,7 +139,7 @@ func (h *HPRTree) queryItems(blockStart int, searchEnv *envelope.Envelope, visit
 	for i := 0; i < h.nodeCapacity; i++ {
 		itemIndex := blockStart + i
 		// don't query past end of items
-		if itemIndex >= h.Size() {
+		if itemIndex >= h.Size() || index.IsDone(visitor) {
 			break
 		}
 		// visit the item if its envelope intersects search env
@@ -163,18 +167,100 @@ func (h *HPRTree) layerSize(layerIndex int) int {
 	return layerEnd - layerStart
 }
 
+// hprNode a node of the tree, the offset of its bounds in a layer.
+type hprNode struct {
+	layerIndex, nodeOffset int
+}
+
+// Nearest returns the k items nearest to the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (h *HPRTree) Nearest(geom matrix.Steric, k int, distance measure.DistanceFunc) []index.Neighbour {
+	if k <= 0 {
+		return []index.Neighbour{}
+	}
+	return h.nearest(geom, k, math.Inf(1), distance)
+}
+
+// NearestWithin returns the items within maxDist of the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (h *HPRTree) NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	return h.nearest(geom, 0, maxDist, distance)
+}
+
+func (h *HPRTree) nearest(geom matrix.Steric, k int, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	h.build()
+	search := index.NewNearestSearch(geom, distance)
+	if h.layerStartIndex == nil {
+		h.pushItems(search, 0)
+	} else {
+		layerIndex := len(h.layerStartIndex) - 2
+		layerSize := h.layerSize(layerIndex)
+		for i := 0; i < layerSize; i += EnvSize {
+			h.pushNode(search, layerIndex, i)
+		}
+	}
+	return search.Search(k, maxDist, func(node interface{}) {
+		n := node.(hprNode)
+		if n.layerIndex == 0 {
+			h.pushItems(search, n.nodeOffset/EnvSize*h.nodeCapacity)
+			return
+		}
+		blockOffset := n.nodeOffset * h.nodeCapacity
+		layerStart := h.layerStartIndex[n.layerIndex-1]
+		layerEnd := h.layerStartIndex[n.layerIndex]
+		for i := 0; i < h.nodeCapacity; i++ {
+			nodeOffset := blockOffset + EnvSize*i
+			if layerStart+nodeOffset >= layerEnd {
+				break
+			}
+			h.pushNode(search, n.layerIndex-1, nodeOffset)
+		}
+	})
+}
+
+func (h *HPRTree) pushNode(search *index.NearestSearch, layerIndex, nodeOffset int) {
+	i := h.layerStartIndex[layerIndex] + nodeOffset
+	env := envelope.FourFloat(h.nodeBounds[i], h.nodeBounds[i+2], h.nodeBounds[i+1], h.nodeBounds[i+3])
+	search.PushNode(env, hprNode{layerIndex, nodeOffset})
+}
+
+func (h *HPRTree) pushItems(search *index.NearestSearch, blockStart int) {
+	for i := 0; i < h.nodeCapacity && blockStart+i < h.Size(); i++ {
+		item := h.Items[blockStart+i].(*Item)
+		search.PushItem(item.Env, item.Item)
+	}
+}
+
 // Remove Removes a single item from the tree.
+// The tree and its extent are computed again on the next query, so removing many items
+// from a large tree is costly, rtree.RTree supports deletion-heavy workloads.
 func (h *HPRTree) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
-	// TODO Auto-generated method stub
+	for i, v := range h.Items {
+		it := v.(*Item)
+		if !h.isIntersectsEnv(it.Env, itemEnv) || !reflect.DeepEqual(it.Item, item) {
+			continue
+		}
+		h.Items = append(h.Items[:i], h.Items[i+1:]...)
+		h.isDirty = true
+		return true
+	}
 	return false
 }
 
-// build Builds the index, if not already built.
+// build Builds the index, if not already built or if items were removed since it was built.
 func (h *HPRTree) build() {
 	// skip if already built
-	if h.isBuilt {
+	if h.isBuilt && !h.isDirty {
 		return
 	}
+	if h.isDirty {
+		h.isDirty = false
+		h.totalExtent = envelope.Empty()
+		for _, v := range h.Items {
+			h.totalExtent.ExpandToIncludeEnv(v.(*Item).Env)
+		}
+		h.layerStartIndex, h.nodeBounds = nil, nil
+	}
 	h.isBuilt = true
 	// don't need to build an empty or very small tree
 	if h.Size() <= h.nodeCapacity {
@@ -220,7 +306,7 @@ func (h *HPRTree) computeLayerNodes(layerIndex int) {
 }
 
 func (h *HPRTree) computeNodeBounds(nodeIndex, blockStart, nodeMaxIndex int) {
-	for i := 0; i <= h.nodeCapacity; i++ {
+	for i := 0; i < h.nodeCapacity; i++ {
 		index := blockStart + 4*i
 		if index >= nodeMaxIndex {
 			break
@@ -236,12 +322,12 @@ func (h *HPRTree) computeLeafNodes(layerSize int) {
 }
 
 func (h *HPRTree) computeLeafNodeBounds(nodeIndex, blockStart int) {
-	for i := 0; i <= h.nodeCapacity; i++ {
+	for i := 0; i < h.nodeCapacity; i++ {
 		itemIndex := blockStart + i
 		if itemIndex >= h.Size() {
 			break
 		}
-		env := h.Items[itemIndex].(Item).Env
+		env := h.Items[itemIndex].(*Item).Env
 		h.updateNodeBounds(nodeIndex, env.MinX, env.MinY, env.MaxX, env.MaxY)
 	}
 }
@@ -270,10 +356,13 @@ func (h *HPRTree) computeLayerIndices(itemSize, nodeCapacity int) []int {
 	layerIndexList := []int{}
 	layerSize := itemSize
 	index := 0
-	for layerSize > 1 {
+	for {
 		layerIndexList = append(layerIndexList, index)
-		layerSize := h.numNodesToCover(layerSize, nodeCapacity)
+		layerSize = h.numNodesToCover(layerSize, nodeCapacity)
 		index += EnvSize * layerSize
+		if layerSize <= 1 {
+			break
+		}
 	}
 	return layerIndexList
 }
@@ -324,8 +413,8 @@ func (it *ItemComparator) Len() int {
 // Less ...
 func (it *ItemComparator) Less(i, j int) bool {
 
-	hCode1 := it.encoder.encode(it.items[i].(Item).Env)
-	hCode2 := it.encoder.encode(it.items[j].(Item).Env)
+	hCode1 := it.encoder.encode(it.items[i].(*Item).Env)
+	hCode2 := it.encoder.encode(it.items[j].(*Item).Env)
 	return hCode1 < hCode2
 }
 
@@ -336,4 +425,5 @@ func (it ItemComparator) Swap(i, j int) {
 
 var (
 	_ index.SpatialIndex = &HPRTree{}
+	_ index.NearestIndex = &HPRTree{}
 )
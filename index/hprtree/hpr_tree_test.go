@@ -8,6 +8,7 @@ import (
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/index"
 )
 
@@ -122,3 +123,92 @@ func TestHPRTree_QueryVisitor(t *testing.T) {
 		})
 	}
 }
+
+func TestHPRTree_Nearest(t *testing.T) {
+	tree := NewHPRTree()
+	points := []matrix.Matrix{}
+	for i := 0; i < 30; i++ {
+		for j := 0; j < 30; j++ {
+			p := matrix.Matrix{float64(i), float64(j)}
+			points = append(points, p)
+			_ = tree.Insert(envelope.Matrix(p), p)
+		}
+	}
+	if got := len(tree.Query(envelope.FourFloat(10, 12, 10, 12)).([]interface{})); got != 9 {
+		t.Errorf("HPRTree.Query() = %v items, want %v", got, 9)
+	}
+	if got := len(tree.Query(envelope.FourFloat(100, 120, 100, 120)).([]interface{})); got != 0 {
+		t.Errorf("HPRTree.Query() = %v items, want %v", got, 0)
+	}
+
+	query := matrix.Matrix{10.2, 10.1}
+	got := tree.Nearest(query, 4, nil)
+	want := []matrix.Matrix{{10, 10}, {11, 10}, {10, 11}, {10, 9}}
+	if len(got) != len(want) {
+		t.Fatalf("HPRTree.Nearest() = %v, want %v", got, want)
+	}
+	for i, v := range got {
+		if !v.Item.(matrix.Matrix).Equals(want[i]) {
+			t.Errorf("HPRTree.Nearest() %v = %v, want %v", i, v.Item, want[i])
+		}
+	}
+
+	within := tree.NearestWithin(query, 1.5, nil)
+	count := 0
+	for _, p := range points {
+		if measure.PlanarDistance(query, p) <= 1.5 {
+			count++
+		}
+	}
+	if len(within) != count {
+		t.Errorf("HPRTree.NearestWithin() = %v items, want %v", len(within), count)
+	}
+	for i := 1; i < len(within); i++ {
+		if within[i].Distance < within[i-1].Distance {
+			t.Errorf("HPRTree.NearestWithin() not ordered by distance: %v", within)
+		}
+	}
+
+	spheroid := tree.Nearest(matrix.Matrix{29.4, 0.1}, 1, measure.SpheroidDistance)
+	if len(spheroid) != 1 || !spheroid[0].Item.(matrix.Matrix).Equals(matrix.Matrix{29, 0}) {
+		t.Errorf("HPRTree.Nearest() spheroid = %v, want %v", spheroid, matrix.Matrix{29, 0})
+	}
+}
+
+func TestHPRTree_Remove(t *testing.T) {
+	tree := NewHPRTree()
+	for i := 0; i < 100; i++ {
+		p := matrix.Matrix{float64(i), float64(i)}
+		_ = tree.Insert(envelope.Matrix(p), p)
+	}
+	if got := len(tree.Query(envelope.FourFloat(0, 100, 0, 100)).([]interface{})); got != 100 {
+		t.Fatalf("HPRTree.Query() = %v items, want %v", got, 100)
+	}
+	for i := 50; i < 100; i++ {
+		p := matrix.Matrix{float64(i), float64(i)}
+		if !tree.Remove(envelope.Matrix(p), p) {
+			t.Errorf("HPRTree.Remove(%v) = false", p)
+		}
+	}
+	if tree.Remove(envelope.Matrix(matrix.Matrix{60, 60}), matrix.Matrix{60, 60}) {
+		t.Errorf("HPRTree.Remove() of a removed item = true")
+	}
+	if !tree.isDirty || tree.Size() != 50 {
+		t.Errorf("HPRTree.Remove() dirty = %v, size = %v, want true, %v", tree.isDirty, tree.Size(), 50)
+	}
+	if got := len(tree.Query(envelope.FourFloat(0, 100, 0, 100)).([]interface{})); got != 50 {
+		t.Errorf("HPRTree.Query() = %v items, want %v", got, 50)
+	}
+	if got := len(tree.Query(envelope.FourFloat(20.5, 30.5, 20.5, 30.5)).([]interface{})); got != 10 {
+		t.Errorf("HPRTree.Query() = %v items, want %v", got, 10)
+	}
+	if want := envelope.FourFloat(0, 49, 0, 49); !tree.totalExtent.Equals(want) {
+		t.Errorf("HPRTree.Remove() extent = %v, want %v", tree.totalExtent, want)
+	}
+	if err := tree.QueryVisitor(envelope.FourFloat(60, 70, 60, 70), &index.ArrayVisitor{}); err != index.ErrHPRNotIsIntersects {
+		t.Errorf("HPRTree.QueryVisitor() error = %v, want %v", err, index.ErrHPRNotIsIntersects)
+	}
+	if got := tree.Nearest(matrix.Matrix{80, 80}, 1, nil); len(got) != 1 || !got[0].Item.(matrix.Matrix).Equals(matrix.Matrix{49, 49}) {
+		t.Errorf("HPRTree.Nearest() = %v, want %v", got, matrix.Matrix{49, 49})
+	}
+}
@@ -0,0 +1,23 @@
+//go:build !unix
+
+package hprtree
+
+import (
+	"os"
+)
+
+// OpenFile returns a Reader of the serialized tree in the named file, read in place.
+// The Reader must be closed to close the file.
+func OpenFile(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	r.closer = f
+	return r, nil
+}
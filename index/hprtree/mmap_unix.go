@@ -0,0 +1,47 @@
+//go:build unix
+
+package hprtree
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+
+	"github.com/spatial-go/geoos/index"
+)
+
+// OpenFile returns a Reader of the serialized tree in the named file, which is memory mapped.
+// The Reader must be closed to unmap the file.
+func OpenFile(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() < headerSize {
+		return nil, index.ErrHPRFormat
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		_ = syscall.Munmap(data)
+		return nil, err
+	}
+	r.closer = mapping(data)
+	return r, nil
+}
+
+// mapping a memory mapped file.
+type mapping []byte
+
+// Close unmaps the file.
+func (m mapping) Close() error {
+	return syscall.Munmap(m)
+}
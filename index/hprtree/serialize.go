@@ -0,0 +1,271 @@
+package hprtree
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+)
+
+// Serialized format of a HPRTree, all numbers little endian:
+//
+//	header       "HPRT", version uint32, node capacity uint32, layer count uint32, item count uint64,
+//	             total extent minx, miny, maxx, maxy float64
+//	layers       layer count uint64 start indices of the layers in the node bounds
+//	node bounds  minx, miny, maxx, maxy float64 for each node, up to the last layer start index
+//	items        minx, miny, maxx, maxy float64 and id uint64 for each item, in Hilbert order
+const (
+	formatMagic   = "HPRT"
+	formatVersion = 1
+	headerSize    = 56
+	boundsSize    = 8 * EnvSize
+	itemSize      = boundsSize + 8
+
+	// maxSerializedItems the maximum item count of a header, so that offsets do not overflow.
+	maxSerializedItems = math.MaxInt64 / (2 * itemSize)
+)
+
+// ItemIDFunc returns the id of an item of the tree, which references its payload in a serialized tree.
+type ItemIDFunc func(item interface{}) (uint64, error)
+
+// Serialize writes the tree in a binary format, building it if not already built.
+// Items are written as their envelope and the id returned by itemID,
+// the tree can be read back by a Reader which queries it without loading it.
+func (h *HPRTree) Serialize(w io.Writer, itemID ItemIDFunc) error {
+	h.build()
+	bw := bufio.NewWriter(w)
+	extent := []float64{0, 0, 0, 0}
+	if !h.totalExtent.IsNil() {
+		extent = []float64{h.totalExtent.MinX, h.totalExtent.MinY, h.totalExtent.MaxX, h.totalExtent.MaxY}
+	}
+	if _, err := bw.WriteString(formatMagic); err != nil {
+		return err
+	}
+	header := []interface{}{
+		uint32(formatVersion), uint32(h.nodeCapacity), uint32(len(h.layerStartIndex)), uint64(h.Size()), extent,
+	}
+	for _, v := range header {
+		if err := binary.Write(bw, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	for _, v := range h.layerStartIndex {
+		if err := binary.Write(bw, binary.LittleEndian, uint64(v)); err != nil {
+			return err
+		}
+	}
+	if err := binary.Write(bw, binary.LittleEndian, h.nodeBounds); err != nil {
+		return err
+	}
+	buf := make([]byte, itemSize)
+	for _, v := range h.Items {
+		item := v.(*Item)
+		id, err := itemID(item.Item)
+		if err != nil {
+			return err
+		}
+		putBounds(buf, item.Env.MinX, item.Env.MinY, item.Env.MaxX, item.Env.MaxY)
+		binary.LittleEndian.PutUint64(buf[boundsSize:], id)
+		if _, err := bw.Write(buf); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Reader A serialized HPRTree queried in place, through an io.ReaderAt such as a file or a memory mapped file.
+// Only the header and the layer indices are read when the Reader is created,
+// queries read the bounds of the nodes they visit and the items they test.
+// Items are visited as their id, a uint64.
+type Reader struct {
+	r               io.ReaderAt
+	closer          io.Closer
+	nodeCapacity    int
+	size            int
+	layerStartIndex []int
+	totalExtent     *envelope.Envelope
+	boundsOffset    int64
+	itemsOffset     int64
+	closed          bool
+}
+
+// NewReader returns a Reader of the serialized tree read from r.
+func NewReader(r io.ReaderAt) (*Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, index.ErrHPRFormat
+	}
+	if string(header[:4]) != formatMagic || binary.LittleEndian.Uint32(header[4:]) != formatVersion {
+		return nil, index.ErrHPRFormat
+	}
+	reader := &Reader{
+		r:            r,
+		nodeCapacity: int(binary.LittleEndian.Uint32(header[8:])),
+		size:         int(binary.LittleEndian.Uint64(header[16:])),
+	}
+	minx, miny, maxx, maxy := getBounds(header[24:])
+	reader.totalExtent = envelope.FourFloat(minx, maxx, miny, maxy)
+	layerCount := int(binary.LittleEndian.Uint32(header[12:]))
+	if reader.nodeCapacity <= 0 || reader.size < 0 || reader.size > maxSerializedItems {
+		return nil, index.ErrHPRFormat
+	}
+	// the layers are determined by the size and the node capacity, as built by the tree.
+	var layerStartIndex []int
+	if reader.size > reader.nodeCapacity {
+		if reader.nodeCapacity < 2 {
+			return nil, index.ErrHPRFormat
+		}
+		layerStartIndex = (&HPRTree{}).computeLayerIndices(reader.size, reader.nodeCapacity)
+	}
+	if layerCount != len(layerStartIndex) {
+		return nil, index.ErrHPRFormat
+	}
+	reader.boundsOffset = headerSize + 8*int64(layerCount)
+	reader.itemsOffset = reader.boundsOffset
+	if layerCount > 0 {
+		layers := make([]byte, 8*layerCount)
+		if _, err := r.ReadAt(layers, headerSize); err != nil {
+			return nil, index.ErrHPRFormat
+		}
+		for i, v := range layerStartIndex {
+			if binary.LittleEndian.Uint64(layers[8*i:]) != uint64(v) {
+				return nil, index.ErrHPRFormat
+			}
+		}
+		reader.layerStartIndex = layerStartIndex
+		reader.itemsOffset += 8 * int64(layerStartIndex[layerCount-1])
+	}
+	// the node bounds and the items are before the end of the data.
+	if reader.size > 0 {
+		if n, _ := r.ReadAt(make([]byte, 1), reader.itemsOffset+itemSize*int64(reader.size)-1); n != 1 {
+			return nil, index.ErrHPRFormat
+		}
+	}
+	return reader, nil
+}
+
+// Size Gets the number of items in the index.
+func (r *Reader) Size() int {
+	return r.size
+}
+
+// Close closes the file the Reader was opened from, if any.
+// Queries after Close return ErrHPRClosed.
+func (r *Reader) Close() error {
+	if r.closed {
+		return index.ErrHPRClosed
+	}
+	closer := r.closer
+	r.closed = true
+	r.r, r.closer, r.layerStartIndex = nil, nil, nil
+	if closer == nil {
+		return nil
+	}
+	return closer.Close()
+}
+
+// Query Queries the index for the ids of all items whose extents intersect the given search Envelope
+func (r *Reader) Query(searchEnv *envelope.Envelope) ([]uint64, error) {
+	visitor := &index.ArrayVisitor{ItemsArray: []interface{}{}}
+	if err := r.QueryVisitor(searchEnv, visitor); err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, len(visitor.ItemsArray))
+	for i, v := range visitor.ItemsArray {
+		ids[i] = v.(uint64)
+	}
+	return ids, nil
+}
+
+// QueryVisitor Queries the index for all items whose extents intersect the given search Envelope,
+// and applies an  ItemVisitor to their ids.
+func (r *Reader) QueryVisitor(searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	if r.closed {
+		return index.ErrHPRClosed
+	}
+	if r.size == 0 || !r.totalExtent.IsIntersects(searchEnv) {
+		return nil
+	}
+	if r.layerStartIndex == nil {
+		return r.queryItems(0, searchEnv, visitor)
+	}
+	return r.queryNodes(len(r.layerStartIndex)-2, 0, searchEnv, visitor)
+}
+
+// queryNodes queries the nodes of a block of the layer, the children of a node of the layer above.
+func (r *Reader) queryNodes(layerIndex, blockOffset int, searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	layerStart := r.layerStartIndex[layerIndex]
+	layerEnd := r.layerStartIndex[layerIndex+1]
+	count := layerEnd - layerStart - blockOffset
+	if count > EnvSize*r.nodeCapacity {
+		count = EnvSize * r.nodeCapacity
+	}
+	buf := make([]byte, 8*count)
+	if _, err := r.r.ReadAt(buf, r.boundsOffset+8*int64(layerStart+blockOffset)); err != nil {
+		return err
+	}
+	for i := 0; i < count; i += EnvSize {
+		if index.IsDone(visitor) {
+			return nil
+		}
+		if !intersects(buf[8*i:], searchEnv) {
+			continue
+		}
+		nodeOffset := blockOffset + i
+		var err error
+		if layerIndex == 0 {
+			err = r.queryItems(nodeOffset/EnvSize*r.nodeCapacity, searchEnv, visitor)
+		} else {
+			err = r.queryNodes(layerIndex-1, nodeOffset*r.nodeCapacity, searchEnv, visitor)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryItems queries a block of items, the items of a leaf node.
+func (r *Reader) queryItems(blockStart int, searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	count := r.size - blockStart
+	if count > r.nodeCapacity {
+		count = r.nodeCapacity
+	}
+	buf := make([]byte, itemSize*count)
+	if _, err := r.r.ReadAt(buf, r.itemsOffset+itemSize*int64(blockStart)); err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		if index.IsDone(visitor) {
+			return nil
+		}
+		item := buf[itemSize*i:]
+		if intersects(item, searchEnv) {
+			visitor.VisitItem(binary.LittleEndian.Uint64(item[boundsSize:]))
+		}
+	}
+	return nil
+}
+
+// intersects tests whether the bounds encoded in buf intersect the envelope.
+func intersects(buf []byte, env *envelope.Envelope) bool {
+	minx, miny, maxx, maxy := getBounds(buf)
+	return !(env.MaxX < minx || env.MaxY < miny || env.MinX > maxx || env.MinY > maxy)
+}
+
+func putBounds(buf []byte, minx, miny, maxx, maxy float64) {
+	for i, v := range []float64{minx, miny, maxx, maxy} {
+		binary.LittleEndian.PutUint64(buf[8*i:], math.Float64bits(v))
+	}
+}
+
+func getBounds(buf []byte) (minx, miny, maxx, maxy float64) {
+	minx = math.Float64frombits(binary.LittleEndian.Uint64(buf))
+	miny = math.Float64frombits(binary.LittleEndian.Uint64(buf[8:]))
+	maxx = math.Float64frombits(binary.LittleEndian.Uint64(buf[16:]))
+	maxy = math.Float64frombits(binary.LittleEndian.Uint64(buf[24:]))
+	return
+}
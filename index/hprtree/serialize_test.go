@@ -0,0 +1,182 @@
+package hprtree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+)
+
+func intID(item interface{}) (uint64, error) {
+	return uint64(item.(int)), nil
+}
+
+func randomTree(size int) *HPRTree {
+	r := rand.New(rand.NewSource(int64(size)))
+	tree := NewHPRTree()
+	for i := 0; i < size; i++ {
+		x, y := r.Float64()*100, r.Float64()*100
+		_ = tree.Insert(envelope.FourFloat(x, x+r.Float64()*2, y, y+r.Float64()*2), i)
+	}
+	return tree
+}
+
+func treeIDs(tree *HPRTree, env *envelope.Envelope) []uint64 {
+	ids := []uint64{}
+	for _, v := range tree.Query(env).([]interface{}) {
+		ids = append(ids, uint64(v.(int)))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func readerIDs(t *testing.T, r *Reader, env *envelope.Envelope) []uint64 {
+	ids, err := r.Query(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestHPRTree_Serialize(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{"empty", 0},
+		{"one node", 10},
+		{"two layers", 200},
+		{"four layers", 5000},
+	}
+	envs := []*envelope.Envelope{
+		envelope.FourFloat(0, 100, 0, 100),
+		envelope.FourFloat(10, 20, 30, 40),
+		envelope.FourFloat(50, 50.5, 50, 50.5),
+		envelope.FourFloat(200, 300, 0, 100),
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := randomTree(tt.size)
+			buf := &bytes.Buffer{}
+			if err := tree.Serialize(buf, intID); err != nil {
+				t.Fatal(err)
+			}
+			r, err := NewReader(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Size() != tt.size {
+				t.Errorf("Reader.Size() = %v, want %v", r.Size(), tt.size)
+			}
+			for _, env := range envs {
+				got, want := readerIDs(t, r, env), treeIDs(tree, env)
+				if len(got) != len(want) {
+					t.Fatalf("Reader.Query(%v) = %v ids, want %v", env, len(got), len(want))
+				}
+				for i := range got {
+					if got[i] != want[i] {
+						t.Errorf("Reader.Query(%v) = %v, want %v", env, got[i], want[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestOpenFile(t *testing.T) {
+	tree := randomTree(1000)
+	name := filepath.Join(t.TempDir(), "tree.hpr")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.Serialize(f, intID); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	r, err := OpenFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := envelope.FourFloat(20, 40, 20, 40)
+	if got, want := readerIDs(t, r, env), treeIDs(tree, env); len(got) != len(want) {
+		t.Errorf("Reader.Query() = %v ids, want %v", len(got), len(want))
+	}
+	visitor := &stopVisitor{}
+	if err := r.QueryVisitor(env, visitor); err != nil || len(visitor.ItemsArray) != 2 {
+		t.Errorf("Reader.QueryVisitor() visited %v items, error %v, want 2", len(visitor.ItemsArray), err)
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Reader.Close() error = %v", err)
+	}
+	if _, err := r.Query(env); err != index.ErrHPRClosed {
+		t.Errorf("Reader.Query() error = %v, want %v", err, index.ErrHPRClosed)
+	}
+	if err := r.QueryVisitor(env, &index.ArrayVisitor{}); err != index.ErrHPRClosed {
+		t.Errorf("Reader.QueryVisitor() error = %v, want %v", err, index.ErrHPRClosed)
+	}
+	if err := r.Close(); err != index.ErrHPRClosed {
+		t.Errorf("Reader.Close() error = %v, want %v", err, index.ErrHPRClosed)
+	}
+}
+
+func TestReader_errors(t *testing.T) {
+	errID := errors.New("no id")
+	if err := randomTree(10).Serialize(&bytes.Buffer{}, func(interface{}) (uint64, error) { return 0, errID }); err != errID {
+		t.Errorf("HPRTree.Serialize() error = %v, want %v", err, errID)
+	}
+	buf := &bytes.Buffer{}
+	_ = randomTree(100).Serialize(buf, intID)
+	data := buf.Bytes()
+	header := func(offset int, v uint64, size int) []byte {
+		d := append([]byte{}, data...)
+		if size == 4 {
+			binary.LittleEndian.PutUint32(d[offset:], uint32(v))
+		} else {
+			binary.LittleEndian.PutUint64(d[offset:], v)
+		}
+		return d
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"short", data[:10]},
+		{"magic", append([]byte("HPRX"), data[4:]...)},
+		{"layers", data[:headerSize+4]},
+		{"one layer", header(12, 1, 4)},
+		{"huge layer count", header(12, math.MaxUint32, 4)},
+		{"decreasing layers", header(headerSize, 1000, 8)},
+		{"last layer", header(headerSize+8, 1<<40, 8)},
+		{"node capacity", header(8, 1, 4)},
+		{"huge size", header(16, 1<<60, 8)},
+		{"negative size", header(16, math.MaxUint64, 8)},
+		{"truncated items", data[:len(data)-itemSize]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewReader(bytes.NewReader(tt.data)); err != index.ErrHPRFormat {
+				t.Errorf("NewReader() error = %v, want %v", err, index.ErrHPRFormat)
+			}
+		})
+	}
+}
+
+// stopVisitor a visitor stopping after two items.
+type stopVisitor struct {
+	index.ArrayVisitor
+}
+
+func (s *stopVisitor) IsDone() bool {
+	return len(s.ItemsArray) >= 2
+}
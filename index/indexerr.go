@@ -18,3 +18,9 @@ var ErrTreeIsNil = fmt.Errorf("Index is nil")
 
 // ErrNotMatchType ...
 var ErrNotMatchType = fmt.Errorf("Operation does not support not match type arguments")
+
+// ErrHPRFormat ...
+var ErrHPRFormat = fmt.Errorf("hpr tree data is not in the serialized format")
+
+// ErrHPRClosed ...
+var ErrHPRClosed = fmt.Errorf("hpr tree reader is closed")
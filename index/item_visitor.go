@@ -8,6 +8,22 @@ type ItemVisitor interface {
 	Items() interface{}
 }
 
+// StoppableVisitor A visitor which may stop a query before all the items are visited.
+type StoppableVisitor interface {
+	ItemVisitor
+
+	// IsDone returns true when no more items need to be visited.
+	IsDone() bool
+}
+
+// IsDone returns true if the visitor is a StoppableVisitor which needs no more items.
+func IsDone(visitor ItemVisitor) bool {
+	if v, ok := visitor.(StoppableVisitor); ok {
+		return v.IsDone()
+	}
+	return false
+}
+
 // compile time checks
 var (
 	_ ItemVisitor = &ArrayVisitor{}
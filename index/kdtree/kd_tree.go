@@ -3,6 +3,7 @@ package kdtree
 
 import (
 	"log"
+	"math"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
@@ -26,6 +27,7 @@ type KdTree struct {
 	root          *KdNode
 	numberOfNodes int64
 	tolerance     float64
+	extent        *envelope.Envelope
 }
 
 // ToMatrixesNotIncludeRepeated Converts a collection of KdNodes to an array of matrixes.
@@ -63,6 +65,7 @@ func (k *KdTree) InsertNoData(p matrix.Matrix) *KdNode {
 func (k *KdTree) InsertMatrix(p matrix.Matrix, data interface{}) *KdNode {
 	if k.root == nil {
 		k.root = &KdNode{Matrix: p, Data: data}
+		k.extent = envelope.Matrix(p)
 		return k.root
 	}
 
@@ -147,6 +150,7 @@ func (k *KdTree) insertExact(p matrix.Matrix, data interface{}) *KdNode {
 	//System.out.println("<<");
 	// no node found, add new leaf node to tree
 	k.numberOfNodes++
+	k.extent.ExpandToIncludeMatrix(p)
 	node := &KdNode{Matrix: p, Data: data}
 	if isLessThan {
 		leafNode.Left = node
@@ -162,6 +166,9 @@ func (k *KdTree) QueryNode(currentNode *KdNode,
 	if currentNode == nil {
 		return index.ErrTreeIsNil
 	}
+	if index.IsDone(visitor) {
+		return nil
+	}
 	var min, max, discriminant float64
 	if odd {
 		min = queryEnv.MinX
@@ -181,10 +188,10 @@ func (k *KdTree) QueryNode(currentNode *KdNode,
 			log.Println(err)
 		}
 	}
-	if queryEnv.Contains(envelope.Matrix(currentNode.Matrix)) {
+	if queryEnv.Contains(envelope.Matrix(currentNode.Matrix)) && !index.IsDone(visitor) {
 		visitor.VisitItem(currentNode)
 	}
-	if searchRight {
+	if searchRight && !index.IsDone(visitor) {
 		if err := k.QueryNode(currentNode.Right, queryEnv, !odd, visitor); err != nil {
 			log.Println(err)
 		}
@@ -275,6 +282,58 @@ func (k *KdTree) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
 	return false
 }
 
+// kdRegion a node of the tree with the region holding its subtree, split by the X ordinate at odd levels.
+type kdRegion struct {
+	node *KdNode
+	env  *envelope.Envelope
+	odd  bool
+}
+
+// Nearest returns the k nodes nearest to the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (k *KdTree) Nearest(geom matrix.Steric, n int, distance measure.DistanceFunc) []index.Neighbour {
+	if n <= 0 {
+		return []index.Neighbour{}
+	}
+	return k.nearest(geom, n, math.Inf(1), distance)
+}
+
+// NearestWithin returns the nodes within maxDist of the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (k *KdTree) NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	return k.nearest(geom, 0, maxDist, distance)
+}
+
+func (k *KdTree) nearest(geom matrix.Steric, n int, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	if k.root == nil {
+		return []index.Neighbour{}
+	}
+	search := index.NewNearestSearch(geom, distance)
+	search.PushNode(k.extent, kdRegion{k.root, k.extent, true})
+	return search.Search(n, maxDist, func(node interface{}) {
+		r := node.(kdRegion)
+		search.PushItemSteric(r.node.Matrix, r.node)
+		if r.node.Left != nil {
+			env := r.env.Copy()
+			if r.odd {
+				env.MaxX = r.node.X()
+			} else {
+				env.MaxY = r.node.Y()
+			}
+			search.PushNode(env, kdRegion{r.node.Left, env, !r.odd})
+		}
+		if r.node.Right != nil {
+			env := r.env.Copy()
+			if r.odd {
+				env.MinX = r.node.X()
+			} else {
+				env.MinY = r.node.Y()
+			}
+			search.PushNode(env, kdRegion{r.node.Right, env, !r.odd})
+		}
+	})
+}
+
 // BestMatchVisitor A visitor for items in a SpatialIndex.
 type BestMatchVisitor struct {
 	tolerance float64
@@ -326,4 +385,5 @@ var (
 	_ index.ItemVisitor  = &BestMatchVisitor{}
 	_ index.SpatialIndex = &quadtree.Quadtree{}
 	_ index.SpatialIndex = &KdTree{}
+	_ index.NearestIndex = &KdTree{}
 )
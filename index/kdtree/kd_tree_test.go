@@ -3,11 +3,14 @@ package kdtree
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"sort"
 	"testing"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
 )
 
 func TestKdTree_IsEmpty(t *testing.T) {
@@ -170,3 +173,39 @@ func buildTree() *KdTree {
 	}
 	return indexTree
 }
+
+func TestKdTree_Nearest(t *testing.T) {
+	tree := &KdTree{}
+	points := []matrix.Matrix{}
+	for i := 0; i < 200; i++ {
+		p := matrix.Matrix{float64(i*37%101) / 10, float64(i*53%97) / 10}
+		points = append(points, p)
+		tree.InsertMatrix(p, i)
+	}
+	query := matrix.Matrix{4.33, 5.71}
+	want := []float64{}
+	for _, p := range points {
+		want = append(want, measure.PlanarDistance(query, p))
+	}
+	sort.Float64s(want)
+
+	got := tree.Nearest(query, 10, nil)
+	if len(got) != 10 {
+		t.Fatalf("KdTree.Nearest() = %v, want 10 nodes", got)
+	}
+	for i, v := range got {
+		if v.Distance != want[i] {
+			t.Errorf("KdTree.Nearest() %v distance = %v, want %v", i, v.Distance, want[i])
+		}
+		if d := measure.PlanarDistance(query, v.Item.(*KdNode).Matrix); d != v.Distance {
+			t.Errorf("KdTree.Nearest() %v = %v at distance %v", i, v.Item, d)
+		}
+	}
+	within := tree.NearestWithin(query, 1, nil)
+	if count := sort.SearchFloat64s(want, math.Nextafter(1, 2)); len(within) != count {
+		t.Errorf("KdTree.NearestWithin() = %v nodes, want %v", len(within), count)
+	}
+	if got := (&KdTree{}).Nearest(query, 1, nil); len(got) != 0 {
+		t.Errorf("KdTree.Nearest() of empty tree = %v", got)
+	}
+}
@@ -0,0 +1,168 @@
+package index
+
+import (
+	"container/heap"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// Neighbour an item found by a nearest neighbour query, with its distance from the query geometry.
+type Neighbour struct {
+	Item     interface{}
+	Distance float64
+}
+
+// NearestIndex A spatial index supporting nearest neighbour queries.
+type NearestIndex interface {
+	// Nearest returns the k items nearest to the geometry, ordered by distance.
+	// A nil distance measures planar distance.
+	Nearest(geom matrix.Steric, k int, distance measure.DistanceFunc) []Neighbour
+
+	// NearestWithin returns the items within maxDist of the geometry, ordered by distance.
+	// A nil distance measures planar distance.
+	NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []Neighbour
+}
+
+// NearestSearch A best-first branch and bound search for the nearest items of an index.
+// Nodes of the index are queued by the distance to their envelope, which bounds the distance to their items,
+// items by their own distance. Nodes are expanded when they reach the head of the queue,
+// so items leave the queue in order of distance and nodes farther than the k-th item are never expanded.
+//
+// The distance is measured by a DistanceFunc, such as measure.PlanarDistance or measure.SpheroidDistance.
+// Items are measured as geometries if they are a matrix.Steric, a *matrix.LineSegment or have a ToMatrix method,
+// otherwise by their envelope.
+type NearestSearch struct {
+	geom     matrix.Steric
+	env      *envelope.Envelope
+	distance measure.DistanceFunc
+	queue    nearestQueue
+	seq      int
+}
+
+// NewNearestSearch returns a search for the items nearest to the geometry.
+func NewNearestSearch(geom matrix.Steric, distance measure.DistanceFunc) *NearestSearch {
+	if distance == nil {
+		distance = measure.PlanarDistance
+	}
+	return &NearestSearch{geom: geom, env: envelope.Bound(geom.Bound()), distance: distance}
+}
+
+// PushNode queues a node of the index whose items all lie in the envelope.
+func (s *NearestSearch) PushNode(env *envelope.Envelope, node interface{}) {
+	s.push(&nearestEntry{distance: s.envelopeDistance(env), value: node})
+}
+
+// PushItem queues an item of the index, the envelope is used for items which are not geometries.
+func (s *NearestSearch) PushItem(env *envelope.Envelope, item interface{}) {
+	if steric := ItemSteric(item); steric != nil {
+		s.PushItemSteric(steric, item)
+		return
+	}
+	s.push(&nearestEntry{distance: s.envelopeDistance(env), value: item, isItem: true})
+}
+
+// PushItemSteric queues an item of the index located by the geometry.
+func (s *NearestSearch) PushItemSteric(steric matrix.Steric, item interface{}) {
+	s.push(&nearestEntry{distance: s.distance(s.geom, steric), value: item, isItem: true})
+}
+
+// Search returns up to k items within maxDist, ordered by distance, all items within maxDist if k is not positive.
+// Nodes are expanded by calling expand, which queues their children.
+func (s *NearestSearch) Search(k int, maxDist float64, expand func(node interface{})) []Neighbour {
+	result := []Neighbour{}
+	for s.queue.Len() > 0 && (k <= 0 || len(result) < k) {
+		entry := heap.Pop(&s.queue).(*nearestEntry)
+		if entry.distance > maxDist {
+			break
+		}
+		if entry.isItem {
+			result = append(result, Neighbour{entry.value, entry.distance})
+		} else {
+			expand(entry.value)
+		}
+	}
+	return result
+}
+
+func (s *NearestSearch) push(entry *nearestEntry) {
+	entry.seq = s.seq
+	s.seq++
+	heap.Push(&s.queue, entry)
+}
+
+// envelopeDistance returns the distance to the envelope, zero if it intersects the envelope of the geometry.
+func (s *NearestSearch) envelopeDistance(env *envelope.Envelope) float64 {
+	if env == nil || env.IsNil() {
+		return 0
+	}
+	if s.env.IsIntersects(env) {
+		return 0
+	}
+	return s.distance(s.geom, EnvelopeSteric(env))
+}
+
+// EnvelopeSteric returns the envelope as a point, a line or a polygon, depending on its extent.
+func EnvelopeSteric(env *envelope.Envelope) matrix.Steric {
+	switch {
+	case env.Width() == 0 && env.Height() == 0:
+		return matrix.Matrix{env.MinX, env.MinY}
+	case env.Width() == 0 || env.Height() == 0:
+		return matrix.LineMatrix{{env.MinX, env.MinY}, {env.MaxX, env.MaxY}}
+	}
+	return matrix.PolygonMatrix{{{env.MinX, env.MinY}, {env.MaxX, env.MinY}, {env.MaxX, env.MaxY},
+		{env.MinX, env.MaxY}, {env.MinX, env.MinY}}}
+}
+
+// ItemSteric returns the geometry of an item, or nil if the item is not a geometry.
+func ItemSteric(item interface{}) matrix.Steric {
+	switch v := item.(type) {
+	case matrix.Steric:
+		return v
+	case *matrix.LineSegment:
+		return matrix.LineMatrix{v.P0, v.P1}
+	case interface{ ToMatrix() matrix.Steric }:
+		return v.ToMatrix()
+	}
+	return nil
+}
+
+// nearestEntry a node or an item in the queue of a NearestSearch.
+type nearestEntry struct {
+	distance float64
+	seq      int
+	value    interface{}
+	isItem   bool
+}
+
+// nearestQueue a priority queue of entries ordered by distance, items before nodes and then in order of insertion.
+type nearestQueue []*nearestEntry
+
+// Len ...
+func (q nearestQueue) Len() int { return len(q) }
+
+// Less ...
+func (q nearestQueue) Less(i, j int) bool {
+	if q[i].distance != q[j].distance {
+		return q[i].distance < q[j].distance
+	}
+	if q[i].isItem != q[j].isItem {
+		return q[i].isItem
+	}
+	return q[i].seq < q[j].seq
+}
+
+// Swap ...
+func (q nearestQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push ...
+func (q *nearestQueue) Push(x interface{}) { *q = append(*q, x.(*nearestEntry)) }
+
+// Pop ...
+func (q *nearestQueue) Pop() interface{} {
+	old := *q
+	entry := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return entry
+}
@@ -95,12 +95,12 @@ func (n *Node) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
 	}
 	found := false
 	for i := 0; i < 4; i++ {
-		if n.Subnode[i] != nil && n.Subnode[i].HasItems() {
+		if n.Subnode[i] != nil {
 			found = n.Subnode[i].Remove(itemEnv, item)
 			if found {
 				// trim subtree if empty
 				if n.Subnode[i].IsPrunable() {
-					n.Subnode[i] = &Node{}
+					n.Subnode[i] = nil
 				}
 				break
 			}
@@ -135,7 +135,7 @@ func (n *Node) IsPrunable() bool {
 // HasChildren ...
 func (n *Node) HasChildren() bool {
 	for i := 0; i < 4; i++ {
-		if !n.Subnode[i].HasItems() {
+		if n.Subnode[i] != nil {
 			return true
 		}
 	}
@@ -144,7 +144,7 @@ func (n *Node) HasChildren() bool {
 
 // Visit ...
 func (n *Node) Visit(searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
-	if !n.IsSearchMatch(searchEnv) {
+	if !n.IsSearchMatch(searchEnv) || index.IsDone(visitor) {
 		return
 	}
 	// this node may have items as well as subnodes (since items may not
@@ -160,11 +160,13 @@ func (n *Node) Visit(searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
 
 // VisitItems ...
 func (n *Node) VisitItems(searchEnv, nodeEnv *envelope.Envelope, visitor index.ItemVisitor) {
-	// would be nice to filter items based on search envelope, but can't until they contain an envelope
+	// would be nice to filter items based on search envelope, but can't until they contain an envelope,
+	// the node has already been matched, and the root which has no envelope matches every search.
 	for _, v := range n.Items {
-		if searchEnv.IsIntersects(nodeEnv) {
-			visitor.VisitItem(v)
+		if index.IsDone(visitor) {
+			return
 		}
+		visitor.VisitItem(v)
 	}
 }
 
@@ -209,23 +211,15 @@ func (n *Node) IsEmpty() bool {
 	if n == nil {
 		return true
 	}
-	isEmpty := true
-	if n.Items != nil && len(n.Items) > 0 {
-		isEmpty = false
-	} else {
-		for i := 0; i < 4; i++ {
-			if n.Subnode[i] == nil {
-				continue
-			}
-			if !n.Subnode[i].HasItems() {
-				if !n.Subnode[i].IsEmpty() {
-					isEmpty = false
-					break
-				}
-			}
+	if len(n.Items) > 0 {
+		return false
+	}
+	for i := 0; i < 4; i++ {
+		if !n.Subnode[i].IsEmpty() {
+			return false
 		}
 	}
-	return isEmpty
+	return true
 }
 
 // IsSearchMatch ...
@@ -4,9 +4,11 @@ package quadtree
 
 import (
 	"log"
+	"math"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/index"
 )
 
@@ -107,6 +109,41 @@ func (q *Quadtree) QueryVisitor(searchEnv *envelope.Envelope, visitor index.Item
 	return nil
 }
 
+// Nearest returns the k items nearest to the geometry, ordered by distance.
+// A nil distance measures planar distance.
+// Items which are not geometries are measured by the envelope of their node.
+func (q *Quadtree) Nearest(geom matrix.Steric, k int, distance measure.DistanceFunc) []index.Neighbour {
+	if k <= 0 {
+		return []index.Neighbour{}
+	}
+	return q.nearest(geom, k, math.Inf(1), distance)
+}
+
+// NearestWithin returns the items within maxDist of the geometry, ordered by distance.
+// A nil distance measures planar distance.
+// Items which are not geometries are measured by the envelope of their node.
+func (q *Quadtree) NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	return q.nearest(geom, 0, maxDist, distance)
+}
+
+func (q *Quadtree) nearest(geom matrix.Steric, k int, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	search := index.NewNearestSearch(geom, distance)
+	expand := func(node interface{}) {
+		n := node.(*Node)
+		for _, v := range n.Items {
+			search.PushItem(n.Env, v)
+		}
+		for i := 0; i < 4; i++ {
+			if !n.Subnode[i].IsEmpty() {
+				search.PushNode(n.Subnode[i].Env, n.Subnode[i])
+			}
+		}
+	}
+	// the root has no envelope, it is expanded at once.
+	expand(q.Root.Node)
+	return search.Search(k, maxDist, expand)
+}
+
 // CollectStats ...
 func (q *Quadtree) CollectStats(itemEnv *envelope.Envelope) {
 	delX := itemEnv.Width()
@@ -121,4 +158,5 @@ func (q *Quadtree) CollectStats(itemEnv *envelope.Envelope) {
 
 var (
 	_ index.SpatialIndex = &Quadtree{}
+	_ index.NearestIndex = &Quadtree{}
 )
@@ -2,12 +2,15 @@ package quadtree
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
 )
 
 var indexTree *Quadtree
@@ -104,3 +107,51 @@ func TestQuadtree_Query(t *testing.T) {
 		})
 	}
 }
+
+func TestQuadtree_Nearest(t *testing.T) {
+	tree := NewQuadtree()
+	segs := []*matrix.LineSegment{}
+	for i := -10; i < 10; i++ {
+		for j := -10; j < 10; j++ {
+			seg := &matrix.LineSegment{P0: matrix.Matrix{float64(i), float64(j)}, P1: matrix.Matrix{float64(i) + 0.5, float64(j) + 0.5}}
+			segs = append(segs, seg)
+			_ = tree.Insert(envelope.TwoMatrix(seg.P0, seg.P1), seg)
+		}
+	}
+	if tree.Size() != len(segs) {
+		t.Errorf("Quadtree.Size() = %v, want %v", tree.Size(), len(segs))
+	}
+	crossing := &matrix.LineSegment{P0: matrix.Matrix{-0.7, -0.8}, P1: matrix.Matrix{-0.6, 0.1}}
+	_ = tree.Insert(envelope.TwoMatrix(crossing.P0, crossing.P1), crossing)
+	found := false
+	for _, v := range tree.Query(envelope.FourFloat(-0.7, -0.6, -0.1, 0.1)).([]interface{}) {
+		found = found || v == crossing
+	}
+	if !found {
+		t.Errorf("Quadtree.Query() does not return the item crossing the axis")
+	}
+	if !tree.Remove(envelope.TwoMatrix(crossing.P0, crossing.P1), crossing) {
+		t.Errorf("Quadtree.Remove() = false, want true")
+	}
+
+	query := matrix.Matrix{0.8, -0.3}
+	got := tree.Nearest(query, 3, nil)
+	want := []float64{}
+	for _, seg := range segs {
+		want = append(want, measure.PlanarDistance(query, matrix.LineMatrix{seg.P0, seg.P1}))
+	}
+	sort.Float64s(want)
+	if len(got) != 3 {
+		t.Fatalf("Quadtree.Nearest() = %v, want 3 items", got)
+	}
+	for i, v := range got {
+		if v.Distance != want[i] {
+			t.Errorf("Quadtree.Nearest() %v distance = %v, want %v", i, v.Distance, want[i])
+		}
+	}
+	within := tree.NearestWithin(query, 2, nil)
+	count := sort.SearchFloat64s(want, math.Nextafter(2, 3))
+	if len(within) != count {
+		t.Errorf("Quadtree.NearestWithin() = %v items, want %v", len(within), count)
+	}
+}
@@ -60,12 +60,12 @@ func (r *Root) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
 	}
 	found := false
 	for i := 0; i < 4; i++ {
-		if r.Subnode[i] != nil && r.Subnode[i].HasItems() {
+		if r.Subnode[i] != nil {
 			found = r.Subnode[i].Remove(itemEnv, item)
 			if found {
 				// trim subtree if empty
 				if r.Subnode[i].IsPrunable() {
-					r.Subnode[i] = &Node{}
+					r.Subnode[i] = nil
 				}
 				break
 			}
@@ -82,7 +82,7 @@ func (r *Root) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
 
 // Visit ...
 func (r *Root) Visit(searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
-	if !r.IsSearchMatch(searchEnv) {
+	if !r.IsSearchMatch(searchEnv) || index.IsDone(visitor) {
 		return
 	}
 	// this node may have items as well as subnodes (since items may not
@@ -0,0 +1,559 @@
+// Package rtree a rtree is a dynamic spatial index structure.
+// This is a R*-tree supporting insertion, deletion and update of items at any time.
+package rtree
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/index"
+)
+
+// RTree const parameter.
+const (
+	DefaultMaxEntries = 16
+	// MinFillRatio the minimum number of entries of a node, as a ratio of the maximum.
+	MinFillRatio = 0.4
+	// ReinsertRatio the number of entries of an overflowing node which are reinserted, as a ratio of the maximum.
+	ReinsertRatio = 0.3
+)
+
+// Item an item of the tree with its envelope.
+type Item struct {
+	Env  *envelope.Envelope
+	Item interface{}
+}
+
+// RTree A R*-tree. Items are inserted in the node needing the least enlargement of its overlap
+// or area, overflowing nodes first reinsert their farthest entries once per level and insertion,
+// and are then split along the axis and at the position giving the least margin and overlap.
+// Deleting an item dissolves the nodes left underfull and reinserts their entries.
+//
+// The tree is safe for concurrent use, queries share a read lock and updates hold the write lock.
+type RTree struct {
+	mu         sync.RWMutex
+	root       *node
+	size       int
+	maxEntries int
+	minEntries int
+}
+
+// node a node of the tree, leaves are at level 0 and hold the items.
+type node struct {
+	parent  *node
+	entries []*entry
+	level   int
+}
+
+// entry an item of a leaf, or a child of a node, with its envelope.
+type entry struct {
+	env   *envelope.Envelope
+	child *node
+	item  interface{}
+}
+
+// NewRTree return a R*-tree with the default node capacity.
+func NewRTree() *RTree {
+	return NewRTreeWithCapacity(DefaultMaxEntries)
+}
+
+// NewRTreeWithCapacity return a R*-tree whose nodes hold at most maxEntries entries, at least 4.
+func NewRTreeWithCapacity(maxEntries int) *RTree {
+	if maxEntries < 4 {
+		maxEntries = 4
+	}
+	minEntries := int(math.Ceil(float64(maxEntries) * MinFillRatio))
+	if minEntries < 2 {
+		minEntries = 2
+	}
+	return &RTree{root: &node{}, maxEntries: maxEntries, minEntries: minEntries}
+}
+
+// Size Gets the number of items in the index.
+func (t *RTree) Size() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.size
+}
+
+// IsEmpty Tests whether the index contains any items.
+func (t *RTree) IsEmpty() bool {
+	return t.Size() == 0
+}
+
+// Depth Returns the number of levels in the tree.
+func (t *RTree) Depth() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.root.level + 1
+}
+
+// Insert Adds a spatial item with an extent specified by the given Envelope to the index
+func (t *RTree) Insert(itemEnv *envelope.Envelope, item interface{}) error {
+	if itemEnv == nil || itemEnv.IsNil() {
+		return index.ErrNotMatchType
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.insert(&entry{env: envelope.Env(itemEnv), item: item}, 0, map[int]bool{})
+	t.size++
+	return nil
+}
+
+// Load Adds the items to the index. An empty index is bulk loaded by Sort-Tile-Recursive packing,
+// which builds a tree of full nodes faster than inserting the items one by one.
+func (t *RTree) Load(items []*Item) error {
+	for _, v := range items {
+		if v.Env == nil || v.Env.IsNil() {
+			return index.ErrNotMatchType
+		}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.size > 0 || len(items) < t.minEntries {
+		for _, v := range items {
+			t.insert(&entry{env: envelope.Env(v.Env), item: v.Item}, 0, map[int]bool{})
+			t.size++
+		}
+		return nil
+	}
+	entries := make([]*entry, len(items))
+	for i, v := range items {
+		entries[i] = &entry{env: envelope.Env(v.Env), item: v.Item}
+	}
+	nodes := t.pack(entries, 0)
+	for level := 1; len(nodes) > 1; level++ {
+		entries = make([]*entry, len(nodes))
+		for i, v := range nodes {
+			entries[i] = &entry{env: v.bound(), child: v}
+		}
+		nodes = t.pack(entries, level)
+	}
+	t.root = nodes[0]
+	t.size = len(items)
+	return nil
+}
+
+// Query Queries the index for all items whose extents intersect the given search  Envelope
+func (t *RTree) Query(searchEnv *envelope.Envelope) interface{} {
+	visitor := &index.ArrayVisitor{ItemsArray: []interface{}{}}
+	_ = t.QueryVisitor(searchEnv, visitor)
+	return visitor.Items()
+}
+
+// QueryVisitor Queries the index for all items whose extents intersect the given search Envelope,
+// and applies an  ItemVisitor to them.
+func (t *RTree) QueryVisitor(searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	t.query(t.root, searchEnv, visitor)
+	return nil
+}
+
+func (t *RTree) query(n *node, searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
+	for _, e := range n.entries {
+		if index.IsDone(visitor) {
+			return
+		}
+		if !e.env.IsIntersects(searchEnv) {
+			continue
+		}
+		if n.level == 0 {
+			visitor.VisitItem(e.item)
+		} else {
+			t.query(e.child, searchEnv, visitor)
+		}
+	}
+}
+
+// Remove Removes a single item from the tree.
+func (t *RTree) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	leaf, i := t.findLeaf(t.root, itemEnv, item)
+	if leaf == nil {
+		return false
+	}
+	leaf.entries = append(leaf.entries[:i], leaf.entries[i+1:]...)
+	t.condense(leaf)
+	t.size--
+	return true
+}
+
+// Update Moves an item of the tree from oldEnv to newEnv, returns false if the item is not found.
+// The item stays in its leaf if the leaf covers the new envelope, otherwise it is reinserted.
+func (t *RTree) Update(oldEnv, newEnv *envelope.Envelope, item interface{}) bool {
+	if newEnv == nil || newEnv.IsNil() {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	leaf, i := t.findLeaf(t.root, oldEnv, item)
+	if leaf == nil {
+		return false
+	}
+	if leaf.bound().Covers(newEnv) {
+		leaf.entries[i].env = envelope.Env(newEnv)
+		t.updateBounds(leaf)
+		return true
+	}
+	e := leaf.entries[i]
+	leaf.entries = append(leaf.entries[:i], leaf.entries[i+1:]...)
+	t.condense(leaf)
+	e.env = envelope.Env(newEnv)
+	t.insert(e, 0, map[int]bool{})
+	return true
+}
+
+// Nearest returns the k items nearest to the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (t *RTree) Nearest(geom matrix.Steric, k int, distance measure.DistanceFunc) []index.Neighbour {
+	if k <= 0 {
+		return []index.Neighbour{}
+	}
+	return t.nearest(geom, k, math.Inf(1), distance)
+}
+
+// NearestWithin returns the items within maxDist of the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (t *RTree) NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	return t.nearest(geom, 0, maxDist, distance)
+}
+
+func (t *RTree) nearest(geom matrix.Steric, k int, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	search := index.NewNearestSearch(geom, distance)
+	expand := func(v interface{}) {
+		n := v.(*node)
+		for _, e := range n.entries {
+			if n.level == 0 {
+				search.PushItem(e.env, e.item)
+			} else {
+				search.PushNode(e.env, e.child)
+			}
+		}
+	}
+	expand(t.root)
+	return search.Search(k, maxDist, expand)
+}
+
+// insert adds the entry to a node at the level, reinserted records the levels
+// which have already reinserted entries during the insertion.
+func (t *RTree) insert(e *entry, level int, reinserted map[int]bool) {
+	n := t.chooseNode(e.env, level)
+	n.add(e)
+	t.overflow(n, reinserted)
+}
+
+// chooseNode returns the node at the level to insert an envelope in.
+// The child needing the least area enlargement is chosen,
+// or just above the leaves the one needing the least overlap enlargement.
+func (t *RTree) chooseNode(env *envelope.Envelope, level int) *node {
+	n := t.root
+	for n.level > level {
+		var best *entry
+		bestOverlap, bestEnlargement, bestArea := math.Inf(1), math.Inf(1), math.Inf(1)
+		for _, e := range n.entries {
+			expanded := envelope.Env(e.env)
+			expanded.ExpandToIncludeEnv(env)
+			area := e.env.Area()
+			enlargement := expanded.Area() - area
+			overlap := 0.0
+			if n.level == 1 {
+				for _, other := range n.entries {
+					if other != e {
+						overlap += overlapArea(expanded, other.env) - overlapArea(e.env, other.env)
+					}
+				}
+			}
+			if overlap < bestOverlap ||
+				(overlap == bestOverlap && (enlargement < bestEnlargement ||
+					(enlargement == bestEnlargement && area < bestArea))) {
+				best, bestOverlap, bestEnlargement, bestArea = e, overlap, enlargement, area
+			}
+		}
+		n = best.child
+	}
+	return n
+}
+
+// overflow treats the node and its ancestors after an entry has been added,
+// reinserting or splitting the nodes holding too many entries and updating the envelopes of the others.
+func (t *RTree) overflow(n *node, reinserted map[int]bool) {
+	for len(n.entries) > t.maxEntries {
+		if n != t.root && !reinserted[n.level] {
+			reinserted[n.level] = true
+			removed := t.pickReinsert(n)
+			t.updateBounds(n)
+			for _, e := range removed {
+				t.insert(e, n.level, reinserted)
+			}
+			return
+		}
+		sibling := t.split(n)
+		if n == t.root {
+			t.root = &node{level: n.level + 1}
+			t.root.add(&entry{env: n.bound(), child: n})
+			t.root.add(&entry{env: sibling.bound(), child: sibling})
+			return
+		}
+		n.parentEntry().env = n.bound()
+		n.parent.add(&entry{env: sibling.bound(), child: sibling})
+		n = n.parent
+	}
+	t.updateBounds(n)
+}
+
+// pickReinsert removes and returns the entries of the node whose centres are farthest from the centre of the node,
+// ordered from the nearest, to be reinserted.
+func (t *RTree) pickReinsert(n *node) []*entry {
+	centre := n.bound().Centre()
+	distance := func(e *entry) float64 {
+		c := e.env.Centre()
+		return math.Hypot(c[0]-centre[0], c[1]-centre[1])
+	}
+	sort.SliceStable(n.entries, func(i, j int) bool {
+		return distance(n.entries[i]) < distance(n.entries[j])
+	})
+	count := int(math.Ceil(float64(t.maxEntries) * ReinsertRatio))
+	keep := len(n.entries) - count
+	removed := append([]*entry{}, n.entries[keep:]...)
+	n.entries = n.entries[:keep]
+	return removed
+}
+
+// split moves part of the entries of the node into a new sibling, which is returned.
+// The axis is chosen by the least sum of the margins of the candidate distributions,
+// the distribution by the least overlap, then the least area.
+func (t *RTree) split(n *node) *node {
+	sorts := [2][2]func(a, b *entry) bool{
+		{func(a, b *entry) bool {
+			return a.env.MinX < b.env.MinX || (a.env.MinX == b.env.MinX && a.env.MaxX < b.env.MaxX)
+		}, func(a, b *entry) bool {
+			return a.env.MaxX < b.env.MaxX || (a.env.MaxX == b.env.MaxX && a.env.MinX < b.env.MinX)
+		}},
+		{func(a, b *entry) bool {
+			return a.env.MinY < b.env.MinY || (a.env.MinY == b.env.MinY && a.env.MaxY < b.env.MaxY)
+		}, func(a, b *entry) bool {
+			return a.env.MaxY < b.env.MaxY || (a.env.MaxY == b.env.MaxY && a.env.MinY < b.env.MinY)
+		}},
+	}
+	sorted := func(less func(a, b *entry) bool) []*entry {
+		entries := append([]*entry{}, n.entries...)
+		sort.SliceStable(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+		return entries
+	}
+
+	bestAxis, bestMargin := 0, math.Inf(1)
+	for axis := 0; axis < 2; axis++ {
+		margin := 0.0
+		for _, less := range sorts[axis] {
+			t.distributions(sorted(less), func(k int, first, second *envelope.Envelope) {
+				margin += first.Width() + first.Height() + second.Width() + second.Height()
+			})
+		}
+		if margin < bestMargin {
+			bestAxis, bestMargin = axis, margin
+		}
+	}
+
+	var best []*entry
+	bestK, bestOverlap, bestArea := 0, math.Inf(1), math.Inf(1)
+	for _, less := range sorts[bestAxis] {
+		entries := sorted(less)
+		t.distributions(entries, func(k int, first, second *envelope.Envelope) {
+			overlap, area := overlapArea(first, second), first.Area()+second.Area()
+			if overlap < bestOverlap || (overlap == bestOverlap && area < bestArea) {
+				best, bestK, bestOverlap, bestArea = entries, k, overlap, area
+			}
+		})
+	}
+
+	n.entries = nil
+	sibling := &node{parent: n.parent, level: n.level}
+	for i, e := range best {
+		if i < bestK {
+			n.add(e)
+		} else {
+			sibling.add(e)
+		}
+	}
+	return sibling
+}
+
+// distributions calls f for each split of the entries in two groups of at least minEntries,
+// the first k entries and the rest, with the envelopes of the groups.
+func (t *RTree) distributions(entries []*entry, f func(k int, first, second *envelope.Envelope)) {
+	count := len(entries)
+	prefix := make([]*envelope.Envelope, count+1)
+	suffix := make([]*envelope.Envelope, count+1)
+	prefix[0], suffix[count] = envelope.Empty(), envelope.Empty()
+	for i, e := range entries {
+		prefix[i+1] = envelope.Env(prefix[i])
+		prefix[i+1].ExpandToIncludeEnv(e.env)
+	}
+	for i := count - 1; i >= 0; i-- {
+		suffix[i] = envelope.Env(suffix[i+1])
+		suffix[i].ExpandToIncludeEnv(entries[i].env)
+	}
+	for k := t.minEntries; k <= count-t.minEntries; k++ {
+		f(k, prefix[k], suffix[k])
+	}
+}
+
+// findLeaf returns the leaf holding the item with an envelope intersecting env, and its index in the leaf.
+func (t *RTree) findLeaf(n *node, env *envelope.Envelope, item interface{}) (*node, int) {
+	for i, e := range n.entries {
+		if !e.env.IsIntersects(env) {
+			continue
+		}
+		if n.level == 0 {
+			if reflect.DeepEqual(e.item, item) {
+				return n, i
+			}
+			continue
+		}
+		if leaf, j := t.findLeaf(e.child, env, item); leaf != nil {
+			return leaf, j
+		}
+	}
+	return nil, -1
+}
+
+// condense removes the underfull nodes from the leaf up after a deletion, reinserting their entries,
+// and shortens the tree if the root is left with a single child.
+func (t *RTree) condense(leaf *node) {
+	type orphan struct {
+		e     *entry
+		level int
+	}
+	orphans := []orphan{}
+	for n := leaf; n != t.root; n = n.parent {
+		parent := n.parent
+		if len(n.entries) < t.minEntries {
+			for i, e := range parent.entries {
+				if e.child == n {
+					parent.entries = append(parent.entries[:i], parent.entries[i+1:]...)
+					break
+				}
+			}
+			for _, e := range n.entries {
+				orphans = append(orphans, orphan{e, n.level})
+			}
+		} else {
+			n.parentEntry().env = n.bound()
+		}
+	}
+	if len(t.root.entries) == 0 {
+		t.root = &node{}
+	}
+	for _, v := range orphans {
+		if v.level > t.root.level {
+			for _, e := range leafEntries(v.e.child) {
+				t.insert(e, 0, map[int]bool{})
+			}
+			continue
+		}
+		t.insert(v.e, v.level, map[int]bool{})
+	}
+	for t.root.level > 0 && len(t.root.entries) == 1 {
+		t.root = t.root.entries[0].child
+		t.root.parent = nil
+	}
+}
+
+// leafEntries returns the entries of the leaves of the subtree.
+func leafEntries(n *node) []*entry {
+	if n.level == 0 {
+		return n.entries
+	}
+	entries := []*entry{}
+	for _, e := range n.entries {
+		entries = append(entries, leafEntries(e.child)...)
+	}
+	return entries
+}
+
+// updateBounds updates the envelopes of the entries of the ancestors of the node.
+func (t *RTree) updateBounds(n *node) {
+	for ; n.parent != nil; n = n.parent {
+		n.parentEntry().env = n.bound()
+	}
+}
+
+// pack groups the entries in nodes at the level by Sort-Tile-Recursive,
+// the entries are sorted by the X ordinate of their centre into vertical slices,
+// then each slice by the Y ordinate into nodes.
+func (t *RTree) pack(entries []*entry, level int) []*node {
+	nodeCount := int(math.Ceil(float64(len(entries)) / float64(t.maxEntries)))
+	sliceCount := int(math.Ceil(math.Sqrt(float64(nodeCount))))
+	sliceSize := sliceCount * t.maxEntries
+	centre := func(e *entry, i int) float64 {
+		if i == 0 {
+			return (e.env.MinX + e.env.MaxX) / 2
+		}
+		return (e.env.MinY + e.env.MaxY) / 2
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return centre(entries[i], 0) < centre(entries[j], 0) })
+	nodes := []*node{}
+	for start := 0; start < len(entries); start += sliceSize {
+		slice := entries[start:int(math.Min(float64(start+sliceSize), float64(len(entries))))]
+		sort.SliceStable(slice, func(i, j int) bool { return centre(slice[i], 1) < centre(slice[j], 1) })
+		for i := 0; i < len(slice); i += t.maxEntries {
+			n := &node{level: level}
+			for _, e := range slice[i:int(math.Min(float64(i+t.maxEntries), float64(len(slice))))] {
+				n.add(e)
+			}
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// add adds the entry to the node.
+func (n *node) add(e *entry) {
+	if e.child != nil {
+		e.child.parent = n
+	}
+	n.entries = append(n.entries, e)
+}
+
+// bound returns the envelope of the entries of the node.
+func (n *node) bound() *envelope.Envelope {
+	env := envelope.Empty()
+	for _, e := range n.entries {
+		env.ExpandToIncludeEnv(e.env)
+	}
+	return env
+}
+
+// parentEntry returns the entry of the parent holding the node.
+func (n *node) parentEntry() *entry {
+	for _, e := range n.parent.entries {
+		if e.child == n {
+			return e
+		}
+	}
+	return nil
+}
+
+// overlapArea returns the area of the intersection of two envelopes.
+func overlapArea(a, b *envelope.Envelope) float64 {
+	w := math.Min(a.MaxX, b.MaxX) - math.Max(a.MinX, b.MinX)
+	h := math.Min(a.MaxY, b.MaxY) - math.Max(a.MinY, b.MinY)
+	if w <= 0 || h <= 0 {
+		return 0
+	}
+	return w * h
+}
+
+var (
+	_ index.SpatialIndex = &RTree{}
+	_ index.NearestIndex = &RTree{}
+)
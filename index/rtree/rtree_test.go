@@ -0,0 +1,232 @@
+package rtree
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+)
+
+func randomItems(r *rand.Rand, n int) []*Item {
+	items := make([]*Item, n)
+	for i := range items {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		items[i] = &Item{envelope.FourFloat(x, x+r.Float64()*10, y, y+r.Float64()*10), i}
+	}
+	return items
+}
+
+// checkTree checks the envelopes, levels, parents and fill of the nodes of the tree.
+func checkTree(t *testing.T, tree *RTree, minFill bool) int {
+	var check func(n *node) int
+	check = func(n *node) int {
+		if n != tree.root && len(n.entries) > tree.maxEntries {
+			t.Errorf("node at level %v has %v entries", n.level, len(n.entries))
+		}
+		if minFill && n != tree.root && len(n.entries) < tree.minEntries {
+			t.Errorf("node at level %v has %v entries", n.level, len(n.entries))
+		}
+		if n.level == 0 {
+			return len(n.entries)
+		}
+		count := 0
+		for _, e := range n.entries {
+			if e.child.parent != n || e.child.level != n.level-1 {
+				t.Errorf("child of node at level %v is at level %v", n.level, e.child.level)
+			}
+			if !e.env.Equals(e.child.bound()) {
+				t.Errorf("entry envelope %v, want %v", e.env, e.child.bound())
+			}
+			count += check(e.child)
+		}
+		return count
+	}
+	return check(tree.root)
+}
+
+func queryIDs(tree *RTree, env *envelope.Envelope) []int {
+	ids := []int{}
+	for _, v := range tree.Query(env).([]interface{}) {
+		ids = append(ids, v.(int))
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func bruteIDs(items map[int]*Item, env *envelope.Envelope) []int {
+	ids := []int{}
+	for id, v := range items {
+		if v.Env.IsIntersects(env) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRTree_InsertRemove(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	tree := NewRTreeWithCapacity(8)
+	items := map[int]*Item{}
+	for _, v := range randomItems(r, 2000) {
+		_ = tree.Insert(v.Env, v.Item)
+		items[v.Item.(int)] = v
+	}
+	if got := checkTree(t, tree, true); got != 2000 || tree.Size() != 2000 {
+		t.Errorf("RTree holds %v items, size %v, want %v", got, tree.Size(), 2000)
+	}
+	for i := 0; i < 1500; i += 2 {
+		if !tree.Remove(items[i].Env, i) {
+			t.Errorf("RTree.Remove(%v) = false", i)
+		}
+		delete(items, i)
+	}
+	if tree.Remove(envelope.FourFloat(0, 1000, 0, 1000), 0) {
+		t.Errorf("RTree.Remove() of a removed item = true")
+	}
+	if got := checkTree(t, tree, true); got != len(items) || tree.Size() != len(items) {
+		t.Errorf("RTree holds %v items, size %v, want %v", got, tree.Size(), len(items))
+	}
+	for i := 0; i < 50; i++ {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		env := envelope.FourFloat(x, x+50, y, y+50)
+		if got, want := queryIDs(tree, env), bruteIDs(items, env); !equalIDs(got, want) {
+			t.Errorf("RTree.Query() = %v, want %v", got, want)
+		}
+	}
+	for id, v := range items {
+		tree.Remove(v.Env, id)
+	}
+	if !tree.IsEmpty() || tree.Depth() != 1 {
+		t.Errorf("RTree after removing all items: size %v depth %v", tree.Size(), tree.Depth())
+	}
+}
+
+func TestRTree_Update(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	tree := NewRTree()
+	items := map[int]*Item{}
+	for _, v := range randomItems(r, 500) {
+		_ = tree.Insert(v.Env, v.Item)
+		items[v.Item.(int)] = v
+	}
+	for i := 0; i < 500; i++ {
+		x, y := r.Float64()*1000, r.Float64()*1000
+		env := envelope.FourFloat(x, x+1, y, y+1)
+		if !tree.Update(items[i].Env, env, i) {
+			t.Errorf("RTree.Update(%v) = false", i)
+		}
+		items[i] = &Item{env, i}
+	}
+	if tree.Update(envelope.FourFloat(0, 1, 0, 1), envelope.FourFloat(0, 1, 0, 1), 1000) {
+		t.Errorf("RTree.Update() of a missing item = true")
+	}
+	if got := checkTree(t, tree, true); got != 500 {
+		t.Errorf("RTree holds %v items, want %v", got, 500)
+	}
+	env := envelope.FourFloat(200, 600, 300, 700)
+	if got, want := queryIDs(tree, env), bruteIDs(items, env); !equalIDs(got, want) {
+		t.Errorf("RTree.Query() = %v, want %v", got, want)
+	}
+}
+
+func TestRTree_Load(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	loaded := randomItems(r, 3000)
+	items := map[int]*Item{}
+	for _, v := range loaded {
+		items[v.Item.(int)] = v
+	}
+	tree := NewRTree()
+	if err := tree.Load(loaded); err != nil {
+		t.Fatal(err)
+	}
+	if got := checkTree(t, tree, false); got != 3000 || tree.Size() != 3000 {
+		t.Errorf("RTree holds %v items, size %v, want %v", got, tree.Size(), 3000)
+	}
+	if tree.Depth() != 3 {
+		t.Errorf("RTree.Depth() = %v, want %v", tree.Depth(), 3)
+	}
+	more := randomItems(r, 10)
+	for i, v := range more {
+		v.Item = 3000 + i
+		items[3000+i] = v
+	}
+	if err := tree.Load(more); err != nil {
+		t.Fatal(err)
+	}
+	env := envelope.FourFloat(100, 400, 100, 400)
+	if got, want := queryIDs(tree, env), bruteIDs(items, env); !equalIDs(got, want) {
+		t.Errorf("RTree.Query() = %v, want %v", got, want)
+	}
+	if err := tree.Load([]*Item{{nil, 0}}); err != index.ErrNotMatchType {
+		t.Errorf("RTree.Load() error = %v, want %v", err, index.ErrNotMatchType)
+	}
+}
+
+func TestRTree_Nearest(t *testing.T) {
+	tree := NewRTreeWithCapacity(4)
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			p := matrix.Matrix{float64(i), float64(j)}
+			_ = tree.Insert(envelope.Matrix(p), p)
+		}
+	}
+	got := tree.Nearest(matrix.Matrix{5.1, 5.2}, 3, nil)
+	want := []matrix.Matrix{{5, 5}, {5, 6}, {6, 5}}
+	for i, v := range got {
+		if !v.Item.(matrix.Matrix).Equals(want[i]) {
+			t.Errorf("RTree.Nearest() %v = %v, want %v", i, v.Item, want[i])
+		}
+	}
+	if within := tree.NearestWithin(matrix.Matrix{0, 0}, 1, nil); len(within) != 3 {
+		t.Errorf("RTree.NearestWithin() = %v, want 3 items", within)
+	}
+}
+
+func TestRTree_Concurrent(t *testing.T) {
+	tree := NewRTree()
+	items := randomItems(rand.New(rand.NewSource(1)), 1000)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, v := range items {
+			_ = tree.Insert(v.Env, v.Item)
+		}
+		for _, v := range items[:500] {
+			tree.Remove(v.Env, v.Item)
+		}
+	}()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				tree.Query(envelope.FourFloat(0, 500, 0, 500))
+				tree.Nearest(matrix.Matrix{500, 500}, 5, nil)
+			}
+		}()
+	}
+	wg.Wait()
+	if tree.Size() != 500 {
+		t.Errorf("RTree.Size() = %v, want %v", tree.Size(), 500)
+	}
+	checkTree(t, tree, true)
+}
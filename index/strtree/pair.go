@@ -0,0 +1,221 @@
+package strtree
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/index"
+)
+
+// Pair a pair of items, one of each tree, with their distance.
+type Pair struct {
+	Item1, Item2 interface{}
+	Distance     float64
+}
+
+// NearestPair returns the pair of items of the tree and of the other tree with the least distance,
+// or nil if a tree is empty. If other is the tree itself, an item is not paired with itself.
+// A nil distance measures planar distance.
+//
+// Items are measured as geometries if they are a matrix.Steric, a *matrix.LineSegment or have a ToMatrix method,
+// otherwise by their envelope.
+func (t *STRtree) NearestPair(other *STRtree, distance measure.DistanceFunc) *Pair {
+	return t.nearestPair(other, math.Inf(1), distance)
+}
+
+// IsWithinDistance tests whether some item of the tree and some item of the other tree
+// are within maxDist of each other. The search stops at the first such pair.
+// A nil distance measures planar distance.
+func (t *STRtree) IsWithinDistance(other *STRtree, maxDist float64, distance measure.DistanceFunc) bool {
+	return t.nearestPair(other, maxDist, distance) != nil
+}
+
+// Join calls visit for each pair of items of the tree and of the other tree whose envelopes intersect,
+// the candidates of a spatial join. If other is the tree itself,
+// each pair of distinct items is visited once.
+func (t *STRtree) Join(other *STRtree, visit func(item1, item2 interface{})) {
+	t.Build()
+	other.Build()
+	if t.root == nil || other.root == nil {
+		return
+	}
+	if t == other {
+		joinSelf(t.root, visit)
+		return
+	}
+	join(t.root, other.root, visit)
+}
+
+// join visits the pairs of items of two subtrees whose envelopes intersect.
+func join(a, b *node, visit func(item1, item2 interface{})) {
+	if !a.env.IsIntersects(b.env) {
+		return
+	}
+	switch {
+	case a.level == 0 && b.level == 0:
+		for _, u := range a.items {
+			for _, v := range b.items {
+				if u.Env.IsIntersects(v.Env) {
+					visit(u.Item, v.Item)
+				}
+			}
+		}
+	case a.level >= b.level:
+		for _, child := range a.children {
+			join(child, b, visit)
+		}
+	default:
+		for _, child := range b.children {
+			join(a, child, visit)
+		}
+	}
+}
+
+// joinSelf visits the pairs of distinct items of a subtree whose envelopes intersect, each pair once.
+func joinSelf(n *node, visit func(item1, item2 interface{})) {
+	if n.level == 0 {
+		for i, u := range n.items {
+			for _, v := range n.items[i+1:] {
+				if u.Env.IsIntersects(v.Env) {
+					visit(u.Item, v.Item)
+				}
+			}
+		}
+		return
+	}
+	for i, child := range n.children {
+		joinSelf(child, visit)
+		for _, other := range n.children[i+1:] {
+			join(child, other, visit)
+		}
+	}
+}
+
+// nearestPair returns the nearest pair of items within maxDist, or nil if there is none.
+// Pairs of nodes are queued by the distance between their envelopes, which bounds the distance of their items,
+// and the larger node of the pair at the head of the queue is expanded,
+// until a pair of items reaches the head of the queue.
+func (t *STRtree) nearestPair(other *STRtree, maxDist float64, distance measure.DistanceFunc) *Pair {
+	t.Build()
+	other.Build()
+	if t.root == nil || other.root == nil {
+		return nil
+	}
+	if distance == nil {
+		distance = measure.PlanarDistance
+	}
+	queue := &pairQueue{}
+	seq := 0
+	push := func(a, b boundable) {
+		if a.item != nil && a.item == b.item {
+			return
+		}
+		d := a.distance(b, distance)
+		if d > maxDist {
+			return
+		}
+		heap.Push(queue, &pairEntry{a, b, d, seq})
+		seq++
+	}
+	push(boundable{env: t.root.env, node: t.root}, boundable{env: other.root.env, node: other.root})
+	for queue.Len() > 0 {
+		p := heap.Pop(queue).(*pairEntry)
+		if p.a.item != nil && p.b.item != nil {
+			return &Pair{p.a.item.Item, p.b.item.Item, p.distance}
+		}
+		if p.b.item != nil || (p.a.node != nil && p.a.env.Area() >= p.b.env.Area()) {
+			for _, v := range p.a.node.boundables() {
+				push(v, p.b)
+			}
+		} else {
+			for _, v := range p.b.node.boundables() {
+				push(p.a, v)
+			}
+		}
+	}
+	return nil
+}
+
+// boundable a node or an item of a tree.
+type boundable struct {
+	env  *envelope.Envelope
+	node *node
+	item *Item
+}
+
+// boundables returns the children or the items of the node.
+func (n *node) boundables() []boundable {
+	result := make([]boundable, 0, len(n.children)+len(n.items))
+	for _, v := range n.children {
+		result = append(result, boundable{env: v.env, node: v})
+	}
+	for _, v := range n.items {
+		result = append(result, boundable{env: v.Env, item: v})
+	}
+	return result
+}
+
+// distance returns the distance between two items, or a lower bound of the distance
+// between their items if one is a node.
+func (b boundable) distance(other boundable, distance measure.DistanceFunc) float64 {
+	if b.item != nil && other.item != nil {
+		return distance(b.steric(), other.steric())
+	}
+	if b.env.IsIntersects(other.env) {
+		return 0
+	}
+	return distance(index.EnvelopeSteric(b.env), index.EnvelopeSteric(other.env))
+}
+
+// steric returns the geometry of an item, or its envelope if it is not a geometry.
+func (b boundable) steric() matrix.Steric {
+	if steric := index.ItemSteric(b.item.Item); steric != nil {
+		return steric
+	}
+	return index.EnvelopeSteric(b.env)
+}
+
+// pairEntry a pair in the queue of a nearest pair search.
+type pairEntry struct {
+	a, b     boundable
+	distance float64
+	seq      int
+}
+
+// pairQueue a priority queue of pairs ordered by distance, pairs of items first and then in order of insertion.
+type pairQueue []*pairEntry
+
+// Len ...
+func (q pairQueue) Len() int { return len(q) }
+
+// Less ...
+func (q pairQueue) Less(i, j int) bool {
+	if q[i].distance != q[j].distance {
+		return q[i].distance < q[j].distance
+	}
+	if iItems, jItems := q[i].isItems(), q[j].isItems(); iItems != jItems {
+		return iItems
+	}
+	return q[i].seq < q[j].seq
+}
+
+// Swap ...
+func (q pairQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push ...
+func (q *pairQueue) Push(x interface{}) { *q = append(*q, x.(*pairEntry)) }
+
+// Pop ...
+func (q *pairQueue) Pop() interface{} {
+	old := *q
+	entry := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return entry
+}
+
+func (p *pairEntry) isItems() bool {
+	return p.a.item != nil && p.b.item != nil
+}
@@ -0,0 +1,287 @@
+// Package strtree a strtree is a static spatial index structure.
+// This is a R-tree packed by the Sort-Tile-Recursive algorithm, for layers queried often and rarely changed.
+package strtree
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/index"
+)
+
+// STRtree const parameter.
+const (
+	DefaultNodeCapacity = 10
+)
+
+// Item an item of the tree with its envelope.
+type Item struct {
+	Env  *envelope.Envelope
+	Item interface{}
+}
+
+// STRtree A query-only R-tree created using the Sort-Tile-Recursive (STR) algorithm.
+// The items are sorted by the X ordinate of their centre into vertical slices,
+// each slice by the Y ordinate into nodes, and the nodes are packed the same way up to the root.
+//
+// Items are inserted before the tree is built, the tree is built by the first query
+// and no more items can be inserted after that. Items may be removed at any time.
+// Queries are safe for concurrent use, but not together with Remove.
+type STRtree struct {
+	mu           sync.Mutex
+	nodeCapacity int
+	items        []*Item
+	root         *node
+	isBuilt      bool
+}
+
+// node a node of the tree, leaves are at level 0 and hold the items.
+type node struct {
+	env      *envelope.Envelope
+	level    int
+	children []*node
+	items    []*Item
+}
+
+// NewSTRtree return a STRtree with the default node capacity.
+func NewSTRtree() *STRtree {
+	return NewSTRtreeWithCapacity(DefaultNodeCapacity)
+}
+
+// NewSTRtreeWithCapacity return a STRtree whose nodes hold at most nodeCapacity children, at least 2.
+func NewSTRtreeWithCapacity(nodeCapacity int) *STRtree {
+	if nodeCapacity < 2 {
+		nodeCapacity = 2
+	}
+	return &STRtree{nodeCapacity: nodeCapacity}
+}
+
+// NodeCapacity Returns the maximum number of children of a node.
+func (t *STRtree) NodeCapacity() int {
+	return t.nodeCapacity
+}
+
+// Size Gets the number of items in the index.
+func (t *STRtree) Size() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.items)
+}
+
+// IsEmpty Tests whether the index contains any items.
+func (t *STRtree) IsEmpty() bool {
+	return t.Size() == 0
+}
+
+// Depth Returns the number of levels in the tree, 0 if it is empty.
+func (t *STRtree) Depth() int {
+	t.Build()
+	if t.root == nil {
+		return 0
+	}
+	return t.root.level + 1
+}
+
+// Insert Adds a spatial item with an extent specified by the given Envelope to the index
+func (t *STRtree) Insert(itemEnv *envelope.Envelope, item interface{}) error {
+	if itemEnv == nil || itemEnv.IsNil() {
+		return index.ErrNotMatchType
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isBuilt {
+		return index.ErrRTreeQueried
+	}
+	t.items = append(t.items, &Item{envelope.Env(itemEnv), item})
+	return nil
+}
+
+// Build Creates the tree from the inserted items, if not already built.
+// The tree is built by the first query, building it in advance avoids the cost in the query.
+func (t *STRtree) Build() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isBuilt {
+		return
+	}
+	t.isBuilt = true
+	if len(t.items) == 0 {
+		return
+	}
+	leaves := make([]*node, len(t.items))
+	for i, v := range t.items {
+		leaves[i] = &node{env: v.Env, items: []*Item{v}}
+	}
+	nodes := t.pack(leaves, 0)
+	for level := 1; len(nodes) > 1; level++ {
+		nodes = t.pack(nodes, level)
+	}
+	t.root = nodes[0]
+}
+
+// pack groups the nodes in parents at the level by Sort-Tile-Recursive.
+// At level 0 the nodes stand for the items, and the leaves hold their items.
+func (t *STRtree) pack(nodes []*node, level int) []*node {
+	nodeCount := int(math.Ceil(float64(len(nodes)) / float64(t.nodeCapacity)))
+	sliceCount := int(math.Ceil(math.Sqrt(float64(nodeCount))))
+	sliceSize := sliceCount * t.nodeCapacity
+	centre := func(n *node, i int) float64 {
+		if i == 0 {
+			return (n.env.MinX + n.env.MaxX) / 2
+		}
+		return (n.env.MinY + n.env.MaxY) / 2
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return centre(nodes[i], 0) < centre(nodes[j], 0) })
+	parents := []*node{}
+	for start := 0; start < len(nodes); start += sliceSize {
+		slice := nodes[start:minInt(start+sliceSize, len(nodes))]
+		sort.SliceStable(slice, func(i, j int) bool { return centre(slice[i], 1) < centre(slice[j], 1) })
+		for i := 0; i < len(slice); i += t.nodeCapacity {
+			parent := &node{env: envelope.Empty(), level: level}
+			for _, v := range slice[i:minInt(i+t.nodeCapacity, len(slice))] {
+				parent.env.ExpandToIncludeEnv(v.env)
+				if level == 0 {
+					parent.items = append(parent.items, v.items...)
+				} else {
+					parent.children = append(parent.children, v)
+				}
+			}
+			parents = append(parents, parent)
+		}
+	}
+	return parents
+}
+
+// Query Queries the index for all items whose extents intersect the given search  Envelope
+func (t *STRtree) Query(searchEnv *envelope.Envelope) interface{} {
+	visitor := &index.ArrayVisitor{ItemsArray: []interface{}{}}
+	_ = t.QueryVisitor(searchEnv, visitor)
+	return visitor.Items()
+}
+
+// QueryVisitor Queries the index for all items whose extents intersect the given search Envelope,
+// and applies an  ItemVisitor to them.
+func (t *STRtree) QueryVisitor(searchEnv *envelope.Envelope, visitor index.ItemVisitor) error {
+	t.Build()
+	if t.root == nil || !t.root.env.IsIntersects(searchEnv) {
+		return nil
+	}
+	t.query(t.root, searchEnv, visitor)
+	return nil
+}
+
+func (t *STRtree) query(n *node, searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
+	if n.level == 0 {
+		for _, v := range n.items {
+			if index.IsDone(visitor) {
+				return
+			}
+			if v.Env.IsIntersects(searchEnv) {
+				visitor.VisitItem(v.Item)
+			}
+		}
+		return
+	}
+	for _, child := range n.children {
+		if index.IsDone(visitor) {
+			return
+		}
+		if child.env.IsIntersects(searchEnv) {
+			t.query(child, searchEnv, visitor)
+		}
+	}
+}
+
+// Remove Removes a single item from the tree.
+// The envelopes of the nodes are left unchanged, so they may be larger than their items.
+func (t *STRtree) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	removed := (*Item)(nil)
+	for i, v := range t.items {
+		if v.Env.IsIntersects(itemEnv) && reflect.DeepEqual(v.Item, item) {
+			removed = v
+			t.items = append(t.items[:i], t.items[i+1:]...)
+			break
+		}
+	}
+	if removed == nil {
+		return false
+	}
+	if t.root != nil {
+		removeItem(t.root, removed)
+	}
+	return true
+}
+
+// removeItem removes the item from the leaf of the subtree holding it.
+func removeItem(n *node, item *Item) bool {
+	if !n.env.IsIntersects(item.Env) {
+		return false
+	}
+	if n.level == 0 {
+		for i, v := range n.items {
+			if v == item {
+				n.items = append(n.items[:i], n.items[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+	for _, child := range n.children {
+		if removeItem(child, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Nearest returns the k items nearest to the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (t *STRtree) Nearest(geom matrix.Steric, k int, distance measure.DistanceFunc) []index.Neighbour {
+	if k <= 0 {
+		return []index.Neighbour{}
+	}
+	return t.nearest(geom, k, math.Inf(1), distance)
+}
+
+// NearestWithin returns the items within maxDist of the geometry, ordered by distance.
+// A nil distance measures planar distance.
+func (t *STRtree) NearestWithin(geom matrix.Steric, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	return t.nearest(geom, 0, maxDist, distance)
+}
+
+func (t *STRtree) nearest(geom matrix.Steric, k int, maxDist float64, distance measure.DistanceFunc) []index.Neighbour {
+	t.Build()
+	if t.root == nil {
+		return []index.Neighbour{}
+	}
+	search := index.NewNearestSearch(geom, distance)
+	search.PushNode(t.root.env, t.root)
+	return search.Search(k, maxDist, func(v interface{}) {
+		n := v.(*node)
+		for _, item := range n.items {
+			search.PushItem(item.Env, item.Item)
+		}
+		for _, child := range n.children {
+			search.PushNode(child.env, child)
+		}
+	})
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+var (
+	_ index.SpatialIndex = &STRtree{}
+	_ index.NearestIndex = &STRtree{}
+)
@@ -0,0 +1,227 @@
+package strtree
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/index"
+)
+
+func randomPoints(r *rand.Rand, n int, offset float64) []matrix.Matrix {
+	points := make([]matrix.Matrix, n)
+	for i := range points {
+		points[i] = matrix.Matrix{offset + r.Float64()*100, r.Float64() * 100}
+	}
+	return points
+}
+
+func pointTree(points []matrix.Matrix, nodeCapacity int) *STRtree {
+	tree := NewSTRtreeWithCapacity(nodeCapacity)
+	for _, p := range points {
+		_ = tree.Insert(envelope.Matrix(p), p)
+	}
+	return tree
+}
+
+func TestSTRtree_Query(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	tests := []struct {
+		name         string
+		size         int
+		nodeCapacity int
+		wantDepth    int
+	}{
+		{"empty", 0, 10, 0},
+		{"one", 1, 10, 1},
+		{"leaf", 10, 10, 1},
+		{"capacity 10", 1000, 10, 3},
+		{"capacity 4", 1000, 4, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := randomPoints(r, tt.size, 0)
+			tree := pointTree(points, tt.nodeCapacity)
+			if got := tree.Depth(); got != tt.wantDepth {
+				t.Errorf("STRtree.Depth() = %v, want %v", got, tt.wantDepth)
+			}
+			if err := tree.Insert(envelope.Matrix(matrix.Matrix{0, 0}), 0); err != index.ErrRTreeQueried {
+				t.Errorf("STRtree.Insert() error = %v, want %v", err, index.ErrRTreeQueried)
+			}
+			for i := 0; i < 20; i++ {
+				x, y := r.Float64()*100, r.Float64()*100
+				env := envelope.FourFloat(x, x+20, y, y+20)
+				want := 0
+				for _, p := range points {
+					if env.IsIntersects(envelope.Matrix(p)) {
+						want++
+					}
+				}
+				if got := tree.Query(env).([]interface{}); len(got) != want {
+					t.Errorf("STRtree.Query() = %v items, want %v", len(got), want)
+				}
+			}
+		})
+	}
+}
+
+func TestSTRtree_Remove(t *testing.T) {
+	points := randomPoints(rand.New(rand.NewSource(2)), 100, 0)
+	tree := pointTree(points, 4)
+	if !tree.Remove(envelope.Matrix(points[0]), points[0]) {
+		t.Errorf("STRtree.Remove() = false")
+	}
+	tree.Build()
+	if !tree.Remove(envelope.Matrix(points[1]), points[1]) {
+		t.Errorf("STRtree.Remove() = false")
+	}
+	if tree.Remove(envelope.Matrix(points[1]), points[1]) {
+		t.Errorf("STRtree.Remove() of a removed item = true")
+	}
+	if tree.Size() != 98 {
+		t.Errorf("STRtree.Size() = %v, want %v", tree.Size(), 98)
+	}
+	if got := tree.Query(envelope.FourFloat(0, 100, 0, 100)).([]interface{}); len(got) != 98 {
+		t.Errorf("STRtree.Query() = %v items, want %v", len(got), 98)
+	}
+	nearest := tree.Nearest(points[1], 1, nil)
+	if nearest[0].Item.(matrix.Matrix).Equals(points[1]) {
+		t.Errorf("STRtree.Nearest() = %v, a removed item", nearest[0].Item)
+	}
+}
+
+func TestSTRtree_NearestPair(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	tests := []struct {
+		name     string
+		offset   float64
+		distance measure.DistanceFunc
+	}{
+		{"overlapping", 50, nil},
+		{"disjoint", 150, nil},
+		{"spheroid", 0, measure.SpheroidDistance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance := tt.distance
+			if distance == nil {
+				distance = measure.PlanarDistance
+			}
+			scale := func(points []matrix.Matrix) []matrix.Matrix {
+				if tt.distance != nil {
+					for _, p := range points {
+						p[0], p[1] = p[0]/10+100, p[1]/10
+					}
+				}
+				return points
+			}
+			points1, points2 := scale(randomPoints(r, 300, 0)), scale(randomPoints(r, 200, tt.offset))
+			want := math.Inf(1)
+			for _, p := range points1 {
+				for _, q := range points2 {
+					want = math.Min(want, distance(p, q))
+				}
+			}
+			tree1, tree2 := pointTree(points1, 6), pointTree(points2, 6)
+			got := tree1.NearestPair(tree2, tt.distance)
+			if got == nil || math.Abs(got.Distance-want) > 1e-9 {
+				t.Fatalf("STRtree.NearestPair() = %v, want distance %v", got, want)
+			}
+			if d := distance(got.Item1.(matrix.Matrix), got.Item2.(matrix.Matrix)); d != got.Distance {
+				t.Errorf("STRtree.NearestPair() distance = %v, items at %v", got.Distance, d)
+			}
+			if !tree1.IsWithinDistance(tree2, want, tt.distance) {
+				t.Errorf("STRtree.IsWithinDistance(%v) = false", want)
+			}
+			if tree1.IsWithinDistance(tree2, want*0.99, tt.distance) {
+				t.Errorf("STRtree.IsWithinDistance(%v) = true", want*0.99)
+			}
+		})
+	}
+
+	points := []matrix.Matrix{{0, 0}, {10, 0}, {10, 3}, {20, 20}}
+	tree := pointTree(points, 2)
+	if got := tree.NearestPair(tree, nil); got == nil || got.Distance != 3 {
+		t.Errorf("STRtree.NearestPair() itself = %v, want distance 3", got)
+	}
+	if got := tree.NearestPair(NewSTRtree(), nil); got != nil {
+		t.Errorf("STRtree.NearestPair() empty = %v, want nil", got)
+	}
+}
+
+func TestSTRtree_Join(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	boxes := func(n int) []*Item {
+		items := make([]*Item, n)
+		for i := range items {
+			x, y := r.Float64()*100, r.Float64()*100
+			items[i] = &Item{envelope.FourFloat(x, x+r.Float64()*5, y, y+r.Float64()*5), i}
+		}
+		return items
+	}
+	tree := func(items []*Item) *STRtree {
+		tree := NewSTRtreeWithCapacity(4)
+		for _, v := range items {
+			_ = tree.Insert(v.Env, v.Item)
+		}
+		return tree
+	}
+	key := func(a, b interface{}) [2]int { return [2]int{a.(int), b.(int)} }
+	sorted := func(pairs [][2]int) [][2]int {
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i][0] < pairs[j][0] || (pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1])
+		})
+		return pairs
+	}
+
+	items1, items2 := boxes(300), boxes(200)
+	want := [][2]int{}
+	for _, u := range items1 {
+		for _, v := range items2 {
+			if u.Env.IsIntersects(v.Env) {
+				want = append(want, key(u.Item, v.Item))
+			}
+		}
+	}
+	got := [][2]int{}
+	tree(items1).Join(tree(items2), func(a, b interface{}) { got = append(got, key(a, b)) })
+	if g, w := sorted(got), sorted(want); len(g) != len(w) {
+		t.Errorf("STRtree.Join() = %v pairs, want %v", len(g), len(w))
+	} else {
+		for i := range g {
+			if g[i] != w[i] {
+				t.Errorf("STRtree.Join() pair %v = %v, want %v", i, g[i], w[i])
+			}
+		}
+	}
+
+	want = [][2]int{}
+	for i, u := range items1 {
+		for _, v := range items1[i+1:] {
+			if u.Env.IsIntersects(v.Env) {
+				want = append(want, key(u.Item, v.Item))
+			}
+		}
+	}
+	got = [][2]int{}
+	self := tree(items1)
+	self.Join(self, func(a, b interface{}) {
+		if a.(int) > b.(int) {
+			a, b = b, a
+		}
+		got = append(got, key(a, b))
+	})
+	if g, w := sorted(got), sorted(want); len(g) != len(w) {
+		t.Errorf("STRtree.Join() itself = %v pairs, want %v", len(g), len(w))
+	} else {
+		for i := range g {
+			if g[i] != w[i] {
+				t.Errorf("STRtree.Join() itself pair %v = %v, want %v", i, g[i], w[i])
+			}
+		}
+	}
+}
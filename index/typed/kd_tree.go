@@ -0,0 +1,79 @@
+package typed
+
+import (
+	"reflect"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index/kdtree"
+)
+
+// KdTree a SpatialIndex of items of type T, stored in a kdtree.KdTree.
+// Items are located at the centre of their envelope, the items at the same location share a node
+// which holds them in its Data.
+type KdTree[T any] struct {
+	tree *kdtree.KdTree
+}
+
+// NewKdTree return a KdTree storing the items in a kdtree.KdTree.
+func NewKdTree[T any]() *KdTree[T] {
+	return &KdTree[T]{tree: &kdtree.KdTree{}}
+}
+
+// Tree returns the tree storing the items.
+func (k *KdTree[T]) Tree() *kdtree.KdTree {
+	return k.tree
+}
+
+// Insert Adds a spatial item located at the centre of the given Envelope to the index
+func (k *KdTree[T]) Insert(itemEnv *envelope.Envelope, item T) error {
+	node := k.tree.InsertMatrix(itemEnv.Centre(), nil)
+	items, _ := node.Data.([]T)
+	node.Data = append(items, item)
+	return nil
+}
+
+// Query Queries the index for all items located in the given search  Envelope
+func (k *KdTree[T]) Query(searchEnv *envelope.Envelope) []T {
+	items := []T{}
+	k.Visit(searchEnv, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+// Visit Queries the index for all items located in the given search Envelope,
+// and calls visit for each of them until visit returns false.
+func (k *KdTree[T]) Visit(searchEnv *envelope.Envelope, visit func(item T) bool) {
+	if k.tree.IsEmpty() {
+		return
+	}
+	v := &visitor[*kdtree.KdNode]{}
+	v.visit = func(node *kdtree.KdNode) bool {
+		items, _ := node.Data.([]T)
+		for _, item := range items {
+			if !visit(item) {
+				return false
+			}
+		}
+		return true
+	}
+	_ = k.tree.QueryVisitor(searchEnv, v)
+}
+
+// Remove Removes a single item from the tree.
+// The node of the item stays in the tree, holding no items.
+func (k *KdTree[T]) Remove(itemEnv *envelope.Envelope, item T) bool {
+	node := k.tree.QueryMatrix(itemEnv.Centre())
+	if node == nil {
+		return false
+	}
+	items, _ := node.Data.([]T)
+	for i, v := range items {
+		if reflect.DeepEqual(v, item) {
+			node.Data = append(items[:i], items[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
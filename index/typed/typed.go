@@ -0,0 +1,121 @@
+// Package typed a type safe layer over the spatial indexes, holding items of a single type.
+package typed
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+	"github.com/spatial-go/geoos/index/hprtree"
+	"github.com/spatial-go/geoos/index/quadtree"
+	"github.com/spatial-go/geoos/index/rtree"
+	"github.com/spatial-go/geoos/index/strtree"
+)
+
+// SpatialIndex The basic operations supported by spatial indexes of items of type T.
+type SpatialIndex[T any] interface {
+	// Insert Adds a spatial item with an extent specified by the given Envelope to the index
+	Insert(itemEnv *envelope.Envelope, item T) error
+
+	// Query Queries the index for all items whose extents intersect the given search  Envelope
+	// Note that some kinds of indexes may also return objects which do not in fact
+	// intersect the query envelope.
+	Query(searchEnv *envelope.Envelope) []T
+
+	// Visit Queries the index for all items whose extents intersect the given search Envelope,
+	// and calls visit for each of them until visit returns false.
+	Visit(searchEnv *envelope.Envelope, visit func(item T) bool)
+
+	// Remove Removes a single item from the tree.
+	Remove(itemEnv *envelope.Envelope, item T) bool
+}
+
+// Index a SpatialIndex of items of type T, stored in an index.SpatialIndex.
+type Index[T any] struct {
+	tree index.SpatialIndex
+}
+
+// New return an Index storing the items in the tree.
+// Items of the tree which are not of type T are skipped by queries.
+func New[T any](tree index.SpatialIndex) *Index[T] {
+	return &Index[T]{tree: tree}
+}
+
+// NewQuadtree return an Index stored in a quadtree.Quadtree.
+func NewQuadtree[T any]() *Index[T] {
+	return New[T](quadtree.NewQuadtree())
+}
+
+// NewHPRTree return an Index stored in a hprtree.HPRTree.
+func NewHPRTree[T any]() *Index[T] {
+	return New[T](hprtree.NewHPRTree())
+}
+
+// NewRTree return an Index stored in a rtree.RTree.
+func NewRTree[T any]() *Index[T] {
+	return New[T](rtree.NewRTree())
+}
+
+// NewSTRtree return an Index stored in a strtree.STRtree.
+func NewSTRtree[T any]() *Index[T] {
+	return New[T](strtree.NewSTRtree())
+}
+
+// Tree returns the tree storing the items.
+func (x *Index[T]) Tree() index.SpatialIndex {
+	return x.tree
+}
+
+// Insert Adds a spatial item with an extent specified by the given Envelope to the index
+func (x *Index[T]) Insert(itemEnv *envelope.Envelope, item T) error {
+	return x.tree.Insert(itemEnv, item)
+}
+
+// Query Queries the index for all items whose extents intersect the given search  Envelope
+func (x *Index[T]) Query(searchEnv *envelope.Envelope) []T {
+	items := []T{}
+	x.Visit(searchEnv, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+// Visit Queries the index for all items whose extents intersect the given search Envelope,
+// and calls visit for each of them until visit returns false.
+func (x *Index[T]) Visit(searchEnv *envelope.Envelope, visit func(item T) bool) {
+	_ = x.tree.QueryVisitor(searchEnv, &visitor[T]{visit: visit})
+}
+
+// Remove Removes a single item from the tree.
+func (x *Index[T]) Remove(itemEnv *envelope.Envelope, item T) bool {
+	return x.tree.Remove(itemEnv, item)
+}
+
+// visitor an index.StoppableVisitor calling a function with the items of type T.
+type visitor[T any] struct {
+	visit func(item T) bool
+	done  bool
+}
+
+// VisitItem Visits an item.
+func (v *visitor[T]) VisitItem(item interface{}) {
+	if t, ok := item.(T); ok && !v.done {
+		v.done = !v.visit(t)
+	}
+}
+
+// Items returns items.
+func (v *visitor[T]) Items() interface{} {
+	return nil
+}
+
+// IsDone returns true when no more items need to be visited.
+func (v *visitor[T]) IsDone() bool {
+	return v.done
+}
+
+// compile time checks
+var (
+	_ SpatialIndex[int]      = &Index[int]{}
+	_ SpatialIndex[int]      = &KdTree[int]{}
+	_ index.StoppableVisitor = &visitor[int]{}
+)
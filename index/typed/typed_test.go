@@ -0,0 +1,100 @@
+package typed
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+)
+
+type feature struct {
+	id  int
+	env *envelope.Envelope
+}
+
+func TestSpatialIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		tree func() SpatialIndex[*feature]
+		// exact the index returns only the items intersecting the query envelope
+		exact bool
+		// points the index locates items at the centre of their envelope
+		points bool
+	}{
+		{"Quadtree", func() SpatialIndex[*feature] { return NewQuadtree[*feature]() }, false, false},
+		{"HPRTree", func() SpatialIndex[*feature] { return NewHPRTree[*feature]() }, true, false},
+		{"RTree", func() SpatialIndex[*feature] { return NewRTree[*feature]() }, true, false},
+		{"STRtree", func() SpatialIndex[*feature] { return NewSTRtree[*feature]() }, true, false},
+		{"KdTree", func() SpatialIndex[*feature] { return NewKdTree[*feature]() }, true, true},
+	}
+	r := rand.New(rand.NewSource(4))
+	features := make([]*feature, 500)
+	for i := range features {
+		x, y := r.Float64()*100, r.Float64()*100
+		features[i] = &feature{i, envelope.FourFloat(x, x+r.Float64()*3, y, y+r.Float64()*3)}
+	}
+	searchEnv := envelope.FourFloat(20, 60, 30, 50)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := tt.tree()
+			for _, f := range features {
+				if err := tree.Insert(f.env, f); err != nil {
+					t.Fatal(err)
+				}
+			}
+			matches := func(f *feature) bool {
+				if tt.points {
+					return searchEnv.Contains(envelope.Matrix(f.env.Centre()))
+				}
+				return searchEnv.IsIntersects(f.env)
+			}
+			want := 0
+			for _, f := range features {
+				if matches(f) {
+					want++
+				}
+			}
+			got := tree.Query(searchEnv)
+			found := 0
+			for _, f := range got {
+				if matches(f) {
+					found++
+				}
+			}
+			if found != want || (tt.exact && len(got) != want) {
+				t.Errorf("Query() = %v items, %v matching, want %v", len(got), found, want)
+			}
+
+			visited := 0
+			tree.Visit(searchEnv, func(f *feature) bool {
+				visited++
+				return visited < 3
+			})
+			if visited != 3 {
+				t.Errorf("Visit() visited %v items after stopping at 3", visited)
+			}
+
+			removed := got[0]
+			if !tree.Remove(removed.env, removed) {
+				t.Errorf("Remove() = false")
+			}
+			if tree.Remove(removed.env, removed) {
+				t.Errorf("Remove() of a removed item = true")
+			}
+			for _, f := range tree.Query(searchEnv) {
+				if f == removed {
+					t.Errorf("Query() returns the removed item %v", f.id)
+				}
+			}
+		})
+	}
+}
+
+func TestIndex_foreignItems(t *testing.T) {
+	tree := NewRTree[string]()
+	_ = tree.Insert(envelope.FourFloat(0, 1, 0, 1), "a")
+	_ = tree.Tree().Insert(envelope.FourFloat(0, 1, 0, 1), 1)
+	if got := tree.Query(envelope.FourFloat(0, 1, 0, 1)); len(got) != 1 || got[0] != "a" {
+		t.Errorf("Query() = %v, want [a]", got)
+	}
+}
@@ -2,8 +2,11 @@
 package planar
 
 import (
+	"context"
 	"errors"
 
+	"github.com/spatial-go/geoos/algorithm/affine"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -13,13 +16,25 @@ var ErrNotPolygon = errors.New("Geometry is not polygon")
 // Algorithm is the interface implemented by an object that can implementation
 // spatial algorithm.
 type Algorithm interface {
+	AffineTransform(geom space.Geometry, t affine.AffineTransformation) (space.Geometry, error)
+
 	Area(geom space.Geometry) (float64, error)
 
+	BatchArea(ctx context.Context, geoms []space.Geometry) ([]float64, error)
+
+	BatchBuffer(ctx context.Context, geoms []space.Geometry, width float64, quadsegs int) ([]space.Geometry, error)
+
+	BatchIntersects(ctx context.Context, geoms []space.Geometry, geom space.Geometry) ([]bool, error)
+
 	Boundary(geom space.Geometry) (space.Geometry, error)
 
-	Buffer(geom space.Geometry, width float64, quadsegs int) space.Geometry
+	Buffer(geom space.Geometry, width float64, quadsegs int) (space.Geometry, error)
+
+	BufferContext(ctx context.Context, geom space.Geometry, width float64, quadsegs int) (space.Geometry, error)
 
-	BufferInMeter(geom space.Geometry, width float64, quadsegs int) space.Geometry
+	BufferInMeter(geom space.Geometry, width float64, quadsegs int) (space.Geometry, error)
+
+	CascadedUnion(ctx context.Context, geom space.Geometry) (space.Geometry, error)
 
 	Centroid(geom space.Geometry) (space.Geometry, error)
 
@@ -35,12 +50,34 @@ type Algorithm interface {
 
 	Difference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
+	DifferenceContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error)
+
 	Disjoint(geom1, geom2 space.Geometry) (bool, error)
 
 	Distance(geom1, geom2 space.Geometry) (float64, error)
 
 	SphericalDistance(geom1, geom2 space.Geometry) (float64, error)
 
+	DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
+	SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
+	DFullyWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
+	SphericalDFullyWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
+	ClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error)
+
+	SphericalClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error)
+
+	ShortestLine(geom1, geom2 space.Geometry) (space.LineString, error)
+
+	SphericalShortestLine(geom1, geom2 space.Geometry) (space.LineString, error)
+
+	LongestLine(geom1, geom2 space.Geometry) (space.LineString, error)
+
+	SphericalLongestLine(geom1, geom2 space.Geometry) (space.LineString, error)
+
 	Envelope(geom space.Geometry) (space.Geometry, error)
 
 	Equals(geom1, geom2 space.Geometry) (bool, error)
@@ -51,8 +88,18 @@ type Algorithm interface {
 
 	HausdorffDistanceDensify(s, d space.Geometry, densifyFrac float64) (float64, error)
 
+	FrechetDistance(geom1, geom2 space.Geometry) (float64, error)
+
+	FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error)
+
+	DTWDistance(geom1, geom2 space.Geometry) (float64, error)
+
+	IoU(geom1, geom2 space.Geometry) (float64, error)
+
 	Intersection(geom1, geom2 space.Geometry) (space.Geometry, error)
 
+	IntersectionContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error)
+
 	Intersects(geom1, geom2 space.Geometry) (bool, error)
 
 	IsClosed(geom space.Geometry) (bool, error)
@@ -63,34 +110,56 @@ type Algorithm interface {
 
 	IsSimple(geom space.Geometry) (bool, error)
 
+	LargestEmptyCircle(obstacles, boundary space.Geometry, tolerance float64) (*Circle, error)
+
 	Length(geom space.Geometry) (float64, error)
 
 	LineMerge(geom space.Geometry) (space.Geometry, error)
 
+	MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*Circle, error)
+
 	NGeometry(geom space.Geometry) (int, error)
 
+	Node(geom space.Geometry, gridSize float64) (space.Geometry, error)
+
 	Overlaps(geom1, geom2 space.Geometry) (bool, error)
 
 	PointOnSurface(geom space.Geometry) (space.Geometry, error)
 
+	ReducePrecision(geom space.Geometry, gridSize float64) (space.Geometry, error)
+
 	Relate(s, d space.Geometry) (string, error)
 
+	RelatePattern(s, d space.Geometry, pattern string) (bool, error)
+
+	RelateWithRule(s, d space.Geometry, rule relate.BoundaryNodeRule) (string, error)
+
 	SharedPaths(geom1, geom2 space.Geometry) (string, error)
 
+	SegmentIntersections(geom space.Geometry) ([]SegmentIntersection, error)
+
 	Simplify(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
 	SimplifyP(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
 	Snap(input, reference space.Geometry, tolerance float64) (space.Geometry, error)
 
+	Split(geom, blade space.Geometry) (space.Geometry, error)
+
 	SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
+	SymDifferenceContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error)
+
 	Touches(geom1, geom2 space.Geometry) (bool, error)
 
 	UnaryUnion(geom space.Geometry) (space.Geometry, error)
 
+	UnaryUnionContext(ctx context.Context, geom space.Geometry) (space.Geometry, error)
+
 	Union(geom1, geom2 space.Geometry) (space.Geometry, error)
 
+	UnionContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error)
+
 	UniquePoints(geom space.Geometry) (space.Geometry, error)
 
 	Within(geom1, geom2 space.Geometry) (bool, error)
@@ -0,0 +1,69 @@
+package planar
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// ErrBatchPanic is returned by Batch when f panics on a geometry.
+var ErrBatchPanic = fmt.Errorf("Batch function panicked")
+
+// Batch applies f to each of the geometries on all cores, returning the results in the order of the geometries.
+// It returns the first error of f, ErrBatchPanic if f panics, or ctx.Err() if the context is done before f is applied to all.
+func Batch[T any](ctx context.Context, geoms []space.Geometry, f func(geom space.Geometry) (T, error)) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make([]T, len(geoms))
+	indexes := make(chan int)
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result, err := apply(f, i, geoms[i])
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[i] = result
+			}
+		}()
+	}
+feed:
+	for i := range geoms {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// apply calls f on the i-th geometry, turning a panic of f into an ErrBatchPanic error.
+func apply[T any](f func(geom space.Geometry) (T, error), i int, geom space.Geometry) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: geometry %d: %v", ErrBatchPanic, i, r)
+		}
+	}()
+	return f(geom)
+}
@@ -1,17 +1,22 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/precision"
 	"github.com/spatial-go/geoos/space"
 	"github.com/spatial-go/geoos/space/topograph"
 )
 
 // megrezAlgorithm algorithm implement
 type megrezAlgorithm struct {
-	topog topograph.Relationship
+	topog     topograph.Relationship
+	precision precision.Model
 }
 
 // Equals returns TRUE if the given Geometries are "spatially equal".
 func (g *megrezAlgorithm) Equals(geom1, geom2 space.Geometry) (bool, error) {
+	if geom1 == nil {
+		return geom2 == nil, nil
+	}
 	return geom1.Equals(geom2), nil
 }
 
@@ -27,6 +32,9 @@ func (g *megrezAlgorithm) EqualsExact(geom1, geom2 space.Geometry, tolerance flo
 // IsClosed Returns TRUE if the LINESTRING's start and end points are coincident.
 // For Polyhedral Surfaces, reports if the surface is areal (open) or IsC (closed).
 func (g *megrezAlgorithm) IsClosed(geom space.Geometry) (bool, error) {
+	if err := nonEmpty(geom); err != nil {
+		return false, err
+	}
 	elem := space.GeometryValid{Geometry: geom}
 	return elem.IsClosed(), nil
 }
@@ -39,6 +47,9 @@ func (g *megrezAlgorithm) IsEmpty(geom space.Geometry) (bool, error) {
 
 // IsRing returns true if the lineal geometry has the ring property.
 func (g *megrezAlgorithm) IsRing(geom space.Geometry) (bool, error) {
+	if err := nonEmpty(geom); err != nil {
+		return false, err
+	}
 	elem := space.GeometryValid{Geometry: geom}
 	return elem.IsClosed() && elem.IsSimple(), nil
 }
@@ -0,0 +1,26 @@
+package planar
+
+import (
+	"context"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// BatchBuffer returns the buffers of the geometries as BufferContext, computed on all cores.
+func (g *megrezAlgorithm) BatchBuffer(ctx context.Context, geoms []space.Geometry, width float64, quadsegs int) ([]space.Geometry, error) {
+	return Batch(ctx, geoms, func(geom space.Geometry) (space.Geometry, error) {
+		return g.BufferContext(ctx, geom, width, quadsegs)
+	})
+}
+
+// BatchArea returns the areas of the geometries as Area, computed on all cores.
+func (g *megrezAlgorithm) BatchArea(ctx context.Context, geoms []space.Geometry) ([]float64, error) {
+	return Batch(ctx, geoms, g.Area)
+}
+
+// BatchIntersects returns whether each of the geometries intersects the geometry as Intersects, computed on all cores.
+func (g *megrezAlgorithm) BatchIntersects(ctx context.Context, geoms []space.Geometry, geom space.Geometry) ([]bool, error) {
+	return Batch(ctx, geoms, func(v space.Geometry) (bool, error) {
+		return g.Intersects(v, geom)
+	})
+}
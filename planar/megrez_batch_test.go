@@ -0,0 +1,114 @@
+package planar
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func squares(n int) []space.Geometry {
+	geoms := make([]space.Geometry, n)
+	for i := range geoms {
+		x := float64(i * 2)
+		geoms[i] = space.Polygon{{{x, 0}, {x + 1, 0}, {x + 1, float64(i + 1)}, {x, float64(i + 1)}, {x, 0}}}
+	}
+	return geoms
+}
+
+func TestBatch(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	geoms := squares(100)
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		f       func(space.Geometry) (int, error)
+		want    []int
+		wantErr error
+	}{
+		{name: "nums", ctx: context.Background(),
+			f: func(g space.Geometry) (int, error) { return len(g.(space.Polygon)[0]), nil },
+			want: func() []int {
+				want := make([]int, len(geoms))
+				for i := range want {
+					want[i] = 5
+				}
+				return want
+			}()},
+		{name: "error", ctx: context.Background(),
+			f:       func(g space.Geometry) (int, error) { return 0, spaceerr.ErrNilGeometry },
+			wantErr: spaceerr.ErrNilGeometry},
+		{name: "panic", ctx: context.Background(),
+			f:       func(g space.Geometry) (int, error) { return len(g.(space.LineString)), nil },
+			wantErr: ErrBatchPanic},
+		{name: "cancelled", ctx: cancelled,
+			f:       func(g space.Geometry) (int, error) { return 0, nil },
+			wantErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Batch(tt.ctx, geoms, tt.f)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Batch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Batch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_BatchArea(t *testing.T) {
+	G := NormalStrategy()
+	geoms := squares(100)
+	got, err := G.BatchArea(context.Background(), geoms)
+	if err != nil {
+		t.Fatalf("BatchArea() error = %v", err)
+	}
+	for i, v := range got {
+		if v != float64(i+1) {
+			t.Errorf("BatchArea()[%v] = %v, want %v", i, v, i+1)
+		}
+	}
+}
+
+func TestAlgorithm_BatchBuffer(t *testing.T) {
+	G := NormalStrategy()
+	geoms := squares(50)
+	got, err := G.BatchBuffer(context.Background(), geoms, 0.5, 4)
+	if err != nil {
+		t.Fatalf("BatchBuffer() error = %v", err)
+	}
+	for i, v := range got {
+		if want, _ := G.Buffer(geoms[i], 0.5, 4); !reflect.DeepEqual(v, want) {
+			t.Errorf("BatchBuffer()[%v] = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestAlgorithm_BatchBufferEmpty(t *testing.T) {
+	G := NormalStrategy()
+	geoms := append(squares(3), space.Polygon{{}})
+	if _, err := G.BatchBuffer(context.Background(), geoms, 1, 4); err != spaceerr.ErrEmptyGeometry {
+		t.Errorf("BatchBuffer() error = %v, want %v", err, spaceerr.ErrEmptyGeometry)
+	}
+}
+
+func TestAlgorithm_BatchIntersects(t *testing.T) {
+	G := NormalStrategy()
+	geoms := squares(10)
+	line := space.LineString{{-1, 5.5}, {30, 5.5}}
+	got, err := G.BatchIntersects(context.Background(), geoms, line)
+	if err != nil {
+		t.Fatalf("BatchIntersects() error = %v", err)
+	}
+	want := []bool{false, false, false, false, false, true, true, true, true, true}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BatchIntersects() = %v, want %v", got, want)
+	}
+}
@@ -1,17 +1,36 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
-// Area returns the area of a polygonal geometry.
+// Area returns the area of a polygonal geometry, 0 for other geometries.
 func (g *megrezAlgorithm) Area(geom space.Geometry) (float64, error) {
+	switch v := geom.(type) {
+	case nil:
+		return 0, spaceerr.ErrNilGeometry
+	case *space.GeometryValid:
+		if v == nil {
+			return 0, spaceerr.ErrNilGeometry
+		}
+		return g.Area(v.Geometry)
+	case *space.Circle:
+		if v == nil {
+			return 0, spaceerr.ErrNilGeometry
+		}
+		return v.Area()
+	case space.Polygon:
+		return v.Area()
+	case space.MultiPolygon:
+		return v.Area()
+	}
 	switch geom.GeoJSONType() {
-	case space.TypePolygon:
-		return geom.(space.Polygon).Area()
-	case space.TypeMultiPolygon:
-		return geom.(space.MultiPolygon).Area()
+	case space.TypePolygon, space.TypeMultiPolygon:
+		return 0, spaceerr.ErrUnsupportedType
 	default:
 		return 0.0, nil
 	}
@@ -28,19 +47,161 @@ func (g *megrezAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float6
 	return geom1.SpheroidDistance(geom2)
 }
 
+// DWithin returns TRUE if the geometries are within the distance of one another,
+// without computing the distance between them once the threshold is reached.
+func (g *megrezAlgorithm) DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	return withinDistance(geom1, geom2, distance, measure.IsWithinDistance)
+}
+
+// SphericalDWithin returns TRUE if the geometries are within the spherical distance in m of one another.
+func (g *megrezAlgorithm) SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	return withinDistance(geom1, geom2, distance, measure.IsWithinSpheroidDistance)
+}
+
+// DFullyWithin returns TRUE if the geometries are entirely within the distance of one another,
+// the maximum distance between them being at most the distance.
+func (g *megrezAlgorithm) DFullyWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	return withinDistance(geom1, geom2, distance, measure.IsFullyWithinDistance)
+}
+
+// SphericalDFullyWithin returns TRUE if the geometries are entirely within the spherical distance in m of one another.
+func (g *megrezAlgorithm) SphericalDFullyWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	return withinDistance(geom1, geom2, distance, measure.IsFullyWithinSpheroidDistance)
+}
+
+// withinDistance tests the geometries with the distance predicate, empty geometries being within no distance.
+func withinDistance(geom1, geom2 space.Geometry, distance float64, f func(from, to matrix.Steric, distance float64) bool) (bool, error) {
+	if geom1 == nil || geom2 == nil {
+		return false, spaceerr.ErrNilGeometry
+	}
+	if distance < 0 {
+		return false, spaceerr.ErrNegativeDistance
+	}
+	if geom1.IsEmpty() || geom2.IsEmpty() {
+		return false, nil
+	}
+	return f(geom1.ToMatrix(), geom2.ToMatrix(), distance), nil
+}
+
+// ClosestPoints returns the nearest points of the geometries, the first on geom1 and the second on geom2.
+func (g *megrezAlgorithm) ClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	return pointPair(geom1, geom2, measure.ClosestPoints)
+}
+
+// SphericalClosestPoints returns the nearest points of the geometries in longitude and latitude.
+func (g *megrezAlgorithm) SphericalClosestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	return pointPair(geom1, geom2, measure.SpheroidClosestPoints)
+}
+
+// ShortestLine returns the line from geom1 to geom2 between their nearest points.
+func (g *megrezAlgorithm) ShortestLine(geom1, geom2 space.Geometry) (space.LineString, error) {
+	return linePointPair(pointPair(geom1, geom2, measure.ClosestPoints))
+}
+
+// SphericalShortestLine returns the line from geom1 to geom2 between their nearest points in longitude and latitude.
+func (g *megrezAlgorithm) SphericalShortestLine(geom1, geom2 space.Geometry) (space.LineString, error) {
+	return linePointPair(pointPair(geom1, geom2, measure.SpheroidClosestPoints))
+}
+
+// LongestLine returns the line from geom1 to geom2 between their points farthest apart.
+func (g *megrezAlgorithm) LongestLine(geom1, geom2 space.Geometry) (space.LineString, error) {
+	return linePointPair(pointPair(geom1, geom2, measure.FarthestPoints))
+}
+
+// SphericalLongestLine returns the line from geom1 to geom2 between their points farthest apart by spherical distance.
+func (g *megrezAlgorithm) SphericalLongestLine(geom1, geom2 space.Geometry) (space.LineString, error) {
+	return linePointPair(pointPair(geom1, geom2, measure.SpheroidFarthestPoints))
+}
+
+// pointPair returns the pair of points of the geometries found by the func.
+func pointPair(geom1, geom2 space.Geometry, f func(from, to matrix.Steric) []matrix.Matrix) (space.Point, space.Point, error) {
+	if geom1 == nil || geom2 == nil || geom1.IsEmpty() || geom2.IsEmpty() {
+		return nil, nil, spaceerr.ErrNilGeometry
+	}
+	points := f(geom1.ToMatrix(), geom2.ToMatrix())
+	if len(points) != 2 {
+		return nil, nil, spaceerr.ErrNilGeometry
+	}
+	return space.Point(points[0]), space.Point(points[1]), nil
+}
+
+// linePointPair returns the line between the pair of points.
+func linePointPair(p1, p2 space.Point, err error) (space.LineString, error) {
+	if err != nil {
+		return nil, err
+	}
+	return space.LineString{p1, p2}, nil
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar
 // or dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted
 // to discrete points for one of the geometries
 func (g *megrezAlgorithm) HausdorffDistance(geom1, geom2 space.Geometry) (float64, error) {
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return 0, err
+	}
 	return (&measure.HausdorffDistance{}).Distance(geom1.ToMatrix(), geom2.ToMatrix()), nil
 }
 
 // HausdorffDistanceDensify computes the Hausdorff distance with an additional densification fraction amount
 func (g *megrezAlgorithm) HausdorffDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return 0, err
+	}
 	return (&measure.HausdorffDistance{}).DistanceDensifyFrac(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
 }
 
+// FrechetDistance returns the discrete Fréchet distance between two geometries,
+// a measure of the similarity of lines such as tracks taking the order of their vertices into account.
+func (g *megrezAlgorithm) FrechetDistance(geom1, geom2 space.Geometry) (float64, error) {
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return 0, err
+	}
+	return (&measure.FrechetDistance{}).Distance(geom1.ToMatrix(), geom2.ToMatrix()), nil
+}
+
+// FrechetDistanceDensify computes the discrete Fréchet distance with an additional densification fraction amount.
+func (g *megrezAlgorithm) FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return 0, err
+	}
+	return (&measure.FrechetDistance{}).DistanceDensifyFrac(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
+}
+
+// DTWDistance returns the dynamic time warping distance between two geometries,
+// the least sum of the distances of their vertices matched in order.
+func (g *megrezAlgorithm) DTWDistance(geom1, geom2 space.Geometry) (float64, error) {
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return 0, err
+	}
+	return measure.DTWDistance(geom1.ToMatrix(), geom2.ToMatrix()), nil
+}
+
+// IoU returns the intersection over union of two polygonal geometries, the Jaccard index of their areas,
+// 1 for equal polygons and 0 for polygons whose interiors do not intersect.
+func (g *megrezAlgorithm) IoU(geom1, geom2 space.Geometry) (float64, error) {
+	if geom1 == nil || geom2 == nil {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	for _, geom := range []space.Geometry{geom1, geom2} {
+		if t := geom.GeoJSONType(); t != space.TypePolygon && t != space.TypeMultiPolygon {
+			return 0, ErrNotPolygon
+		}
+	}
+	area1, _ := geom1.Area()
+	area2, _ := geom2.Area()
+	if area1 == 0 || area2 == 0 {
+		return 0, nil
+	}
+	result, err := snapround.Intersection(geom1.ToMatrix(), geom2.ToMatrix(), 0)
+	if err != nil {
+		return 0, err
+	}
+	intersection, _ := space.TransGeometry(result).Area()
+	return intersection / (area1 + area2 - intersection), nil
+}
+
 // Length returns the 2D Cartesian length of the geometry if it is a LineString, MultiLineString
 func (g *megrezAlgorithm) Length(geom space.Geometry) (float64, error) {
 	return geom.Length(), nil
@@ -1,15 +1,19 @@
 package planar
 
 import (
+	"math"
 	"testing"
 
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Area(t *testing.T) {
 	const polygon = `POLYGON((-1 -1, 1 -1, 1 1, -1 1, -1 -1))`
 	geometry, _ := wkt.UnmarshalString(polygon)
+	valid, _ := space.CreateElementValid(geometry)
+	circle, _ := space.CreateCircle(space.Point{0, 0}, 1)
 	type args struct {
 		g space.Geometry
 	}
@@ -20,6 +24,10 @@ func TestAlgorithm_Area(t *testing.T) {
 		wantErr bool
 	}{
 		{name: "area", args: args{g: geometry}, want: 4.0, wantErr: false},
+		{name: "valid", args: args{g: valid}, want: 4.0, wantErr: false},
+		{name: "circle", args: args{g: circle}, want: math.Pi, wantErr: false},
+		{name: "line", args: args{g: space.LineString{{0, 0}, {1, 1}}}, want: 0, wantErr: false},
+		{name: "nil", args: args{g: nil}, want: 0, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -124,6 +132,169 @@ func TestAlgorithm_SphericalDistance(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_DWithin(t *testing.T) {
+	square := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name      string
+		g1, g2    space.Geometry
+		distance  float64
+		want      bool
+		wantFully bool
+		wantErr   bool
+	}{
+		{"line", space.LineString{{12, 0}, {12, 10}}, square, 2, true, false, false},
+		{"line far", space.LineString{{12, 0}, {12, 10}}, square, 1.9, false, false, false},
+		{"inside", space.Point{5, 5}, square, 0, true, false, false},
+		{"fully", space.Point{5, 5}, square, 7.1, true, true, false},
+		{"multi", space.MultiPoint{{20, 20}, {11, 5}}, square, 1, true, false, false},
+		{"empty", space.LineString{}, square, 1, false, false, false},
+		{"negative", space.Point{5, 5}, square, -1, false, false, true},
+		{"nil", nil, square, 1, false, false, true},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.DWithin(tt.g1, tt.g2, tt.distance)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("DWithin() = %v, %v, want %v", got, err, tt.want)
+			}
+			got, err = G.DFullyWithin(tt.g1, tt.g2, tt.distance)
+			if (err != nil) != tt.wantErr || got != tt.wantFully {
+				t.Errorf("DFullyWithin() = %v, %v, want %v", got, err, tt.wantFully)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_SphericalDWithin(t *testing.T) {
+	point01 := space.Point{116.397439, 39.909177}
+	point02 := space.Point{116.397725, 39.903079}
+	line := space.LineString{{116.397439, 39.909177}, {116.397725, 39.903079}}
+	tests := []struct {
+		name      string
+		g1, g2    space.Geometry
+		distance  float64
+		want      bool
+		wantFully bool
+	}{
+		{"points", point01, point02, 679, true, true},
+		{"points far", point01, point02, 678, false, false},
+		{"on line", point01, line, 0, true, false},
+		{"line fully", point01, line, 679, true, true},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := G.SphericalDWithin(tt.g1, tt.g2, tt.distance); got != tt.want {
+				t.Errorf("SphericalDWithin() = %v, want %v", got, tt.want)
+			}
+			if got, _ := G.SphericalDFullyWithin(tt.g1, tt.g2, tt.distance); got != tt.wantFully {
+				t.Errorf("SphericalDFullyWithin() = %v, want %v", got, tt.wantFully)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_ShortestLine(t *testing.T) {
+	road := space.LineString{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name        string
+		g1, g2      space.Geometry
+		want        space.LineString
+		wantLongest space.LineString
+		wantErr     bool
+	}{
+		{"customer", space.Point{4, 3}, road, space.LineString{{4, 3}, {4, 0}}, space.LineString{{4, 3}, {10, 10}}, false},
+		{"corner", space.Point{12, -1}, road, space.LineString{{12, -1}, {10, 0}}, space.LineString{{12, -1}, {0, 0}}, false},
+		{"polygon", space.Polygon{{{12, 4}, {14, 4}, {14, 6}, {12, 4}}}, road,
+			space.LineString{{12, 4}, {10, 4}}, space.LineString{{14, 6}, {0, 0}}, false},
+		{"empty", space.LineString{}, road, nil, nil, true},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.ShortestLine(tt.g1, tt.g2)
+			if (err != nil) != tt.wantErr || !got.Equals(tt.want) {
+				t.Errorf("ShortestLine() = %v, %v, want %v", got, err, tt.want)
+			}
+			got, err = G.LongestLine(tt.g1, tt.g2)
+			if (err != nil) != tt.wantErr || !got.Equals(tt.wantLongest) {
+				t.Errorf("LongestLine() = %v, %v, want %v", got, err, tt.wantLongest)
+			}
+		})
+	}
+
+	p1, p2, err := G.ClosestPoints(space.Point{4, 3}, road)
+	if err != nil || !p1.Equals(space.Point{4, 3}) || !p2.Equals(space.Point{4, 0}) {
+		t.Errorf("ClosestPoints() = %v, %v, %v", p1, p2, err)
+	}
+	line, err := G.SphericalShortestLine(space.Point{116.4, 39.92}, space.LineString{{116.39, 39.91}, {116.41, 39.91}})
+	if err != nil || !line.EqualsExact(space.LineString{{116.4, 39.92}, {116.4, 39.91}}, 1e-9) {
+		t.Errorf("SphericalShortestLine() = %v, %v", line, err)
+	}
+	line, err = G.SphericalLongestLine(space.Point{116.4, 39.92}, space.LineString{{116.39, 39.91}, {116.5, 39.91}})
+	if err != nil || !line.Equals(space.LineString{{116.4, 39.92}, {116.5, 39.91}}) {
+		t.Errorf("SphericalLongestLine() = %v, %v", line, err)
+	}
+}
+
+func TestAlgorithm_FrechetDistance(t *testing.T) {
+	track := space.LineString{{0, 0}, {1, 0}, {2, 0}}
+	G := NormalStrategy()
+	if got, _ := G.FrechetDistance(track, space.LineString{{2, 0}, {1, 0}, {0, 0}}); got != 2 {
+		t.Errorf("FrechetDistance() = %v, want 2", got)
+	}
+	if got, _ := G.HausdorffDistance(track, space.LineString{{2, 0}, {1, 0}, {0, 0}}); got != 0 {
+		t.Errorf("HausdorffDistance() = %v, want 0", got)
+	}
+	if got, _ := G.FrechetDistanceDensify(space.LineString{{0, 0}, {10, 0}}, space.LineString{{0, 1}, {10, 1}}, 0.1); got != 1 {
+		t.Errorf("FrechetDistanceDensify() = %v, want 1", got)
+	}
+	if got, _ := G.DTWDistance(track, space.LineString{{0, 1}, {1, 1}, {2, 1}}); got != 3 {
+		t.Errorf("DTWDistance() = %v, want 3", got)
+	}
+	distances := map[string]func(g1, g2 space.Geometry) (float64, error){
+		"FrechetDistance": G.FrechetDistance,
+		"FrechetDistanceDensify": func(g1, g2 space.Geometry) (float64, error) {
+			return G.FrechetDistanceDensify(g1, g2, 0.5)
+		},
+		"DTWDistance": G.DTWDistance,
+	}
+	for name, distance := range distances {
+		if _, err := distance(track, space.LineString{}); err != spaceerr.ErrEmptyGeometry {
+			t.Errorf("%v() error = %v, want %v", name, err, spaceerr.ErrEmptyGeometry)
+		}
+		if _, err := distance(nil, track); err != spaceerr.ErrNilGeometry {
+			t.Errorf("%v() error = %v, want %v", name, err, spaceerr.ErrNilGeometry)
+		}
+	}
+}
+
+func TestAlgorithm_IoU(t *testing.T) {
+	square := space.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	tests := []struct {
+		name    string
+		g1, g2  space.Geometry
+		want    float64
+		wantErr bool
+	}{
+		{"equal", square, square, 1, false},
+		{"half", square, space.Polygon{{{1, 0}, {3, 0}, {3, 2}, {1, 2}, {1, 0}}}, 1.0 / 3, false},
+		{"disjoint", square, space.Polygon{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}}, 0, false},
+		{"multi", space.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, {{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}}}, square, 0.5, false},
+		{"line", square, space.LineString{{0, 0}, {1, 1}}, 0, true},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.IoU(tt.g1, tt.g2)
+			if (err != nil) != tt.wantErr || math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("IoU() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_NGeometry(t *testing.T) {
 	multiPoint, _ := wkt.UnmarshalString(`MULTIPOINT ( -1 0, -1 2, -1 3, -1 4, -1 7, 0 1, 0 3, 1 1, 2 0, 6 0, 7 8, 9 8, 10 6 )`)
 	multiLineString, _ := wkt.UnmarshalString(`MULTILINESTRING((10 130,50 190,110 190,140 150,150 80,100 10,20 40,10 130),
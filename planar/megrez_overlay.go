@@ -1,10 +1,15 @@
 package planar
 
 import (
+	"context"
+
 	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/graph/clipping"
+	"github.com/spatial-go/geoos/algorithm/graph/dissovle"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/noding"
 	"github.com/spatial-go/geoos/algorithm/overlay"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
 	"github.com/spatial-go/geoos/algorithm/sharedpaths"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
@@ -15,24 +20,62 @@ import (
 // One can think of this as GeometryA - Intersection(A,B).
 // If A is completely contained in B then an empty geometry collection is returned.
 func (g *megrezAlgorithm) Difference(geom1, geom2 space.Geometry) (space.Geometry, error) {
-	if (geom1.GeoJSONType()) != (geom2.GeoJSONType()) {
-		return nil, algorithm.ErrNotMatchType
+	return g.DifferenceContext(context.Background(), geom1, geom2)
+}
+
+// DifferenceContext returns the part of geometry A that does not intersect with geometry B as Difference,
+// returning ctx.Err() if the context is done before the difference is computed.
+func (g *megrezAlgorithm) DifferenceContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	var result space.Geometry
 	var err error
-	if result, err := clipping.Difference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
-		return space.TransGeometry(result), nil
+	if g.isSnapRounded(geom1, geom2) {
+		result, err = g.snapRoundOverlay(ctx, geom1, geom2, snapround.OpDifference)
+	} else if err = nonEmpty(geom1, geom2); err != nil {
+		return nil, err
+	} else if geom1.GeoJSONType() != geom2.GeoJSONType() {
+		return nil, algorithm.ErrNotMatchType
+	} else {
+		var difference matrix.Steric
+		if difference, err = clipping.Difference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
+			result = space.TransGeometry(difference)
+		}
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
 	}
-	return nil, err
+	return result, err
 }
 
 // Intersection returns a geometry that represents the point set intersection of the Geometries.
-func (g *megrezAlgorithm) Intersection(geom1, geom2 space.Geometry) (intersectGeom space.Geometry, intersectErr error) {
-	if result, err := clipping.Intersection(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
-		intersectGeom = space.TransGeometry(result)
+func (g *megrezAlgorithm) Intersection(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return g.IntersectionContext(context.Background(), geom1, geom2)
+}
+
+// IntersectionContext returns the point set intersection of the Geometries as Intersection,
+// returning ctx.Err() if the context is done before the intersection is computed.
+func (g *megrezAlgorithm) IntersectionContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var result space.Geometry
+	var err error
+	if g.isSnapRounded(geom1, geom2) {
+		result, err = g.snapRoundOverlay(ctx, geom1, geom2, snapround.OpIntersection)
+	} else if err = nonEmpty(geom1, geom2); err != nil {
+		return nil, err
 	} else {
-		intersectErr = err
+		var intersection matrix.Steric
+		if intersection, err = clipping.Intersection(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
+			result = space.TransGeometry(intersection)
+		}
 	}
-	return
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return result, err
 }
 
 // LineMerge returns a (set of) LineString(s) formed by sewing together the constituent line work of a MULTILINESTRING.
@@ -49,6 +92,44 @@ func (g *megrezAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error)
 	return lm, nil
 }
 
+// Node returns the linework of the geometry noded at all its intersections as a MultiLineString,
+// polygons contribute their rings and overlapping sections of lines are returned once.
+// If gridSize is positive the linework is snap-rounded to a grid of that size.
+func (g *megrezAlgorithm) Node(geom space.Geometry, gridSize float64) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if gridSize < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	ml := space.MultiLineString{}
+	for _, v := range noding.Node(noding.Linework(geom.ToMatrix()), gridSize) {
+		ml = append(ml, space.LineString(v))
+	}
+	return ml, nil
+}
+
+// SegmentIntersection an intersection point of the segment with index Segment0 of line Line0
+// and the segment with index Segment1 of line Line1 of the linework of a geometry.
+type SegmentIntersection struct {
+	Point           space.Point
+	Line0, Segment0 int
+	Line1, Segment1 int
+}
+
+// SegmentIntersections returns all the intersection points of the segments of the linework of the geometry.
+// Lines are numbered in the order of the components of the geometry, polygons contribute their rings.
+func (g *megrezAlgorithm) SegmentIntersections(geom space.Geometry) ([]SegmentIntersection, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result := []SegmentIntersection{}
+	for _, v := range noding.Intersections(noding.Linework(geom.ToMatrix())...) {
+		result = append(result, SegmentIntersection{space.Point(v.Point), v.Line0, v.Segment0, v.Line1, v.Segment1})
+	}
+	return result, nil
+}
+
 // SharedPaths returns a collection containing paths shared by the two input geometries.
 // Those going in the same direction are in the first element of the collection,
 // those going in the opposite direction are in the second element.
@@ -71,23 +152,66 @@ func (g *megrezAlgorithm) SharedPaths(geom1, geom2 space.Geometry) (string, erro
 	return wkt.MarshalString(coll), nil
 }
 
+// Split returns a collection of the geometries resulting from splitting a geometry by a blade.
+// Polygons can be split by lines or polygons, lines can be split by points, lines or polygons,
+// the components of multi geometries are split one by one.
+func (g *megrezAlgorithm) Split(geom, blade space.Geometry) (space.Geometry, error) {
+	if geom == nil || blade == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result, err := dissovle.Split(geom.ToMatrix(), blade.ToMatrix())
+	if err != nil {
+		return nil, err
+	}
+	coll := space.Collection{}
+	for _, v := range result {
+		coll = append(coll, space.TransGeometry(v))
+	}
+	return coll, nil
+}
+
 // SymDifference returns a geometry that represents the portions of A and B that do not intersect.
 // It is called a symmetric difference because SymDifference(A,B) = SymDifference(B,A).
 // One can think of this as Union(geomA,geomB) - Intersection(A,B).
 func (g *megrezAlgorithm) SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error) {
-	if geom1.GeoJSONType() != geom2.GeoJSONType() {
-		return nil, algorithm.ErrNotMatchType
+	return g.SymDifferenceContext(context.Background(), geom1, geom2)
+}
+
+// SymDifferenceContext returns the portions of A and B that do not intersect as SymDifference,
+// returning ctx.Err() if the context is done before the symmetric difference is computed.
+func (g *megrezAlgorithm) SymDifferenceContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	var result space.Geometry
 	var err error
-	if result, err := clipping.SymDifference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
-		return space.TransGeometry(result), nil
+	if g.isSnapRounded(geom1, geom2) {
+		result, err = g.snapRoundOverlay(ctx, geom1, geom2, snapround.OpSymDifference)
+	} else if err = nonEmpty(geom1, geom2); err != nil {
+		return nil, err
+	} else if geom1.GeoJSONType() != geom2.GeoJSONType() {
+		return nil, algorithm.ErrNotMatchType
+	} else {
+		var symDifference matrix.Steric
+		if symDifference, err = clipping.SymDifference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
+			result = space.TransGeometry(symDifference)
+		}
 	}
-	return nil, err
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return result, err
 }
 
 // UnaryUnion does dissolve boundaries between components of a multipolygon (invalid) and does perform union
 // between the components of a geometrycollection
 func (g *megrezAlgorithm) UnaryUnion(geom space.Geometry) (space.Geometry, error) {
+	if g.isSnapRounded(geom) {
+		return g.snapRoundOverlay(context.Background(), geom, nil, snapround.OpUnion)
+	}
+	if err := nonEmpty(geom); err != nil {
+		return nil, err
+	}
 	if geom.GeoJSONType() == space.TypeMultiPolygon {
 		result, _ := clipping.UnaryUnion(geom.ToMatrix())
 		return space.TransGeometry(result), nil
@@ -97,6 +221,95 @@ func (g *megrezAlgorithm) UnaryUnion(geom space.Geometry) (space.Geometry, error
 
 // Union returns a new geometry representing all points in this geometry and the other.
 func (g *megrezAlgorithm) Union(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if g.isSnapRounded(geom1, geom2) {
+		return g.snapRoundOverlay(context.Background(), geom1, geom2, snapround.OpUnion)
+	}
+	if err := nonEmpty(geom1, geom2); err != nil {
+		return nil, err
+	}
 	result, err := clipping.Union(geom1.ToMatrix(), geom2.ToMatrix())
 	return space.TransGeometry(result), err
 }
+
+// CascadedUnion does dissolve boundaries between the polygons of a multipolygon or a geometrycollection,
+// unioning groups of polygons near each other on all cores.
+// It returns ctx.Err() if the context is done before the union is computed.
+func (g *megrezAlgorithm) CascadedUnion(ctx context.Context, geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	switch geom.GeoJSONType() {
+	case space.TypePolygon, space.TypeMultiPolygon, space.TypeCollection:
+	default:
+		return nil, ErrNotPolygon
+	}
+	result, err := overlay.CascadedUnion(ctx, geom.ToMatrix(), 0)
+	if err != nil {
+		if err == algorithm.ErrNotMatchType {
+			return nil, ErrNotPolygon
+		}
+		return nil, err
+	}
+	return space.TransGeometry(result), nil
+}
+
+// UnaryUnionContext does dissolve boundaries as UnaryUnion,
+// returning ctx.Err() if the context is done before the union is computed.
+func (g *megrezAlgorithm) UnaryUnionContext(ctx context.Context, geom space.Geometry) (space.Geometry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var result space.Geometry
+	var err error
+	if g.isSnapRounded(geom) {
+		result, err = g.snapRoundOverlay(ctx, geom, nil, snapround.OpUnion)
+	} else if err = nonEmpty(geom); err != nil {
+		return nil, err
+	} else if geom.GeoJSONType() == space.TypeMultiPolygon {
+		var union matrix.Steric
+		union, err = clipping.UnaryUnionContext(ctx, geom.ToMatrix())
+		result = space.TransGeometry(union)
+	} else {
+		return nil, ErrNotPolygon
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return result, err
+}
+
+// UnionContext returns a new geometry representing all points in this geometry and the other as Union,
+// returning ctx.Err() if the context is done before the union is computed.
+func (g *megrezAlgorithm) UnionContext(ctx context.Context, geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var result space.Geometry
+	var err error
+	if g.isSnapRounded(geom1, geom2) {
+		result, err = g.snapRoundOverlay(ctx, geom1, geom2, snapround.OpUnion)
+	} else if err = nonEmpty(geom1, geom2); err != nil {
+		return nil, err
+	} else {
+		var union matrix.Steric
+		union, err = clipping.UnionContext(ctx, geom1.ToMatrix(), geom2.ToMatrix())
+		result = space.TransGeometry(union)
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return result, err
+}
+
+// nonEmpty returns ErrNilGeometry or ErrEmptyGeometry if a geometry is nil or empty.
+func nonEmpty(geoms ...space.Geometry) error {
+	for _, geom := range geoms {
+		if geom == nil {
+			return spaceerr.ErrNilGeometry
+		}
+		if geom.IsEmpty() {
+			return spaceerr.ErrEmptyGeometry
+		}
+	}
+	return nil
+}
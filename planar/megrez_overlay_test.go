@@ -1,12 +1,18 @@
 package planar
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/precision"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Difference(t *testing.T) {
@@ -155,6 +161,104 @@ func TestAlgorithm_SymDifference(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_Split(t *testing.T) {
+	poly, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0,10 10)`)
+	blade, _ := wkt.UnmarshalString(`LINESTRING(-1 5,11 5)`)
+	expectPoly, _ := wkt.UnmarshalString(`GEOMETRYCOLLECTION(POLYGON((0 0,10 0,10 5,0 5,0 0)),POLYGON((0 5,10 5,10 10,0 10,0 5)))`)
+	expectLine, _ := wkt.UnmarshalString(`GEOMETRYCOLLECTION(LINESTRING(0 0,10 0,10 5),LINESTRING(10 5,10 10))`)
+
+	type args struct {
+		geom  space.Geometry
+		blade space.Geometry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "split polygon", args: args{geom: poly, blade: blade}, want: expectPoly},
+		{name: "split line", args: args{geom: line, blade: blade}, want: expectLine},
+		{name: "split nil", args: args{geom: line, blade: nil}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.Split(tt.args.geom, tt.args.blade)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Split() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && !got.Equals(tt.want) {
+				t.Errorf("Split() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_Node(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 10),(0 10,10 0))`)
+	expect, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,5 5),(5 5,10 10),(0 10,5 5),(5 5,10 0))`)
+	snapLines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0.4),(5 -5,5.2 5))`)
+	expectSnap, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,5 0),(5 0,10 0),(5 -5,5 0),(5 0,5 5))`)
+
+	tests := []struct {
+		name     string
+		geom     space.Geometry
+		gridSize float64
+		want     space.Geometry
+		wantErr  bool
+	}{
+		{name: "node lines", geom: lines, want: expect},
+		{name: "node snap rounding", geom: snapLines, gridSize: 1, want: expectSnap},
+		{name: "node wrong grid size", geom: lines, gridSize: -1, wantErr: true},
+		{name: "node nil", geom: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.Node(tt.geom, tt.gridSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Node() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && !got.Equals(tt.want) {
+				t.Errorf("Node() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_SegmentIntersections(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0,10 10),(0 10,10 0))`)
+	poly, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+
+	tests := []struct {
+		name    string
+		geom    space.Geometry
+		want    []SegmentIntersection
+		wantErr bool
+	}{
+		{name: "lines", geom: lines, want: []SegmentIntersection{{space.Point{10, 0}, 0, 0, 1, 0}, {space.Point{10, 0}, 0, 1, 1, 0}}},
+		{name: "simple polygon", geom: poly, want: []SegmentIntersection{}},
+		{name: "nil", geom: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.SegmentIntersections(tt.geom)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SegmentIntersections() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SegmentIntersections() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_SharedPaths(t *testing.T) {
 	const mullinestring = `MULTILINESTRING((26 125,26 200,126 200,126 125,26 125),
 	   (51 150,101 150,76 175,51 150))`
@@ -373,3 +477,244 @@ func TestAlgorithm_Union(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_UnaryUnionContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	geom := space.MultiPolygon{space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		space.Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}
+	want := space.Polygon{{{0, 0}, {10, 0}, {10, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		g       space.Geometry
+		want    space.Geometry
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), g: geom, want: want},
+		{name: "cancelled", ctx: cancelled, g: geom, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, g: geom, wantErr: context.DeadlineExceeded},
+		{name: "not polygon", ctx: context.Background(), g: space.Point{1, 1}, wantErr: ErrNotPolygon},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.UnaryUnionContext(tt.ctx, tt.g)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnaryUnionContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("UnaryUnionContext() = %v, want nil", got)
+				}
+				return
+			}
+			if isEqual, _ := G.EqualsExact(got, tt.want, 0.000001); !isEqual {
+				t.Errorf("UnaryUnionContext() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_UnionContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	g1, g2 := space.Point{1, 2}, space.Point{3, 4}
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    space.Geometry
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), want: space.MultiPoint{{1, 2}, {3, 4}}},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.UnionContext(tt.ctx, g1, g2)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnionContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnionContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_OverlayContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the clipping may reorient the rings of its inputs, each overlay gets its own
+	inputs := func() (space.Geometry, space.Geometry) {
+		return space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			space.Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}
+	}
+	for _, G := range []Algorithm{NormalStrategy(), PrecisionStrategy(precision.Fixed(1000))} {
+		overlays := map[string]struct {
+			overlay        func(g1, g2 space.Geometry) (space.Geometry, error)
+			overlayContext func(ctx context.Context, g1, g2 space.Geometry) (space.Geometry, error)
+		}{
+			"Intersection":  {G.Intersection, G.IntersectionContext},
+			"Difference":    {G.Difference, G.DifferenceContext},
+			"SymDifference": {G.SymDifference, G.SymDifferenceContext},
+		}
+		for name, tt := range overlays {
+			t.Run(name, func(t *testing.T) {
+				want, err := tt.overlay(inputs())
+				if err != nil {
+					t.Fatal(err)
+				}
+				g1, g2 := inputs()
+				if got, err := tt.overlayContext(context.Background(), g1, g2); err != nil || !reflect.DeepEqual(got, want) {
+					t.Errorf("%vContext() = %v, %v, want %v", name, got, err, want)
+				}
+				if got, err := tt.overlayContext(cancelled, g1, g2); !errors.Is(err, context.Canceled) || got != nil {
+					t.Errorf("%vContext() = %v, %v, want nil, %v", name, got, err, context.Canceled)
+				}
+			})
+		}
+	}
+}
+
+func TestAlgorithm_OverlayError(t *testing.T) {
+	square := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	collapsed := space.Polygon{{{0, 1}, {0, 1}, {0, 1}, {0, 1}}}
+	G := NormalStrategy()
+	overlays := map[string]func(g1, g2 space.Geometry) (space.Geometry, error){
+		"Union":         G.Union,
+		"Intersection":  G.Intersection,
+		"Difference":    G.Difference,
+		"SymDifference": G.SymDifference,
+		"UnionContext": func(g1, g2 space.Geometry) (space.Geometry, error) {
+			return G.UnionContext(context.Background(), g1, g2)
+		},
+		"IntersectionContext": func(g1, g2 space.Geometry) (space.Geometry, error) {
+			return G.IntersectionContext(context.Background(), g1, g2)
+		},
+		"DifferenceContext": func(g1, g2 space.Geometry) (space.Geometry, error) {
+			return G.DifferenceContext(context.Background(), g1, g2)
+		},
+		"SymDifferenceContext": func(g1, g2 space.Geometry) (space.Geometry, error) {
+			return G.SymDifferenceContext(context.Background(), g1, g2)
+		},
+	}
+	tests := []struct {
+		name    string
+		g1, g2  space.Geometry
+		wantErr error
+	}{
+		{name: "nil", g1: nil, g2: square, wantErr: spaceerr.ErrNilGeometry},
+		{name: "empty", g1: square, g2: space.Polygon{}, wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "collapsed", g1: square, g2: collapsed, wantErr: algorithm.ErrTopology},
+	}
+	for _, tt := range tests {
+		for name, overlay := range overlays {
+			t.Run(tt.name+" "+name, func(t *testing.T) {
+				if _, err := overlay(tt.g1, tt.g2); !errors.Is(err, tt.wantErr) {
+					t.Errorf("%v() error = %v, wantErr %v", name, err, tt.wantErr)
+				}
+			})
+		}
+	}
+}
+
+func TestAlgorithm_CascadedUnion(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	geom := space.MultiPolygon{space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		space.Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		g        space.Geometry
+		wantArea float64
+		wantErr  error
+	}{
+		{name: "multipolygon", ctx: context.Background(), g: geom, wantArea: 175},
+		{name: "collection", ctx: context.Background(),
+			g: space.Collection{geom, space.Polygon{{{20, 20}, {30, 20}, {30, 30}, {20, 30}, {20, 20}}}}, wantArea: 275},
+		{name: "cancelled", ctx: cancelled, g: geom, wantErr: context.Canceled},
+		{name: "not polygon", ctx: context.Background(), g: space.Point{1, 1}, wantErr: ErrNotPolygon},
+		{name: "collection not polygon", ctx: context.Background(), g: space.Collection{geom, space.Point{1, 1}}, wantErr: ErrNotPolygon},
+		{name: "nil", ctx: context.Background(), wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.CascadedUnion(tt.ctx, tt.g)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CascadedUnion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if area, _ := G.Area(got); area != tt.wantArea {
+				t.Errorf("CascadedUnion() = %v, want area %v", wkt.MarshalString(got), tt.wantArea)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_OverlayNormalize(t *testing.T) {
+	square1 := space.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	square2 := space.Polygon{{{3, 3}, {1, 3}, {1, 1}, {3, 1}, {3, 3}}}
+	want := space.Polygon{{{0, 0}, {2, 0}, {2, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 2}, {0, 2}, {0, 0}}}
+	G := NormalStrategy()
+	for _, geoms := range [][2]space.Geometry{{square1, square2}, {square2, square1}, {square2.Normalize(), square1}} {
+		got, err := G.Union(geoms[0], geoms[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got = got.Normalize(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Union().Normalize() = %v, want %v", got, want)
+		}
+	}
+}
+
+// countdownContext is a context done after its Err method has been called n times.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (c *countdownContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestAlgorithm_UnionContext_cancelPartway(t *testing.T) {
+	squares := space.MultiPolygon{}
+	for i := 0; i < 400; i++ {
+		x, y := float64(i%20)*1.5, float64(i/20)*1.5
+		squares = append(squares, space.Polygon{{{x, y}, {x + 2, y}, {x + 2, y + 2}, {x, y + 2}, {x, y}}})
+	}
+	G := PrecisionStrategy(precision.Fixed(1000))
+	if _, err := G.UnaryUnionContext(context.Background(), squares); err != nil {
+		t.Fatal(err)
+	}
+	ctx := &countdownContext{Context: context.Background(), n: 5}
+	if got, err := G.UnaryUnionContext(ctx, squares); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("UnaryUnionContext() = %v, %v, want nil, %v", got, err, context.Canceled)
+	}
+	ctx = &countdownContext{Context: context.Background(), n: 5}
+	if got, err := G.UnionContext(ctx, squares[:200], squares[200:]); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("UnionContext() = %v, %v, want nil, %v", got, err, context.Canceled)
+	}
+	ctx = &countdownContext{Context: context.Background(), n: 5}
+	if got, err := G.IntersectionContext(ctx, squares[:200], squares[200:]); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("IntersectionContext() = %v, %v, want nil, %v", got, err, context.Canceled)
+	}
+}
@@ -0,0 +1,73 @@
+package planar
+
+import (
+	"context"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/overlay/snapround"
+	"github.com/spatial-go/geoos/algorithm/precision"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// ReducePrecision returns the geometry with all its coordinates rounded to a grid of gridSize.
+// Repeated points and collapsed lines are removed, polygonal geometries are snap-rounded
+// so the result is valid.
+func (g *megrezAlgorithm) ReducePrecision(geom space.Geometry, gridSize float64) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result, err := precision.Reduce(geom.ToMatrix(), gridSize)
+	if err != nil {
+		return nil, err
+	}
+	return space.TransGeometry(result), nil
+}
+
+// precisionModel returns the fixed precision model which applies to the geometries,
+// the precision model of the algorithm or else the one attached to a geometry.
+func (g *megrezAlgorithm) precisionModel(geoms ...space.Geometry) precision.Model {
+	if !g.precision.IsFloating() {
+		return g.precision
+	}
+	for _, geom := range geoms {
+		if p, ok := geom.(interface{ PrecisionModel() precision.Model }); ok && !p.PrecisionModel().IsFloating() {
+			return p.PrecisionModel()
+		}
+	}
+	return g.precision
+}
+
+// isSnapRounded returns true if the overlay of the geometries is to be snap-rounded,
+// that is a fixed precision model applies and the geometries are polygonal.
+func (g *megrezAlgorithm) isSnapRounded(geoms ...space.Geometry) bool {
+	if g.precisionModel(geoms...).IsFloating() {
+		return false
+	}
+	for _, geom := range geoms {
+		if geom == nil {
+			return false
+		}
+		if t := geom.GeoJSONType(); t != space.TypePolygon && t != space.TypeMultiPolygon {
+			return false
+		}
+	}
+	return true
+}
+
+// snapRoundOverlay returns the overlay of the geometries snap-rounded to the grid of the precision model,
+// returning ctx.Err() if the context is done before the overlay is computed.
+func (g *megrezAlgorithm) snapRoundOverlay(ctx context.Context, geom1, geom2 space.Geometry, opCode int) (space.Geometry, error) {
+	gridSize := g.precisionModel(geom1, geom2).GridSize()
+	var result matrix.Steric
+	var err error
+	if geom2 == nil {
+		result, err = snapround.UnaryUnionContext(ctx, geom1.ToMatrix(), gridSize)
+	} else {
+		result, err = snapround.OverlayContext(ctx, geom1.ToMatrix(), geom2.ToMatrix(), opCode, gridSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return space.TransGeometry(result), nil
+}
@@ -0,0 +1,80 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/precision"
+	"github.com/spatial-go/geoos/geoencoding/wkt"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestAlgorithm_ReducePrecision(t *testing.T) {
+	point, _ := wkt.UnmarshalString(`POINT(1.26 2.74)`)
+	expectPoint, _ := wkt.UnmarshalString(`POINT(1.5 2.5)`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,0.1 0.1,1.9 2.1)`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,2 2)`)
+	poly, _ := wkt.UnmarshalString(`POLYGON((0.1 0,10.2 0.1,9.9 10,0 10.3,0.1 0))`)
+	expectPoly, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+
+	tests := []struct {
+		name     string
+		geom     space.Geometry
+		gridSize float64
+		want     space.Geometry
+		wantErr  bool
+	}{
+		{name: "point", geom: point, gridSize: 0.5, want: expectPoint},
+		{name: "line", geom: line, gridSize: 1, want: expectLine},
+		{name: "polygon", geom: poly, gridSize: 1, want: expectPoly},
+		{name: "wrong grid size", geom: poly, gridSize: -1, wantErr: true},
+		{name: "nil", geom: nil, gridSize: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.ReducePrecision(tt.geom, tt.gridSize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReducePrecision() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil && !got.Equals(tt.want) {
+				t.Errorf("ReducePrecision() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_PrecisionOverlay(t *testing.T) {
+	parcel1, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	parcel2, _ := wkt.UnmarshalString(`POLYGON((10.0000001 0,20 0,20 10,10.0000001 10,10.0000001 0))`)
+	expectUnion, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,20 0,20 10,10 10,0 10,0 0))`)
+	expectDifference, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+
+	G := PrecisionStrategy(precision.Fixed(1000))
+	got, err := G.Union(parcel1, parcel2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equals(expectUnion) {
+		t.Errorf("Union() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(expectUnion))
+	}
+	got, err = G.Difference(parcel1, parcel2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equals(expectDifference) {
+		t.Errorf("Difference() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(expectDifference))
+	}
+
+	attached, err := space.CreateElementValidWithPrecision(parcel1, space.WGS84, precision.Fixed(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = NormalStrategy().Union(attached, parcel2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equals(expectUnion) {
+		t.Errorf("Union() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(expectUnion))
+	}
+}
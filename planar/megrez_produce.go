@@ -1,26 +1,66 @@
 package planar
 
 import (
+	"context"
+
+	"github.com/spatial-go/geoos/algorithm/affine"
 	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/overlay/snap"
 	"github.com/spatial-go/geoos/algorithm/simplify"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
+// AffineTransform returns the geometry with all its coordinates transformed by the affine transformation.
+// Use affine.FromControlPoints to place a geometry, e.g. a CAD drawing, from three known point pairs.
+func (g *megrezAlgorithm) AffineTransform(geom space.Geometry, t affine.AffineTransformation) (space.Geometry, error) {
+	return space.AffineTransform(geom, t)
+}
+
 // Boundary returns the closure of the combinatorial boundary of this space.Geometry.
 func (g *megrezAlgorithm) Boundary(geom space.Geometry) (space.Geometry, error) {
+	if err := nonEmpty(geom); err != nil {
+		return nil, err
+	}
 	return geom.Boundary()
 }
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (g *megrezAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int) (geometry space.Geometry) {
+func (g *megrezAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
 	return geom.Buffer(width, quadsegs)
 }
 
+// BufferContext Returns the buffer of the geometry as Buffer,
+// returning ctx.Err() if the context is done before the buffer is computed.
+func (g *megrezAlgorithm) BufferContext(ctx context.Context, geom space.Geometry, width float64, quadsegs int) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	steric := geom.ToMatrix()
+	if steric.IsEmpty() {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	result, err := buffer.BufferContext(ctx, steric, width, quadsegs)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return space.Polygon{}, nil
+	}
+	return space.ToGeometry(result)
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (g *megrezAlgorithm) BufferInMeter(geom space.Geometry, width float64, quadsegs int) (geometry space.Geometry) {
+func (g *megrezAlgorithm) BufferInMeter(geom space.Geometry, width float64, quadsegs int) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
 	return geom.BufferInMeter(width, quadsegs)
 }
 
@@ -61,6 +101,44 @@ func (g *megrezAlgorithm) Envelope(geom space.Geometry) (space.Geometry, error)
 	}
 }
 
+// Circle a circle given by its center, the point where it touches the nearest facet and its radius.
+type Circle struct {
+	Center, RadiusPoint space.Point
+	Radius              float64
+}
+
+// LargestEmptyCircle computes the largest circle whose center lies in boundary
+// and whose interior does not intersect the obstacles, to within tolerance.
+// Obstacles may be points, lines or polygons. If boundary is nil the convex hull of the obstacles is used.
+func (g *megrezAlgorithm) LargestEmptyCircle(obstacles, boundary space.Geometry, tolerance float64) (*Circle, error) {
+	if obstacles == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	var bound matrix.Steric
+	if boundary != nil {
+		bound = boundary.ToMatrix()
+	}
+	circle, err := buffer.LargestEmptyCircle(obstacles.ToMatrix(), bound, tolerance)
+	if err != nil {
+		return nil, err
+	}
+	return &Circle{space.Point(circle.Center), space.Point(circle.RadiusPoint), circle.Radius}, nil
+}
+
+// MaximumInscribedCircle computes the largest circle contained in a polygonal geometry, to within tolerance.
+// Its center is the pole of inaccessibility, the interior point farthest from the boundary,
+// which is suitable for placing labels.
+func (g *megrezAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*Circle, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	circle, err := buffer.MaximumInscribedCircle(geom.ToMatrix(), tolerance)
+	if err != nil {
+		return nil, err
+	}
+	return &Circle{space.Point(circle.Center), space.Point(circle.RadiusPoint), circle.Radius}, nil
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (g *megrezAlgorithm) PointOnSurface(geom space.Geometry) (space.Geometry, error) {
 	m := buffer.InteriorPoint(geom.ToMatrix())
@@ -1,13 +1,20 @@
 package planar
 
 import (
+	"context"
+	"errors"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/affine"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/debugtools"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Boundary(t *testing.T) {
@@ -116,7 +123,11 @@ func TestAlgorithm_Buffer(t *testing.T) {
 		}
 		t.Run(tt.name, func(t *testing.T) {
 			G := NormalStrategy()
-			gotGeometry := G.Buffer(tt.args.g, tt.args.width, tt.args.quadsegs)
+			gotGeometry, err := G.Buffer(tt.args.g, tt.args.width, tt.args.quadsegs)
+			if err != nil {
+				t.Errorf("GEOAlgorithm.Buffer() error = %v", err)
+				return
+			}
 			isEqual, _ := G.EqualsExact(gotGeometry, tt.want, 0.000001)
 			if !isEqual {
 				t.Errorf("GEOAlgorithm.Buffer() = %v\n, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
@@ -130,6 +141,74 @@ func TestAlgorithm_Buffer(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_BufferContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	geom := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	G := NormalStrategy()
+	want, _ := G.Buffer(geom, 1, 4)
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    space.Geometry
+		wantErr error
+	}{
+		{name: "background", ctx: context.Background(), want: want},
+		{name: "cancelled", ctx: cancelled, wantErr: context.Canceled},
+		{name: "deadline exceeded", ctx: expired, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.BufferContext(tt.ctx, geom, 1, 4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BufferContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BufferContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_BufferError(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       space.Geometry
+		width   float64
+		want    space.Geometry
+		wantErr error
+	}{
+		{name: "nil", g: nil, width: 1, wantErr: spaceerr.ErrNilGeometry},
+		{name: "empty point", g: space.Point{}, width: 1, wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "empty polygon", g: space.Polygon{}, width: 1, wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "zero width point", g: space.Point{1, 1}, width: 0, want: space.Polygon{}},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.Buffer(tt.g, tt.width, 4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Buffer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Buffer() = %v, want %v", got, tt.want)
+			}
+			got, err = G.BufferContext(context.Background(), tt.g, tt.width, 4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BufferContext() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BufferContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_Centroid(t *testing.T) {
 	const multipoint = `MULTIPOINT ( -1 0, -1 2, -1 3, -1 4, -1 7, 0 1, 0 3, 1 1, 2 0, 6 0, 7 8, 9 8, 10 6 )`
 	geometry, _ := wkt.UnmarshalString(multipoint)
@@ -443,7 +522,11 @@ func TestAlgorithm_BufferInMeter(t *testing.T) {
 		}
 		t.Run(tt.name, func(t *testing.T) {
 			g := &megrezAlgorithm{}
-			gotGeometry := g.BufferInMeter(tt.args.geom, tt.args.width, tt.args.quadsegs)
+			gotGeometry, err := g.BufferInMeter(tt.args.geom, tt.args.width, tt.args.quadsegs)
+			if err != nil {
+				t.Errorf("MegrezAlgorithm.BufferInMeter() error = %v", err)
+				return
+			}
 			isEqual, _ := g.EqualsExact(gotGeometry, tt.want, 0.000001)
 			if !isEqual {
 				t.Errorf("MegrezAlgorithm.BufferInMeter() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
@@ -455,3 +538,86 @@ func TestAlgorithm_BufferInMeter(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_MaximumInscribedCircle(t *testing.T) {
+	square, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	multi, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,2 0,2 2,0 2,0 0)),((10 0,20 0,20 10,10 10,10 0)))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 1)`)
+	tests := []struct {
+		name       string
+		g          space.Geometry
+		wantCenter space.Point
+		wantRadius float64
+		wantErr    bool
+	}{
+		{name: "square", g: square, wantCenter: space.Point{5, 5}, wantRadius: 5},
+		{name: "multipolygon", g: multi, wantCenter: space.Point{15, 5}, wantRadius: 5},
+		{name: "line", g: line, wantErr: true},
+		{name: "nil", g: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalStrategy().MaximumInscribedCircle(tt.g, 0.001)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MaximumInscribedCircle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !got.Center.EqualsExact(tt.wantCenter, 0.001) || math.Abs(got.Radius-tt.wantRadius) > 0.001 {
+				t.Errorf("MaximumInscribedCircle() = %v, want %v %v", got, tt.wantCenter, tt.wantRadius)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_LargestEmptyCircle(t *testing.T) {
+	points, _ := wkt.UnmarshalString(`MULTIPOINT(0 0,10 0,10 10,0 10)`)
+	point, _ := wkt.UnmarshalString(`POINT(5 5)`)
+	square, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	tests := []struct {
+		name       string
+		obstacles  space.Geometry
+		boundary   space.Geometry
+		wantRadius float64
+	}{
+		{name: "points", obstacles: points, wantRadius: 5 * math.Sqrt2},
+		{name: "point in boundary", obstacles: point, boundary: square, wantRadius: 5 * math.Sqrt2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalStrategy().LargestEmptyCircle(tt.obstacles, tt.boundary, 0.001)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got.Radius-tt.wantRadius) > 0.001 {
+				t.Errorf("LargestEmptyCircle() radius = %v, want %v", got.Radius, tt.wantRadius)
+			}
+			if d, _ := got.Center.Distance(got.RadiusPoint); math.Abs(d-got.Radius) > 1e-9 {
+				t.Errorf("LargestEmptyCircle() radius point = %v, at %v from the center", got.RadiusPoint, d)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_AffineTransform(t *testing.T) {
+	plan := space.Polygon{{{0, 0}, {20, 0}, {20, 10}, {0, 10}, {0, 0}}}
+	trans, err := affine.FromControlPoints(
+		[3]matrix.Matrix{{0, 0}, {20, 0}, {0, 10}},
+		[3]matrix.Matrix{{116.3, 39.9}, {116.3002, 39.9}, {116.3, 39.9001}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	G := NormalStrategy()
+	got, err := G.AffineTransform(plan, trans)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := space.Polygon{{{116.3, 39.9}, {116.3002, 39.9}, {116.3002, 39.9001}, {116.3, 39.9001}, {116.3, 39.9}}}
+	if !got.EqualsExact(want, 1e-9) {
+		t.Errorf("AffineTransform() = %v, want %v", got, want)
+	}
+	if _, err := G.AffineTransform(nil, trans); err != spaceerr.ErrNilGeometry {
+		t.Errorf("AffineTransform() error = %v, want %v", err, spaceerr.ErrNilGeometry)
+	}
+}
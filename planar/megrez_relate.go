@@ -1,6 +1,7 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -59,6 +60,18 @@ func (g *megrezAlgorithm) Relate(s, d space.Geometry) (string, error) {
 	return g.topog.Relate(s, d)
 }
 
+// RelatePattern returns TRUE if the DE-9IM matrix for the spatial relationship between
+// the two geometries matches the pattern, such as "T*F**F***".
+func (g *megrezAlgorithm) RelatePattern(s, d space.Geometry, pattern string) (bool, error) {
+	return g.topog.RelatePattern(s, d, pattern)
+}
+
+// RelateWithRule computes the DE-9IM matrix for the spatial relationship between
+// the two geometries, the boundaries of lines given by the boundary node rule.
+func (g *megrezAlgorithm) RelateWithRule(s, d space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	return g.topog.RelateWithRule(s, d, rule)
+}
+
 // Touches returns TRUE if the only points in common between A and B lie in the union of the boundaries of A and B.
 // The touches relation applies to all Area/Area, Line/Line, Line/Area, Point/Area and Point/Line pairs of relationships,
 // but not to the Point/Point pair.
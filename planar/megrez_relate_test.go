@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
 	"github.com/spatial-go/geoos/space/topograph"
@@ -532,3 +533,62 @@ func Test_megrezAlgorithm_Adjacency(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_RelatePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    args
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		{"within", args{space.Polygon{{{2, 2}, {3, 2}, {3, 3}, {2, 3}, {2, 2}}},
+			space.Polygon{{{1, 1}, {5, 1}, {5, 5}, {1, 5}, {1, 1}}}}, "T*F**F***", true, false},
+		{"contains", args{space.Polygon{{{2, 2}, {3, 2}, {3, 3}, {2, 3}, {2, 2}}},
+			space.Polygon{{{1, 1}, {5, 1}, {5, 5}, {1, 5}, {1, 1}}}}, "T*****FF*", false, false},
+		{"length", args{space.Point{0, 0}, space.Point{0, 0}}, "T*F**F**", false, true},
+		{"symbol", args{space.Point{0, 0}, space.Point{0, 0}}, "T*F**F**X", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalStrategy().RelatePattern(tt.args.g1, tt.args.g2, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RelatePattern() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RelatePattern() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_RelateWithRule(t *testing.T) {
+	network := space.MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{1, 0}, {1, 1}}}
+	tests := []struct {
+		name    string
+		args    args
+		rule    relate.BoundaryNodeRule
+		want    string
+		wantErr bool
+	}{
+		{"mod2", args{space.Point{1, 0}, network}, relate.Mod2, "F0FFFF102", false},
+		{"endpoint", args{space.Point{1, 0}, network}, relate.EndPoint, "F0FFFF102", false},
+		{"monovalent", args{space.Point{1, 0}, network}, relate.MonovalentEndPoint, "0FFFFF102", false},
+		{"closed endpoint", args{space.Point{0, 0}, space.LineString{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			relate.EndPoint, "F0FFFF1F2", false},
+		{"rule", args{space.Point{1, 0}, network}, relate.BoundaryNodeRule(4), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalStrategy().RelateWithRule(tt.args.g1, tt.args.g2, tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RelateWithRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RelateWithRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package planar
 
 import (
+	"context"
 	"testing"
 
 	"github.com/spatial-go/geoos/geoencoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_IsClosed(t *testing.T) {
@@ -201,3 +203,117 @@ func TestAlgorithm_IsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func FuzzAlgorithm(f *testing.F) {
+	f.Add(`POINT(1 1)`)
+	f.Add(`LINESTRING(1 1,2 3,3 2,1 2)`)
+	f.Add(`LINESTRING EMPTY`)
+	f.Add(`POLYGON((0 0,10 0,10 10,0 10,0 0),(1 1,2 1,2 2,1 1))`)
+	f.Add(`POLYGON((0 0,0 10,10 10,10 0,0 0))`)
+	f.Add(`MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((5 5,6 5,6 6,5 5)))`)
+	f.Add(`GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 1,2 2),POLYGON((0 0,1 0,1 1,0 0)))`)
+	f.Add(`POLYGON((0 0,1 1))`)
+	f.Add(`POLYGON EMPTY`)
+	f.Add(`POLYGON((1 0,1 0))`)
+	f.Add(`POLYGON((0 0,1 0,0 100000000000,0 0))`)
+	f.Add(`POLYGON((0 1,0 1,0 1,0 1))`)
+	f.Fuzz(func(t *testing.T, s string) {
+		geom, err := wkt.UnmarshalString(s)
+		if err != nil || geom == nil {
+			return
+		}
+		for _, v := range fuzzVariants(geom) {
+			fuzzTargets(v)
+		}
+	})
+}
+
+func TestAlgorithm_NoPanic(t *testing.T) {
+	shell := space.Ring{{0, 0}, {5, 0}, {5, 5}, {0, 0}}
+	for _, geom := range []space.Geometry{
+		space.LineString{}, space.Polygon{{}}, space.Polygon{shell, {}}, space.MultiLineString{{}},
+	} {
+		for _, v := range fuzzVariants(geom) {
+			fuzzTargets(v)
+		}
+	}
+}
+
+func TestAlgorithm_EmptyGeometry(t *testing.T) {
+	G := NormalStrategy()
+	line := space.LineString{}
+	square := space.Polygon{{{0, 0}, {5, 0}, {5, 5}, {0, 5}, {0, 0}}}
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{name: "boundary", fn: func() error { _, err := G.Boundary(line); return err }},
+		{name: "is closed", fn: func() error { _, err := G.IsClosed(line); return err }},
+		{name: "is ring", fn: func() error { _, err := G.IsRing(line); return err }},
+		{name: "hausdorff", fn: func() error { _, err := G.HausdorffDistance(line, square); return err }},
+		{name: "hausdorff densify", fn: func() error { _, err := G.HausdorffDistanceDensify(square, line, 0.5); return err }},
+		{name: "buffer", fn: func() error { _, err := G.Buffer(space.Polygon{{}}, 1, 4); return err }},
+		{name: "buffer context", fn: func() error {
+			_, err := G.BufferContext(context.Background(), space.Polygon{{}}, 1, 4)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(); err != spaceerr.ErrEmptyGeometry {
+				t.Errorf("error = %v, want %v", err, spaceerr.ErrEmptyGeometry)
+			}
+		})
+	}
+}
+
+// fuzzVariants returns the geometry, the geometry wrapped in a GeometryValid,
+// and a circle around a point.
+func fuzzVariants(geom space.Geometry) []space.Geometry {
+	result := []space.Geometry{geom, &space.GeometryValid{Geometry: geom}}
+	if p, ok := geom.(space.Point); ok {
+		if circle, err := space.CreateCircle(p, 1); err == nil {
+			result = append(result, circle, &space.GeometryValid{Geometry: circle})
+		}
+	}
+	return result
+}
+
+// fuzzTargets runs the algorithms on the geometry, they must return errors and not panic.
+func fuzzTargets(geom space.Geometry) {
+	G := NormalStrategy()
+	square := space.Polygon{{{0, 0}, {5, 0}, {5, 5}, {0, 5}, {0, 0}}}
+	circle, _ := space.CreateCircle(space.Point{1, 1}, 2)
+	_, _ = G.Area(geom)
+	_, _ = G.Length(geom)
+	_, _ = G.Boundary(geom)
+	_, _ = G.Buffer(geom, 1, 4)
+	_, _ = G.BufferContext(context.Background(), geom, 1, 4)
+	_, _ = G.Centroid(geom)
+	_, _ = G.ConvexHull(geom)
+	_, _ = G.Envelope(geom)
+	_, _ = G.PointOnSurface(geom)
+	_, _ = G.IsSimple(geom)
+	_, _ = G.IsClosed(geom)
+	_, _ = G.IsRing(geom)
+	_, _ = G.UniquePoints(geom)
+	_, _ = G.Simplify(geom, 0.1)
+	_, _ = G.NGeometry(geom)
+	_, _ = G.UnaryUnion(geom)
+	_, _ = space.CreateElementValid(geom)
+	for _, other := range []space.Geometry{square, circle} {
+		_, _ = G.Equals(geom, other)
+		_, _ = G.Equals(other, geom)
+		_, _ = G.EqualsExact(geom, other, 0)
+		_, _ = G.EqualsExact(other, geom, 0)
+		_, _ = G.Distance(geom, other)
+		_, _ = G.HausdorffDistance(geom, other)
+		_, _ = G.HausdorffDistance(other, geom)
+		_, _ = G.HausdorffDistanceDensify(geom, other, 0.5)
+		_, _ = G.Intersects(geom, other)
+		_, _ = G.Relate(geom, other)
+		_, _ = G.Union(geom, other)
+		_, _ = G.Intersection(geom, other)
+		_, _ = G.Difference(geom, other)
+	}
+}
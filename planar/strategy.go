@@ -3,6 +3,7 @@ package planar
 import (
 	"sync"
 
+	"github.com/spatial-go/geoos/algorithm/precision"
 	"github.com/spatial-go/geoos/space/topograph"
 )
 
@@ -16,6 +17,12 @@ func NormalStrategy() Algorithm {
 	return GetStrategy(NewMegrezAlgorithm)
 }
 
+// PrecisionStrategy returns normal algorithm whose overlay operations use the precision model,
+// with a fixed precision model polygonal overlays are snap-rounded to its grid.
+func PrecisionStrategy(model precision.Model) Algorithm {
+	return &megrezAlgorithm{topog: topograph.NormalRelationship(), precision: model}
+}
+
 // GetStrategy returns  algorithm by new Algorithm.
 func GetStrategy(f newAlgorithm) Algorithm {
 	return f()
@@ -24,7 +31,7 @@ func GetStrategy(f newAlgorithm) Algorithm {
 // NewMegrezAlgorithm returns Algorithm that is MegrezAlgorithm.
 func NewMegrezAlgorithm() Algorithm {
 	once.Do(func() {
-		algorithmMegrez = &megrezAlgorithm{topograph.NormalRelationship()}
+		algorithmMegrez = &megrezAlgorithm{topog: topograph.NormalRelationship()}
 	})
 	return algorithmMegrez
 }
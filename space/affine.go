@@ -0,0 +1,101 @@
+package space
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/affine"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// AffineTransform returns a copy of the geometry with all its coordinates transformed.
+// A bound is transformed to the bound of its transformed corners if the transformation keeps
+// axis-aligned rectangles axis-aligned, or else to the polygon of its transformed corners,
+// a circle keeps its type with the centre transformed and the radius scaled by the square root of the determinant
+// if the transformation is a similarity, or else is transformed as a polygon.
+func AffineTransform(geom Geometry, t affine.AffineTransformation) (Geometry, error) {
+	switch g := geom.(type) {
+	case nil:
+		return nil, spaceerr.ErrNilGeometry
+	case *GeometryValid:
+		if g == nil {
+			return nil, spaceerr.ErrNilGeometry
+		}
+		result, err := AffineTransform(g.Geometry, t)
+		if err != nil {
+			return nil, err
+		}
+		return &GeometryValid{Geometry: result, coordinateSystem: g.coordinateSystem, precisionModel: g.precisionModel}, nil
+	case Bound:
+		if len(g.Min) < 2 || len(g.Max) < 2 || g.IsEmpty() {
+			return g, nil
+		}
+		if !keepsAxes(t) {
+			return AffineTransform(g.ToPolygon(), t)
+		}
+		bound := Bound{Min: Point(t.Transform(matrix.Matrix(g.Min))), Max: Point(t.Transform(matrix.Matrix(g.Min)))}
+		for _, v := range g.ToRing()[1:] {
+			bound = bound.Extend(Point(t.Transform(matrix.Matrix(v))))
+		}
+		return bound, nil
+	case *Circle:
+		if g == nil {
+			return nil, spaceerr.ErrNilGeometry
+		}
+		poly := Polygon(t.TransformSteric(matrix.PolygonMatrix(g.Polygon)).(matrix.PolygonMatrix))
+		if !t.IsSimilarity() {
+			return poly, nil
+		}
+		return &Circle{
+			Polygon:  poly,
+			Centre:   Point(t.Transform(matrix.Matrix(g.Centre))),
+			Radius:   g.Radius * math.Sqrt(math.Abs(t.Determinant())),
+			Segments: g.Segments,
+		}, nil
+	case Point:
+		return Point(t.Transform(matrix.Matrix(g))), nil
+	case LineString:
+		return LineString(t.TransformSteric(matrix.LineMatrix(g)).(matrix.LineMatrix)), nil
+	case Ring:
+		return Ring(t.TransformSteric(matrix.LineMatrix(g)).(matrix.LineMatrix)), nil
+	case Polygon:
+		return Polygon(t.TransformSteric(matrix.PolygonMatrix(g)).(matrix.PolygonMatrix)), nil
+	case MultiPoint:
+		mp := make(MultiPoint, len(g))
+		for i, v := range g {
+			mp[i] = Point(t.Transform(matrix.Matrix(v)))
+		}
+		return mp, nil
+	case MultiLineString:
+		mls := make(MultiLineString, len(g))
+		for i, v := range g {
+			mls[i] = LineString(t.TransformSteric(matrix.LineMatrix(v)).(matrix.LineMatrix))
+		}
+		return mls, nil
+	case MultiPolygon:
+		mp := make(MultiPolygon, len(g))
+		for i, v := range g {
+			mp[i] = Polygon(t.TransformSteric(matrix.PolygonMatrix(v)).(matrix.PolygonMatrix))
+		}
+		return mp, nil
+	case Collection:
+		coll := make(Collection, len(g))
+		for i, v := range g {
+			result, err := AffineTransform(v, t)
+			if err != nil {
+				return nil, err
+			}
+			coll[i] = result
+		}
+		return coll, nil
+	}
+	return ToGeometry(t.TransformSteric(geom.ToMatrix()))
+}
+
+// keepsAxes tests whether the transformation maps axis-aligned rectangles to axis-aligned rectangles,
+// scaling or swapping the axes, to within the rounding of a rotation by a multiple of a right angle.
+func keepsAxes(t affine.AffineTransformation) bool {
+	zero := func(v float64) bool { return math.Abs(v) <= calc.AccuracyFloat }
+	return zero(t.M01) && zero(t.M10) || zero(t.M00) && zero(t.M11)
+}
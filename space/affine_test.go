@@ -0,0 +1,103 @@
+package space
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/affine"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestAffineTransform(t *testing.T) {
+	circle, err := CreateCircle(Point{0, 0}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		geom    Geometry
+		trans   affine.AffineTransformation
+		want    Geometry
+		wantErr error
+	}{
+		{name: "point", geom: Point{1, 2}, trans: affine.Translation(1, 1), want: Point{2, 3}},
+		{name: "line", geom: LineString{{0, 0}, {1, 0}}, trans: affine.Scaling(2, 1), want: LineString{{0, 0}, {2, 0}}},
+		{name: "ring", geom: Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, trans: affine.Translation(1, 0),
+			want: Ring{{1, 0}, {2, 0}, {2, 1}, {1, 0}}},
+		{name: "polygon", geom: Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, trans: affine.Reflection(0, 0, 1, 0),
+			want: Polygon{{{0, 0}, {1, 0}, {1, -1}, {0, 0}}}},
+		{name: "multi point", geom: MultiPoint{{0, 0}, {1, 1}}, trans: affine.Translation(1, 1), want: MultiPoint{{1, 1}, {2, 2}}},
+		{name: "multi line", geom: MultiLineString{{{0, 0}, {1, 1}}}, trans: affine.Translation(1, 1),
+			want: MultiLineString{{{1, 1}, {2, 2}}}},
+		{name: "multi polygon", geom: MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}, trans: affine.Translation(0, 1),
+			want: MultiPolygon{{{{0, 1}, {1, 1}, {1, 2}, {0, 1}}}}},
+		{name: "collection", geom: Collection{Point{0, 0}, Bound{Min: Point{0, 0}, Max: Point{1, 1}}}, trans: affine.Translation(1, 1),
+			want: Collection{Point{1, 1}, Bound{Min: Point{1, 1}, Max: Point{2, 2}}}},
+		{name: "bound rotate", geom: Bound{Min: Point{0, 0}, Max: Point{2, 1}}, trans: affine.Rotation(math.Pi),
+			want: Bound{Min: Point{-2, -1}, Max: Point{0, 0}}},
+		{name: "bound swap", geom: Bound{Min: Point{0, 0}, Max: Point{2, 1}}, trans: affine.Reflection(0, 0, 1, 1),
+			want: Bound{Min: Point{0, 0}, Max: Point{1, 2}}},
+		{name: "bound skew", geom: Bound{Min: Point{0, 0}, Max: Point{1, 1}}, trans: affine.Skewing(1, 0),
+			want: Polygon{{{0, 0}, {1, 0}, {2, 1}, {1, 1}, {0, 0}}}},
+		{name: "bound rotate quarter", geom: Bound{Min: Point{0, 0}, Max: Point{1, 1}}, trans: affine.Rotation(math.Pi / 4),
+			want: Polygon{{{0, 0}, {math.Sqrt2 / 2, math.Sqrt2 / 2}, {0, math.Sqrt2}, {-math.Sqrt2 / 2, math.Sqrt2 / 2}, {0, 0}}}},
+		{name: "nil", geom: nil, trans: affine.Identity(), wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AffineTransform(tt.geom, tt.trans)
+			if err != tt.wantErr {
+				t.Fatalf("AffineTransform() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !got.EqualsExact(tt.want, 1e-12) {
+				t.Errorf("AffineTransform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	t.Run("circle", func(t *testing.T) {
+		got, err := AffineTransform(circle, affine.Scaling(2, 2).Translate(10, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, ok := got.(*Circle)
+		if !ok || !c.Centre.Equals(Point{10, 0}) || c.Radius != 2 || !reflect.DeepEqual(c.Polygon[0][0], []float64{12, 0}) {
+			t.Errorf("AffineTransform() = %v, want circle of radius 2 at {10 0}", got)
+		}
+	})
+	t.Run("circle skew", func(t *testing.T) {
+		got, err := AffineTransform(circle, affine.Skewing(1, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := Polygon(affine.Skewing(1, 0).TransformSteric(circle.Polygon.ToMatrix()).(matrix.PolygonMatrix))
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("AffineTransform() = %v, want polygon %v", got, want)
+		}
+		if got, err = AffineTransform(circle, affine.Scaling(2, 1)); err != nil || got.GeoJSONType() != TypePolygon {
+			t.Errorf("AffineTransform() = %T, want polygon", got)
+		} else if _, ok := got.(*Circle); ok {
+			t.Errorf("AffineTransform() = %T, want polygon", got)
+		}
+	})
+	t.Run("circle reflect", func(t *testing.T) {
+		got, err := AffineTransform(circle, affine.Reflection(0, 0, 1, 0).Rotate(0.3))
+		if c, ok := got.(*Circle); err != nil || !ok || math.Abs(c.Radius-1) > 1e-12 {
+			t.Errorf("AffineTransform() = %v, want circle of radius 1", got)
+		}
+	})
+	t.Run("geometry valid", func(t *testing.T) {
+		valid, err := CreateElementValidWithCoordSys(Point{1, 1}, WGS84)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := AffineTransform(valid, affine.Translation(1, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, ok := got.(*GeometryValid); !ok || v.CoordinateSystem() != WGS84 || !v.Geom().Equals(Point{2, 2}) {
+			t.Errorf("AffineTransform() = %v, want valid point {2 2}", got)
+		}
+	})
+}
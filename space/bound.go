@@ -256,13 +256,13 @@ func (b Bound) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (b Bound) Buffer(width float64, quadsegs int) Geometry {
+func (b Bound) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return b.ToPolygon().Buffer(width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (b Bound) BufferInMeter(width float64, quadsegs int) Geometry {
+func (b Bound) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return b.ToPolygon().BufferInMeter(width, quadsegs)
 }
 
@@ -322,3 +322,28 @@ func (b Bound) Filter(f matrix.Filter) Geometry {
 func (b Bound) Geom() Geometry {
 	return b
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (b Bound) ForceCCW() Geometry {
+	return b
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (b Bound) ForceCW() Geometry {
+	return b
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (b Bound) ForceRHR() Geometry {
+	return b.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (b Bound) Reverse() Geometry {
+	return b
+}
+
+// Normalize returns the geometry in canonical form.
+func (b Bound) Normalize() Geometry {
+	return b
+}
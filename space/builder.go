@@ -0,0 +1,341 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// PolygonBuilder builds a polygon from a shell and holes.
+// Rings are closed and oriented CCW for the shell and CW for holes on build,
+// the coordinates are copied so the built polygon shares nothing with the inputs.
+type PolygonBuilder struct {
+	shell    []Point
+	holes    [][]Point
+	coordSys int
+}
+
+// NewPolygonBuilder returns a polygon builder with the default coordinate system.
+func NewPolygonBuilder() *PolygonBuilder {
+	return &PolygonBuilder{coordSys: defaultCoordinateSystem()}
+}
+
+// Shell sets the shell of the polygon.
+func (b *PolygonBuilder) Shell(pts ...Point) *PolygonBuilder {
+	b.shell = pts
+	return b
+}
+
+// Hole adds a hole to the polygon.
+func (b *PolygonBuilder) Hole(pts ...Point) *PolygonBuilder {
+	b.holes = append(b.holes, pts)
+	return b
+}
+
+// CoordinateSystem sets the coordinate system of the built geometry.
+func (b *PolygonBuilder) CoordinateSystem(coordSys int) *PolygonBuilder {
+	b.coordSys = coordSys
+	return b
+}
+
+// Polygon returns the normalized polygon, error if the polygon is empty or a ring is not valid.
+func (b *PolygonBuilder) Polygon() (Polygon, error) {
+	rings := make([][]Point, 0, len(b.holes)+1)
+	rings = append(rings, b.shell)
+	rings = append(rings, b.holes...)
+	return buildPolygon(rings)
+}
+
+// Build returns the valid polygon tagged with the coordinate system.
+func (b *PolygonBuilder) Build() (*GeometryValid, error) {
+	poly, err := b.Polygon()
+	if err != nil {
+		return nil, err
+	}
+	return CreateElementValidWithCoordSys(poly, b.coordSys)
+}
+
+// MultiPointBuilder builds a multi point.
+type MultiPointBuilder struct {
+	points   []Point
+	coordSys int
+}
+
+// NewMultiPointBuilder returns a multi point builder with the default coordinate system.
+func NewMultiPointBuilder() *MultiPointBuilder {
+	return &MultiPointBuilder{coordSys: defaultCoordinateSystem()}
+}
+
+// Point adds points to the multi point.
+func (b *MultiPointBuilder) Point(pts ...Point) *MultiPointBuilder {
+	b.points = append(b.points, pts...)
+	return b
+}
+
+// CoordinateSystem sets the coordinate system of the built geometry.
+func (b *MultiPointBuilder) CoordinateSystem(coordSys int) *MultiPointBuilder {
+	b.coordSys = coordSys
+	return b
+}
+
+// Build returns the valid multi point tagged with the coordinate system.
+func (b *MultiPointBuilder) Build() (*GeometryValid, error) {
+	if len(b.points) == 0 {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	mp := make(MultiPoint, len(b.points))
+	for i, v := range b.points {
+		p, err := copyPoint(v)
+		if err != nil {
+			return nil, err
+		}
+		mp[i] = p
+	}
+	return CreateElementValidWithCoordSys(mp, b.coordSys)
+}
+
+// MultiLineStringBuilder builds a multi line string.
+type MultiLineStringBuilder struct {
+	lines    [][]Point
+	coordSys int
+}
+
+// NewMultiLineStringBuilder returns a multi line string builder with the default coordinate system.
+func NewMultiLineStringBuilder() *MultiLineStringBuilder {
+	return &MultiLineStringBuilder{coordSys: defaultCoordinateSystem()}
+}
+
+// LineString adds a line string to the multi line string.
+func (b *MultiLineStringBuilder) LineString(pts ...Point) *MultiLineStringBuilder {
+	b.lines = append(b.lines, pts)
+	return b
+}
+
+// CoordinateSystem sets the coordinate system of the built geometry.
+func (b *MultiLineStringBuilder) CoordinateSystem(coordSys int) *MultiLineStringBuilder {
+	b.coordSys = coordSys
+	return b
+}
+
+// Build returns the valid multi line string tagged with the coordinate system.
+func (b *MultiLineStringBuilder) Build() (*GeometryValid, error) {
+	if len(b.lines) == 0 {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	mls := make(MultiLineString, len(b.lines))
+	for i, v := range b.lines {
+		line, err := copyLine(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) < 2 {
+			return nil, spaceerr.ErrNotValidGeometry
+		}
+		mls[i] = LineString(line)
+	}
+	return CreateElementValidWithCoordSys(mls, b.coordSys)
+}
+
+// MultiPolygonBuilder builds a multi polygon, each polygon is normalized as PolygonBuilder.
+type MultiPolygonBuilder struct {
+	polygons []Polygon
+	coordSys int
+}
+
+// NewMultiPolygonBuilder returns a multi polygon builder with the default coordinate system.
+func NewMultiPolygonBuilder() *MultiPolygonBuilder {
+	return &MultiPolygonBuilder{coordSys: defaultCoordinateSystem()}
+}
+
+// Polygon adds polygons to the multi polygon.
+func (b *MultiPolygonBuilder) Polygon(polys ...Polygon) *MultiPolygonBuilder {
+	b.polygons = append(b.polygons, polys...)
+	return b
+}
+
+// CoordinateSystem sets the coordinate system of the built geometry.
+func (b *MultiPolygonBuilder) CoordinateSystem(coordSys int) *MultiPolygonBuilder {
+	b.coordSys = coordSys
+	return b
+}
+
+// Build returns the valid multi polygon tagged with the coordinate system.
+func (b *MultiPolygonBuilder) Build() (*GeometryValid, error) {
+	if len(b.polygons) == 0 {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	mp, err := normalizeMultiPolygon(b.polygons)
+	if err != nil {
+		return nil, err
+	}
+	return CreateElementValidWithCoordSys(mp, b.coordSys)
+}
+
+// CollectionBuilder builds a geometry collection,
+// polygons of the collection are normalized as PolygonBuilder.
+type CollectionBuilder struct {
+	geoms    []Geometry
+	coordSys int
+}
+
+// NewCollectionBuilder returns a collection builder with the default coordinate system.
+func NewCollectionBuilder() *CollectionBuilder {
+	return &CollectionBuilder{coordSys: defaultCoordinateSystem()}
+}
+
+// Geometry adds geometries to the collection.
+func (b *CollectionBuilder) Geometry(geoms ...Geometry) *CollectionBuilder {
+	b.geoms = append(b.geoms, geoms...)
+	return b
+}
+
+// CoordinateSystem sets the coordinate system of the built geometry.
+func (b *CollectionBuilder) CoordinateSystem(coordSys int) *CollectionBuilder {
+	b.coordSys = coordSys
+	return b
+}
+
+// Build returns the valid collection tagged with the coordinate system.
+func (b *CollectionBuilder) Build() (*GeometryValid, error) {
+	if len(b.geoms) == 0 {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	coll, err := normalizeCollection(b.geoms)
+	if err != nil {
+		return nil, err
+	}
+	return CreateElementValidWithCoordSys(coll, b.coordSys)
+}
+
+// buildPolygon returns the polygon of rings, the first ring is the shell.
+func buildPolygon(rings [][]Point) (Polygon, error) {
+	if len(rings) == 0 || len(rings[0]) == 0 {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	poly := make(Polygon, len(rings))
+	for i, v := range rings {
+		ring, err := buildRing(v, i == 0)
+		if err != nil {
+			return nil, err
+		}
+		poly[i] = ring
+	}
+	if err := matrix.PolygonMatrix(poly).ValidRings(); err != nil {
+		return nil, err
+	}
+	return poly, nil
+}
+
+// buildRing returns the closed ring of points oriented CCW if ccw is true, or CW.
+func buildRing(pts []Point, ccw bool) (matrix.LineMatrix, error) {
+	ring, err := copyLine(pts)
+	if err != nil {
+		return nil, err
+	}
+	if len(ring) > 0 && !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+		ring = append(ring, append([]float64{}, ring[0]...))
+	}
+	return orientRing(ring, ccw), nil
+}
+
+// normalizeMultiPolygon returns the multi polygon with each polygon normalized.
+func normalizeMultiPolygon(polys []Polygon) (MultiPolygon, error) {
+	mp := make(MultiPolygon, len(polys))
+	for i, v := range polys {
+		poly, err := normalizePolygon(v)
+		if err != nil {
+			return nil, err
+		}
+		mp[i] = poly
+	}
+	return mp, nil
+}
+
+// normalizePolygon returns the polygon with closed and oriented rings.
+func normalizePolygon(p Polygon) (Polygon, error) {
+	rings := make([][]Point, len(p))
+	for i, v := range p {
+		rings[i] = make([]Point, len(v))
+		for j, pt := range v {
+			rings[i][j] = pt
+		}
+	}
+	return buildPolygon(rings)
+}
+
+// normalizeCollection returns a copy of the geometries with polygons normalized.
+func normalizeCollection(geoms []Geometry) (Collection, error) {
+	coll := make(Collection, len(geoms))
+	for i, v := range geoms {
+		var err error
+		switch g := v.(type) {
+		case nil:
+			return nil, spaceerr.ErrNilGeometry
+		case Point:
+			coll[i], err = copyPoint(g)
+		case LineString:
+			var line matrix.LineMatrix
+			line, err = copyLine(lineToPoints(g))
+			coll[i] = LineString(line)
+		case MultiPoint:
+			mp := make(MultiPoint, len(g))
+			for j, p := range g {
+				if mp[j], err = copyPoint(p); err != nil {
+					break
+				}
+			}
+			coll[i] = mp
+		case MultiLineString:
+			mls := make(MultiLineString, len(g))
+			for j, l := range g {
+				var line matrix.LineMatrix
+				if line, err = copyLine(lineToPoints(l)); err != nil {
+					break
+				}
+				mls[j] = LineString(line)
+			}
+			coll[i] = mls
+		case Polygon:
+			coll[i], err = normalizePolygon(g)
+		case MultiPolygon:
+			coll[i], err = normalizeMultiPolygon(g)
+		case Collection:
+			coll[i], err = normalizeCollection(g)
+		default:
+			coll[i] = v
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coll, nil
+}
+
+// lineToPoints returns the points of the line.
+func lineToPoints(line LineString) []Point {
+	pts := make([]Point, len(line))
+	for i, v := range line {
+		pts[i] = v
+	}
+	return pts
+}
+
+// copyLine returns a copy of points as line matrix, error if a point is not valid.
+func copyLine(pts []Point) (matrix.LineMatrix, error) {
+	line := make(matrix.LineMatrix, len(pts))
+	for i, v := range pts {
+		p, err := copyPoint(v)
+		if err != nil {
+			return nil, err
+		}
+		line[i] = p
+	}
+	return line, nil
+}
+
+// copyPoint returns a copy of point, error if the point is not valid.
+func copyPoint(p Point) (Point, error) {
+	if !p.IsValid() {
+		return nil, spaceerr.ErrNotValidGeometry
+	}
+	return append(Point{}, p...), nil
+}
@@ -0,0 +1,116 @@
+package space
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestPolygonBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name         string
+		builder      *PolygonBuilder
+		want         Geometry
+		wantCoordSys int
+		wantErr      error
+	}{
+		{name: "close and orient", builder: NewPolygonBuilder().
+			Shell(Point{0, 0}, Point{0, 10}, Point{10, 10}, Point{10, 0}).
+			Hole(Point{2, 2}, Point{4, 2}, Point{4, 4}, Point{2, 4}),
+			want: Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+			wantCoordSys: GCJ02},
+		{name: "closed shell", builder: NewPolygonBuilder().
+			Shell(Point{0, 0}, Point{1, 0}, Point{1, 1}, Point{0, 0}).CoordinateSystem(WGS84),
+			want:         Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			wantCoordSys: WGS84},
+		{name: "no shell", builder: NewPolygonBuilder(), wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "invalid point", builder: NewPolygonBuilder().Shell(Point{0, 0}, Point{1}, Point{1, 1}),
+			wantErr: spaceerr.ErrNotValidGeometry},
+		{name: "too few points", builder: NewPolygonBuilder().Shell(Point{0, 0}, Point{1, 1}),
+			wantErr: algorithm.ErrTopology},
+		{name: "collapsed hole", builder: NewPolygonBuilder().
+			Shell(Point{0, 0}, Point{1, 0}, Point{1, 1}).Hole(Point{0, 0}, Point{1, 1}, Point{2, 2}),
+			wantErr: algorithm.ErrTopology},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.Build()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PolygonBuilder.Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got.Geom(), tt.want) {
+				t.Errorf("PolygonBuilder.Build() = %v, want %v", got.Geom(), tt.want)
+			}
+			if got.CoordinateSystem() != tt.wantCoordSys {
+				t.Errorf("PolygonBuilder.Build() coordinate system = %v, want %v", got.CoordinateSystem(), tt.wantCoordSys)
+			}
+		})
+	}
+}
+
+func TestPolygonBuilder_Immutable(t *testing.T) {
+	shell := []Point{{0, 0}, {1, 0}, {1, 1}}
+	builder := NewPolygonBuilder().Shell(shell...)
+	poly, err := builder.Polygon()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shell[2][1] = 2
+	again, err := builder.Polygon()
+	if err != nil {
+		t.Fatal(err)
+	}
+	poly[0][1][0] = 7
+	if want := (Polygon{{{0, 0}, {7, 0}, {1, 1}, {0, 0}}}); !reflect.DeepEqual(poly, want) {
+		t.Errorf("PolygonBuilder.Polygon() = %v, want %v", poly, want)
+	}
+	if want := (Polygon{{{0, 0}, {1, 0}, {1, 2}, {0, 0}}}); !reflect.DeepEqual(again, want) {
+		t.Errorf("PolygonBuilder.Polygon() = %v, want %v", again, want)
+	}
+}
+
+func TestMultiBuilders_Build(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func() (*GeometryValid, error)
+		want    Geometry
+		wantErr error
+	}{
+		{name: "multi point", build: NewMultiPointBuilder().Point(Point{0, 0}, Point{1, 1}).Build,
+			want: MultiPoint{{0, 0}, {1, 1}}},
+		{name: "multi point empty", build: NewMultiPointBuilder().Build, wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "multi line", build: NewMultiLineStringBuilder().
+			LineString(Point{0, 0}, Point{1, 1}).LineString(Point{2, 2}, Point{3, 3}).Build,
+			want: MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}},
+		{name: "multi line one point", build: NewMultiLineStringBuilder().LineString(Point{0, 0}).Build,
+			wantErr: spaceerr.ErrNotValidGeometry},
+		{name: "multi polygon", build: NewMultiPolygonBuilder().
+			Polygon(Polygon{{{0, 0}, {0, 1}, {1, 1}}}, Polygon{{{2, 2}, {3, 2}, {3, 3}, {2, 2}}}).Build,
+			want: MultiPolygon{{{{0, 0}, {1, 1}, {0, 1}, {0, 0}}}, {{{2, 2}, {3, 2}, {3, 3}, {2, 2}}}}},
+		{name: "multi polygon empty polygon", build: NewMultiPolygonBuilder().Polygon(Polygon{}).Build,
+			wantErr: spaceerr.ErrEmptyGeometry},
+		{name: "collection", build: NewCollectionBuilder().
+			Geometry(Point{0, 0}, LineString{{0, 0}, {1, 1}}, Polygon{{{0, 0}, {0, 1}, {1, 1}}}).Build,
+			want: Collection{Point{0, 0}, LineString{{0, 0}, {1, 1}}, Polygon{{{0, 0}, {1, 1}, {0, 1}, {0, 0}}}}},
+		{name: "collection nil", build: NewCollectionBuilder().Geometry(nil).Build, wantErr: spaceerr.ErrNilGeometry},
+		{name: "collection empty", build: NewCollectionBuilder().Build, wantErr: spaceerr.ErrEmptyGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.build()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got.Geom(), tt.want) {
+				t.Errorf("Build() = %v, want %v", got.Geom(), tt.want)
+			}
+		})
+	}
+}
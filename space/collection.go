@@ -3,6 +3,7 @@ package space
 import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/simplify"
 	"github.com/spatial-go/geoos/space/spaceerr"
 )
@@ -93,21 +94,20 @@ func (c Collection) EqualsCollection(collection Collection) bool {
 
 // Equals checks if the Collection represents the same Geometry or vector.
 func (c Collection) Equals(g Geometry) bool {
-	if g.GeoJSONType() != c.GeoJSONType() {
-		return false
-	}
-	return c.EqualsCollection(g.(Collection))
+	other, ok := underlying(g).(Collection)
+	return ok && c.EqualsCollection(other)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (c Collection) EqualsExact(g Geometry, tolerance float64) bool {
-	if c.GeoJSONType() != g.GeoJSONType() {
+	other, ok := underlying(g).(Collection)
+	if !ok || len(c) != len(other) {
 		return false
 	}
 	for i, v := range c {
-		if !v.EqualsExact((g.(Collection)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -119,18 +119,12 @@ func (c Collection) Area() (float64, error) {
 	area := 0.0
 	for _, g := range c {
 		switch g.GeoJSONType() {
-		case TypePolygon:
-			if areaOfPolygon, err := g.(Polygon).Area(); err == nil {
+		case TypePolygon, TypeMultiPolygon:
+			if areaOfPolygon, err := g.Area(); err == nil {
 				area += areaOfPolygon
 			} else {
 				return 0, nil
 			}
-		case TypeMultiPolygon:
-			if areaOfMultiPolygon, err := g.(MultiPolygon).Area(); err == nil {
-				area += areaOfMultiPolygon
-			} else {
-				return 0, nil
-			}
 		default:
 
 		}
@@ -194,8 +188,11 @@ func (c Collection) Length() float64 {
 // IsSimple returns true if this space.Geometry has no anomalous geometric points,
 // such as self intersection or self tangency.
 func (c Collection) IsSimple() bool {
-	elem := &GeometryValid{c, GCJ02}
-	return elem.IsSimple()
+	if c.IsEmpty() {
+		return true
+	}
+	vop := &operation.ValidOP{Steric: c.ToMatrix()}
+	return vop.IsSimple()
 }
 
 // Centroid Computes the centroid point of a geometry.
@@ -229,13 +226,13 @@ func (c Collection) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (c Collection) Buffer(width float64, quadsegs int) Geometry {
+func (c Collection) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(c, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (c Collection) BufferInMeter(width float64, quadsegs int) Geometry {
+func (c Collection) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(c, width, quadsegs)
 }
 
@@ -319,3 +316,44 @@ func (c Collection) Filter(f matrix.Filter) Geometry {
 func (c Collection) Geom() Geometry {
 	return c
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (c Collection) ForceCCW() Geometry {
+	return c.each(Geometry.ForceCCW)
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (c Collection) ForceCW() Geometry {
+	return c.each(Geometry.ForceCW)
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (c Collection) ForceRHR() Geometry {
+	return c.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (c Collection) Reverse() Geometry {
+	return c.each(Geometry.Reverse)
+}
+
+// Normalize returns the geometry in canonical form.
+func (c Collection) Normalize() Geometry {
+	result := c.each(Geometry.Normalize)
+	sortGeometries(result)
+	return result
+}
+
+// each returns a collection of f applied to each geometry, nil geometries are kept.
+func (c Collection) each(f func(Geometry) Geometry) Collection {
+	if c == nil {
+		return c
+	}
+	result := make(Collection, len(c))
+	for i, v := range c {
+		if v != nil {
+			result[i] = f(v)
+		}
+	}
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/precision"
 	"github.com/spatial-go/geoos/coordtransform"
 	"github.com/spatial-go/geoos/space/spaceerr"
 )
@@ -44,6 +45,7 @@ type Line struct {
 type GeometryValid struct {
 	Geometry
 	coordinateSystem int
+	precisionModel   precision.Model
 }
 
 // CreateElementValid Returns valid geom element. returns nil if geom is invalid.
@@ -55,16 +57,36 @@ func CreateElementValid(geom Geometry) (*GeometryValid, error) {
 func CreateElementValidWithCoordSys(geom Geometry, coordSys int) (*GeometryValid, error) {
 	geom = geom.Filter(&matrix.UniqueArrayFilter{})
 	if geom.IsValid() {
-		return &GeometryValid{geom, coordSys}, nil
+		return &GeometryValid{Geometry: geom, coordinateSystem: coordSys}, nil
 	}
 	return nil, spaceerr.ErrNotValidGeometry
 }
 
+// CreateElementValidWithPrecision Returns valid geom element with the precision model. returns nil if geom is invalid.
+// The coordinates of geom are rounded to the precision model.
+func CreateElementValidWithPrecision(geom Geometry, coordSys int, model precision.Model) (*GeometryValid, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	geom = TransGeometry(model.MakePreciseSteric(geom.ToMatrix()))
+	valid, err := CreateElementValidWithCoordSys(geom, coordSys)
+	if err != nil {
+		return nil, err
+	}
+	valid.precisionModel = model
+	return valid, nil
+}
+
 // CoordinateSystem return Coordinate System.
 func (g *GeometryValid) CoordinateSystem() int {
 	return g.coordinateSystem
 }
 
+// PrecisionModel return the precision model of the coordinates.
+func (g *GeometryValid) PrecisionModel() precision.Model {
+	return g.precisionModel
+}
+
 // IsProjection returns true if the coordinateSystem is projection.
 func (g *GeometryValid) IsProjection() bool {
 	for i := range projectionCoordinateSystem {
@@ -108,86 +130,117 @@ func Distance(from, to Geometry, f measure.DistanceFunc) (float64, error) {
 	return f(from.ToMatrix(), to.ToMatrix()), nil
 }
 
-// TransGeometry trans steric to geometry.
+// TransGeometry trans steric to geometry, nil if the steric is not supported.
 func TransGeometry(inputGeom matrix.Steric) Geometry {
+	geom, _ := ToGeometry(inputGeom)
+	return geom
+}
+
+// ToGeometry trans steric to geometry as TransGeometry,
+// returning ErrUnsupportedType if the steric or a steric of its collection is not supported.
+func ToGeometry(inputGeom matrix.Steric) (Geometry, error) {
 	switch g := inputGeom.(type) {
 	case matrix.Matrix:
-		return Point(g)
+		return Point(g), nil
 	case matrix.LineMatrix:
 		if len(g) == 1 {
-			return Point(matrix.Matrix(g[0]))
+			return Point(matrix.Matrix(g[0])), nil
 		}
-		return LineString(g)
+		return LineString(g), nil
 	case matrix.PolygonMatrix:
-		return Polygon(g)
+		return Polygon(g), nil
+	case matrix.MultiPolygonMatrix:
+		var coll MultiPolygon
+		for _, v := range g {
+			coll = append(coll, Polygon(v))
+		}
+		return coll, nil
 	case matrix.Collection:
+		geoms := make([]Geometry, len(g))
 		multiType := ""
-		for _, v := range g {
-			if multiType == "" {
-				multiType = TransGeometry(v).GeoJSONType()
+		for i, v := range g {
+			geom, err := ToGeometry(v)
+			if err != nil {
+				return nil, err
 			}
-			if multiType != TransGeometry(v).GeoJSONType() {
+			geoms[i] = geom
+			if i == 0 {
+				multiType = geom.GeoJSONType()
+			} else if multiType != geom.GeoJSONType() {
 				multiType = ""
-				break
 			}
 		}
 		switch multiType {
 		case TypeLineString:
 			var coll MultiLineString
-			for _, v := range g {
-				coll = append(coll, TransGeometry(v).(LineString))
+			for _, v := range geoms {
+				coll = append(coll, v.(LineString))
 			}
-			return coll
+			return coll, nil
 		case TypePoint:
 			var coll MultiPoint
-			for _, v := range g {
-				coll = append(coll, TransGeometry(v).(Point))
+			for _, v := range geoms {
+				coll = append(coll, v.(Point))
 			}
-			return coll
+			return coll, nil
 		case TypePolygon:
 			var coll MultiPolygon
-			for _, v := range g {
-				coll = append(coll, TransGeometry(v).(Polygon))
+			for _, v := range geoms {
+				coll = append(coll, v.(Polygon))
 			}
-			return coll
+			return coll, nil
 		default:
 			var coll Collection
-			for _, v := range g {
-				coll = append(coll, TransGeometry(v))
+			for _, v := range geoms {
+				coll = append(coll, v)
 			}
-			return coll
+			return coll, nil
 		}
-	default:
-		return nil
 	}
+	return nil, spaceerr.ErrUnsupportedType
 }
 
 // bufferInMeter ...
-func bufferInMeter(geometry Geometry, width float64, quadsegs int) Geometry {
+func bufferInMeter(geometry Geometry, width float64, quadsegs int) (Geometry, error) {
+	if geometry.IsEmpty() {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
 	centroid := geometry.Centroid()
 	width = measure.MercatorDistance(width, centroid.Lat())
 	transformer := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
-	geomMatrix, _ := transformer.TransformGeometry(geometry.ToMatrix())
-	geometry = TransGeometry(geomMatrix)
-	geometry = geometry.Buffer(width, quadsegs)
-	if geometry != nil {
-		transformer.CoordType = coordtransform.MERCATORTOLL
-		geomMatrix, _ = transformer.TransformGeometry(geometry.ToMatrix())
-		geometry = TransGeometry(geomMatrix)
+	geomMatrix, err := transformer.TransformGeometry(geometry.ToMatrix())
+	if err != nil {
+		return nil, err
+	}
+	if geometry, err = ToGeometry(geomMatrix); err != nil {
+		return nil, err
+	}
+	if geometry, err = geometry.Buffer(width, quadsegs); err != nil {
+		return nil, err
 	}
-	return geometry
+	if geometry.IsEmpty() {
+		return geometry, nil
+	}
+	transformer.CoordType = coordtransform.MERCATORTOLL
+	if geomMatrix, err = transformer.TransformGeometry(geometry.ToMatrix()); err != nil {
+		return nil, err
+	}
+	return ToGeometry(geomMatrix)
 }
 
 // bufferInOriginal ...
-func bufferInOriginal(geometry Geometry, width float64, quadsegs int) Geometry {
-	buff := buffer.Buffer(geometry.ToMatrix(), width, quadsegs)
-
-	result := buff
-	switch b := result.(type) {
+func bufferInOriginal(geometry Geometry, width float64, quadsegs int) (Geometry, error) {
+	steric := geometry.ToMatrix()
+	if steric.IsEmpty() {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
+	switch b := buffer.Buffer(steric, width, quadsegs).(type) {
+	case nil:
+		return Polygon{}, nil
 	case matrix.LineMatrix:
-		return LineString(b)
+		return LineString(b), nil
 	case matrix.PolygonMatrix:
-		return Polygon(b)
+		return Polygon(b), nil
 	}
-	return nil
+	return nil, spaceerr.ErrUnsupportedType
 }
@@ -1,10 +1,14 @@
 package space
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/precision"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func Test_Centroid(t *testing.T) {
@@ -88,9 +92,96 @@ func TestBufferInMeter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := bufferInMeter(tt.args.geometry, tt.args.width, tt.args.quadsegs); !reflect.DeepEqual(got, tt.want) {
+			got, err := bufferInMeter(tt.args.geometry, tt.args.width, tt.args.quadsegs)
+			if err != nil {
+				t.Errorf("BufferInMeter() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("BufferInMeter() = %v, want %v", got.ToMatrix(), tt.want)
 			}
 		})
 	}
 }
+
+func TestCreateElementValidWithPrecision(t *testing.T) {
+	geom := LineString{{1.2345, 2.3456}, {3.4567, 4.5678}}
+	got, err := CreateElementValidWithPrecision(geom, WGS84, precision.Fixed(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (LineString{{1.23, 2.35}, {3.46, 4.57}}); !reflect.DeepEqual(got.Geom(), want) {
+		t.Errorf("CreateElementValidWithPrecision() = %v, want %v", got.Geom(), want)
+	}
+	if got.PrecisionModel() != precision.Fixed(100) || got.CoordinateSystem() != WGS84 {
+		t.Errorf("CreateElementValidWithPrecision() precision = %v, coordinate system = %v", got.PrecisionModel(), got.CoordinateSystem())
+	}
+}
+
+func TestToGeometry(t *testing.T) {
+	tests := []struct {
+		name    string
+		steric  matrix.Steric
+		want    Geometry
+		wantErr error
+	}{
+		{name: "point", steric: matrix.Matrix{1, 1}, want: Point{1, 1}},
+		{name: "line", steric: matrix.LineMatrix{{1, 1}, {2, 2}}, want: LineString{{1, 1}, {2, 2}}},
+		{name: "multi polygon", steric: matrix.MultiPolygonMatrix{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+			want: MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}},
+		{name: "multi point", steric: matrix.Collection{matrix.Matrix{1, 1}, matrix.Matrix{2, 2}},
+			want: MultiPoint{{1, 1}, {2, 2}}},
+		{name: "collection", steric: matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{1, 1}, {2, 2}}},
+			want: Collection{Point{1, 1}, LineString{{1, 1}, {2, 2}}}},
+		{name: "nil", steric: nil, wantErr: spaceerr.ErrUnsupportedType},
+		{name: "collection with nil", steric: matrix.Collection{matrix.Matrix{1, 1}, nil}, wantErr: spaceerr.ErrUnsupportedType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToGeometry(tt.steric)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ToGeometry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToGeometry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_EqualsWrapped(t *testing.T) {
+	square := Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	valid, err := CreateElementValid(square)
+	if err != nil {
+		t.Fatal(err)
+	}
+	circle, _ := CreateCircle(Point{0, 0}, 1)
+	tests := []struct {
+		name   string
+		g1, g2 Geometry
+		want   bool
+	}{
+		{name: "polygon valid", g1: square, g2: valid, want: true},
+		{name: "polygon circle", g1: square, g2: circle, want: false},
+		{name: "circle polygon", g1: circle.Polygon, g2: circle, want: true},
+		{name: "point valid", g1: Point{1, 2}, g2: &GeometryValid{Geometry: Point{1, 2}}, want: true},
+		{name: "point polygon", g1: Point{1, 2}, g2: square, want: false},
+		{name: "line ring", g1: LineString{{0, 0}, {1, 1}, {0, 0}}, g2: Ring{{0, 0}, {1, 1}, {0, 0}}, want: true},
+		{name: "ring line", g1: Ring{{0, 0}, {1, 1}, {0, 0}}, g2: LineString{{0, 0}, {1, 1}, {0, 0}}, want: true},
+		{name: "multi point length", g1: MultiPoint{{0, 0}, {1, 1}}, g2: MultiPoint{{0, 0}}, want: false},
+		{name: "multi polygon valid", g1: MultiPolygon{square}, g2: &GeometryValid{Geometry: MultiPolygon{square}}, want: true},
+		{name: "multi polygon circle", g1: MultiPolygon{square}, g2: circle, want: false},
+		{name: "collection valid", g1: Collection{square}, g2: &GeometryValid{Geometry: Collection{square}}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g1.Equals(tt.g2); got != tt.want {
+				t.Errorf("Equals() = %v, want %v", got, tt.want)
+			}
+			if got := tt.g1.EqualsExact(tt.g2, 0); got != tt.want {
+				t.Errorf("EqualsExact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -54,11 +54,11 @@ type Geometry interface {
 
 	// Buffer Returns a geometry that represents all points whose distance
 	// from this space.Geometry is less than or equal to distance.
-	Buffer(width float64, quadsegs int) Geometry
+	Buffer(width float64, quadsegs int) (Geometry, error)
 
 	// BufferInMeter Returns a geometry that represents all points whose distance
 	// from this space.Geometry is less than or equal to distance.
-	BufferInMeter(width float64, quadsegs int) Geometry
+	BufferInMeter(width float64, quadsegs int) (Geometry, error)
 
 	// Centroid Computes the centroid point of a geometry.
 	Centroid() Point
@@ -128,6 +128,46 @@ type Geometry interface {
 
 	// Filter Performs an operation with the provided .
 	Filter(f matrix.Filter) Geometry
+
+	// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+	ForceCCW() Geometry
+
+	// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+	ForceCW() Geometry
+
+	// ForceRHR returns the geometry oriented by the right-hand rule, exterior rings clockwise, as ForceCW.
+	ForceRHR() Geometry
+
+	// Reverse returns the geometry with the vertex order of its components reversed.
+	Reverse() Geometry
+
+	// Normalize returns the geometry in canonical form, so equal geometries are EqualsExact
+	// whatever their vertex order and starting point.
+	// Lines are directed from their lesser end, rings start at their minimum vertex,
+	// exterior rings are counter-clockwise and interior rings clockwise,
+	// and the components of holes, multi geometries and collections are sorted.
+	Normalize() Geometry
+}
+
+// underlying returns the geometry wrapped by a GeometryValid, or the polygon of a Circle,
+// so they are compared as the geometries they hold.
+func underlying(g Geometry) Geometry {
+	for {
+		switch v := g.(type) {
+		case *GeometryValid:
+			if v == nil {
+				return nil
+			}
+			g = v.Geometry
+		case *Circle:
+			if v == nil {
+				return nil
+			}
+			return v.Polygon
+		default:
+			return g
+		}
+	}
 }
 
 // compile time checks
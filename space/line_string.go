@@ -68,24 +68,22 @@ func (ls LineString) EqualsLineString(lineString LineString) bool {
 
 // Equals checks if the LineString represents the same Geometry or vector.
 func (ls LineString) Equals(g Geometry) bool {
-	if g.GeoJSONType() != ls.GeoJSONType() {
-		return false
-	}
-	return ls.EqualsLineString(g.(LineString))
+	line, ok := asLineString(g)
+	return ok && ls.EqualsLineString(line)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (ls LineString) EqualsExact(g Geometry, tolerance float64) bool {
-	if ls.GeoJSONType() != g.GeoJSONType() {
+	line, ok := asLineString(g)
+	if !ok {
 		return false
 	}
-	line := g.(LineString)
-	if ls.IsEmpty() && g.IsEmpty() {
+	if ls.IsEmpty() && line.IsEmpty() {
 		return true
 	}
-	if ls.IsEmpty() != g.IsEmpty() {
+	if ls.IsEmpty() != line.IsEmpty() {
 		return false
 	}
 	if len(ls) != len(line) {
@@ -139,6 +137,9 @@ func (ls LineString) SpheroidDistance(g Geometry) (float64, error) {
 // Boundary returns the closure of the combinatorial boundary of this space.Geometry.
 // The boundary of a lineal geometry is always a zero-dimensional geometry (which may be empty).
 func (ls LineString) Boundary() (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
 	if ls.IsClosed() {
 		return nil, spaceerr.ErrBoundBeNil
 	}
@@ -148,7 +149,7 @@ func (ls LineString) Boundary() (Geometry, error) {
 // IsClosed Returns TRUE if the LINESTRING's start and end points are coincident.
 // For Polyhedral Surfaces, reports if the surface is areal (open) or IsC (closed).
 func (ls LineString) IsClosed() bool {
-	return Point(ls[0]).Equals(Point(ls[len(ls)-1]))
+	return !ls.IsEmpty() && Point(ls[0]).Equals(Point(ls[len(ls)-1]))
 }
 
 // Length Returns the length of this LineString
@@ -197,13 +198,16 @@ func (ls LineString) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (ls LineString) Buffer(width float64, quadsegs int) Geometry {
+func (ls LineString) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(ls, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (ls LineString) BufferInMeter(width float64, quadsegs int) Geometry {
+func (ls LineString) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
+	if ls.IsEmpty() {
+		return nil, spaceerr.ErrEmptyGeometry
+	}
 
 	distances := make([]float64, len(ls))
 
@@ -212,17 +216,21 @@ func (ls LineString) BufferInMeter(width float64, quadsegs int) Geometry {
 	}
 
 	transformer := coordtransform.NewTransformer(coordtransform.LLTOMERCATOR)
-	geomMatrix, _ := transformer.TransformGeometry(ls.ToMatrix())
+	geomMatrix, err := transformer.TransformGeometry(ls.ToMatrix())
+	if err != nil {
+		return nil, err
+	}
 
 	lbuffer := &buffer.VariableLineBuffer{Line: geomMatrix.(matrix.LineMatrix), QuadrantSegments: quadsegs}
 	resultMatrix := lbuffer.DistancesBuffer(distances)
-	geometry := TransGeometry(resultMatrix)
-	if geometry != nil {
-		transformer.CoordType = coordtransform.MERCATORTOLL
-		geomMatrix, _ = transformer.TransformGeometry(geometry.ToMatrix())
-		geometry = TransGeometry(geomMatrix)
+	if resultMatrix == nil {
+		return Polygon{}, nil
+	}
+	transformer.CoordType = coordtransform.MERCATORTOLL
+	if geomMatrix, err = transformer.TransformGeometry(resultMatrix); err != nil {
+		return nil, err
 	}
-	return geometry
+	return ToGeometry(geomMatrix)
 }
 
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
@@ -301,3 +309,41 @@ func (ls LineString) Filter(f matrix.Filter) Geometry {
 func (ls LineString) Geom() Geometry {
 	return ls
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+// A line string has no rings, a copy is returned.
+func (ls LineString) ForceCCW() Geometry {
+	return LineString(cloneLine(matrix.LineMatrix(ls)))
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+// A line string has no rings, a copy is returned.
+func (ls LineString) ForceCW() Geometry {
+	return LineString(cloneLine(matrix.LineMatrix(ls)))
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (ls LineString) ForceRHR() Geometry {
+	return ls.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (ls LineString) Reverse() Geometry {
+	return LineString(reverseLine(matrix.LineMatrix(ls)))
+}
+
+// Normalize returns the geometry in canonical form.
+func (ls LineString) Normalize() Geometry {
+	return LineString(canonicalLine(matrix.LineMatrix(ls)))
+}
+
+// asLineString returns the line string or ring held by the geometry, false if it holds none.
+func asLineString(g Geometry) (LineString, bool) {
+	switch v := underlying(g).(type) {
+	case LineString:
+		return v, true
+	case Ring:
+		return LineString(v), true
+	}
+	return nil, false
+}
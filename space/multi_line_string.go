@@ -1,6 +1,8 @@
 package space
 
 import (
+	"sort"
+
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
@@ -85,21 +87,20 @@ func (mls MultiLineString) EqualsMultiLineString(multiLineString MultiLineString
 
 // Equals checks if the MultiLineString represents the same Geometry or vector.
 func (mls MultiLineString) Equals(g Geometry) bool {
-	if g.GeoJSONType() != mls.GeoJSONType() {
-		return false
-	}
-	return mls.EqualsMultiLineString(g.(MultiLineString))
+	other, ok := underlying(g).(MultiLineString)
+	return ok && mls.EqualsMultiLineString(other)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mls MultiLineString) EqualsExact(g Geometry, tolerance float64) bool {
-	if mls.GeoJSONType() != g.GeoJSONType() {
+	other, ok := underlying(g).(MultiLineString)
+	if !ok || len(mls) != len(other) {
 		return false
 	}
 	for i, v := range mls {
-		if !v.EqualsExact((g.(MultiLineString)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -207,13 +208,13 @@ func (mls MultiLineString) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mls MultiLineString) Buffer(width float64, quadsegs int) Geometry {
+func (mls MultiLineString) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(mls, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mls MultiLineString) BufferInMeter(width float64, quadsegs int) Geometry {
+func (mls MultiLineString) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(mls, width, quadsegs)
 }
 
@@ -291,3 +292,47 @@ func (mls MultiLineString) Filter(f matrix.Filter) Geometry {
 func (mls MultiLineString) Geom() Geometry {
 	return mls
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+// A multi line string has no rings, a copy is returned.
+func (mls MultiLineString) ForceCCW() Geometry {
+	return cloneMultiLineString(mls)
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+// A multi line string has no rings, a copy is returned.
+func (mls MultiLineString) ForceCW() Geometry {
+	return cloneMultiLineString(mls)
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (mls MultiLineString) ForceRHR() Geometry {
+	return mls.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (mls MultiLineString) Reverse() Geometry {
+	if mls == nil {
+		return mls
+	}
+	result := make(MultiLineString, len(mls))
+	for i, v := range mls {
+		result[i] = LineString(reverseLine(matrix.LineMatrix(v)))
+	}
+	return result
+}
+
+// Normalize returns the geometry in canonical form.
+func (mls MultiLineString) Normalize() Geometry {
+	if mls == nil {
+		return mls
+	}
+	result := make(MultiLineString, len(mls))
+	for i, v := range mls {
+		result[i] = LineString(canonicalLine(matrix.LineMatrix(v)))
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return compareLine(matrix.LineMatrix(result[i]), matrix.LineMatrix(result[j])) < 0
+	})
+	return result
+}
@@ -1,6 +1,8 @@
 package space
 
 import (
+	"sort"
+
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
@@ -71,21 +73,20 @@ func (mp MultiPoint) EqualsMultiPoint(multiPoint MultiPoint) bool {
 
 // Equals checks if the MultiPoint represents the same Geometry or vector.
 func (mp MultiPoint) Equals(g Geometry) bool {
-	if g.GeoJSONType() != mp.GeoJSONType() {
-		return false
-	}
-	return mp.EqualsMultiPoint(g.(MultiPoint))
+	other, ok := underlying(g).(MultiPoint)
+	return ok && mp.EqualsMultiPoint(other)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mp MultiPoint) EqualsExact(g Geometry, tolerance float64) bool {
-	if mp.GeoJSONType() != g.GeoJSONType() {
+	other, ok := underlying(g).(MultiPoint)
+	if !ok || len(mp) != len(other) {
 		return false
 	}
 	for i, v := range mp {
-		if !v.EqualsExact((g.(MultiPoint)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -164,13 +165,13 @@ func (mp MultiPoint) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mp MultiPoint) Buffer(width float64, quadsegs int) Geometry {
+func (mp MultiPoint) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(mp, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mp MultiPoint) BufferInMeter(width float64, quadsegs int) Geometry {
+func (mp MultiPoint) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(mp, width, quadsegs)
 }
 
@@ -250,3 +251,34 @@ func (mp MultiPoint) Filter(f matrix.Filter) Geometry {
 func (mp MultiPoint) Geom() Geometry {
 	return mp
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+// A multi point has no rings, a copy is returned.
+func (mp MultiPoint) ForceCCW() Geometry {
+	return cloneMultiPoint(mp)
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+// A multi point has no rings, a copy is returned.
+func (mp MultiPoint) ForceCW() Geometry {
+	return cloneMultiPoint(mp)
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (mp MultiPoint) ForceRHR() Geometry {
+	return mp.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (mp MultiPoint) Reverse() Geometry {
+	return cloneMultiPoint(mp)
+}
+
+// Normalize returns the geometry in canonical form.
+func (mp MultiPoint) Normalize() Geometry {
+	result := mp.Reverse().(MultiPoint)
+	sort.SliceStable(result, func(i, j int) bool {
+		return comparePoint(result[i], result[j]) < 0
+	})
+	return result
+}
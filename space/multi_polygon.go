@@ -1,6 +1,8 @@
 package space
 
 import (
+	"sort"
+
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
@@ -70,21 +72,20 @@ func (mp MultiPolygon) EqualsMultiPolygon(multiPolygon MultiPolygon) bool {
 
 // Equals checks if the MultiPolygon represents the same Geometry or vector.
 func (mp MultiPolygon) Equals(g Geometry) bool {
-	if g.GeoJSONType() != mp.GeoJSONType() {
-		return false
-	}
-	return mp.EqualsMultiPolygon(g.(MultiPolygon))
+	other, ok := underlying(g).(MultiPolygon)
+	return ok && mp.EqualsMultiPolygon(other)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mp MultiPolygon) EqualsExact(g Geometry, tolerance float64) bool {
-	if mp.GeoJSONType() != g.GeoJSONType() {
+	other, ok := underlying(g).(MultiPolygon)
+	if !ok || len(mp) != len(other) {
 		return false
 	}
 	for i, v := range mp {
-		if !v.EqualsExact((g.(MultiPolygon)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -126,8 +127,8 @@ func (mp MultiPolygon) Boundary() (Geometry, error) {
 	}
 	rings := MultiLineString{}
 	for _, p := range mp {
-		if r, err := p.Boundary(); err == nil {
-			rings = append(rings, r.(MultiLineString)...)
+		for _, v := range p {
+			rings = append(rings, v)
 		}
 	}
 	return rings, nil
@@ -183,13 +184,13 @@ func (mp MultiPolygon) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mp MultiPolygon) Buffer(width float64, quadsegs int) Geometry {
+func (mp MultiPolygon) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(mp, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (mp MultiPolygon) BufferInMeter(width float64, quadsegs int) Geometry {
+func (mp MultiPolygon) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(mp, width, quadsegs)
 }
 
@@ -272,3 +273,44 @@ func (mp MultiPolygon) Filter(f matrix.Filter) Geometry {
 func (mp MultiPolygon) Geom() Geometry {
 	return mp
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (mp MultiPolygon) ForceCCW() Geometry {
+	return mp.each(func(p Polygon) Polygon { return orientPolygon(p, true) })
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (mp MultiPolygon) ForceCW() Geometry {
+	return mp.each(func(p Polygon) Polygon { return orientPolygon(p, false) })
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (mp MultiPolygon) ForceRHR() Geometry {
+	return mp.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (mp MultiPolygon) Reverse() Geometry {
+	return mp.each(reversePolygon)
+}
+
+// Normalize returns the geometry in canonical form.
+func (mp MultiPolygon) Normalize() Geometry {
+	result := mp.each(canonicalPolygon)
+	sort.SliceStable(result, func(i, j int) bool {
+		return compareSteric(result[i].ToMatrix(), result[j].ToMatrix()) < 0
+	})
+	return result
+}
+
+// each returns a multi polygon of f applied to each polygon.
+func (mp MultiPolygon) each(f func(Polygon) Polygon) MultiPolygon {
+	if mp == nil {
+		return mp
+	}
+	result := make(MultiPolygon, len(mp))
+	for i, v := range mp {
+		result[i] = f(v)
+	}
+	return result
+}
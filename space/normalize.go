@@ -0,0 +1,268 @@
+package space
+
+import (
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// typeOrder the order of geometry types in the canonical form of a collection.
+var typeOrder = map[string]int{
+	TypePoint:           0,
+	TypeMultiPoint:      1,
+	TypeLineString:      2,
+	TypeMultiLineString: 3,
+	TypePolygon:         4,
+	TypeMultiPolygon:    5,
+	TypeCollection:      6,
+	TypeBound:           7,
+}
+
+// cloneLine returns a deep copy of the line.
+func cloneLine(line matrix.LineMatrix) matrix.LineMatrix {
+	if line == nil {
+		return nil
+	}
+	result := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		result[i] = append([]float64{}, v...)
+	}
+	return result
+}
+
+// cloneMultiPoint returns a deep copy of the multi point.
+func cloneMultiPoint(mp MultiPoint) MultiPoint {
+	if mp == nil {
+		return nil
+	}
+	result := make(MultiPoint, len(mp))
+	for i, v := range mp {
+		result[i] = append(Point(nil), v...)
+	}
+	return result
+}
+
+// cloneMultiLineString returns a deep copy of the multi line string.
+func cloneMultiLineString(mls MultiLineString) MultiLineString {
+	if mls == nil {
+		return nil
+	}
+	result := make(MultiLineString, len(mls))
+	for i, v := range mls {
+		result[i] = LineString(cloneLine(matrix.LineMatrix(v)))
+	}
+	return result
+}
+
+// reverseLine returns a copy of the line with the vertex order reversed.
+func reverseLine(line matrix.LineMatrix) matrix.LineMatrix {
+	return cloneLine(line).Reverse()
+}
+
+// orientRing returns a copy of the ring oriented CCW if ccw is true, or CW.
+// Rings without area are only copied.
+func orientRing(ring matrix.LineMatrix, ccw bool) matrix.LineMatrix {
+	result := cloneLine(ring)
+	// AreaDirection is negative for CCW rings.
+	if area := measure.AreaDirection(result); area != 0 && (area < 0) != ccw {
+		result.Reverse()
+	}
+	return result
+}
+
+// orientPolygon returns a copy of the polygon with the shell oriented CCW if ccw is true,
+// or CW, and the holes oriented the other way.
+func orientPolygon(p Polygon, ccw bool) Polygon {
+	if p == nil {
+		return nil
+	}
+	result := make(Polygon, len(p))
+	for i, v := range p {
+		result[i] = orientRing(v, (i == 0) == ccw)
+	}
+	return result
+}
+
+// reversePolygon returns a copy of the polygon with the vertex order of its rings reversed.
+func reversePolygon(p Polygon) Polygon {
+	if p == nil {
+		return nil
+	}
+	result := make(Polygon, len(p))
+	for i, v := range p {
+		result[i] = reverseLine(v)
+	}
+	return result
+}
+
+// canonicalLine returns a copy of the line directed so its start is not greater than its end.
+func canonicalLine(line matrix.LineMatrix) matrix.LineMatrix {
+	for i, j := 0, len(line)-1; i < j; i, j = i+1, j-1 {
+		if c := comparePoint(line[i], line[j]); c != 0 {
+			if c > 0 {
+				return reverseLine(line)
+			}
+			break
+		}
+	}
+	return cloneLine(line)
+}
+
+// canonicalRing returns a copy of the ring oriented as orientRing, starting at its minimum vertex.
+func canonicalRing(ring matrix.LineMatrix, ccw bool) matrix.LineMatrix {
+	result := orientRing(ring, ccw)
+	if len(result) < 4 || !result.IsClosed() {
+		return result
+	}
+	start := 0
+	for i := 1; i < len(result)-1; i++ {
+		if comparePoint(result[i], result[start]) < 0 {
+			start = i
+		}
+	}
+	if start == 0 {
+		return result
+	}
+	rotated := make(matrix.LineMatrix, 0, len(result))
+	rotated = append(rotated, result[start:len(result)-1]...)
+	rotated = append(rotated, result[:start]...)
+	return append(rotated, append([]float64{}, result[start]...))
+}
+
+// canonicalPolygon returns a copy of the polygon with the shell CCW, the holes CW and sorted,
+// all the rings starting at their minimum vertex.
+func canonicalPolygon(p Polygon) Polygon {
+	if p == nil {
+		return nil
+	}
+	result := make(Polygon, len(p))
+	for i, v := range p {
+		result[i] = canonicalRing(v, i == 0)
+	}
+	if len(result) > 2 {
+		holes := result[1:]
+		sort.SliceStable(holes, func(i, j int) bool {
+			return compareLine(holes[i], holes[j]) < 0
+		})
+	}
+	return result
+}
+
+// sortGeometries sorts the geometries in canonical order, by type and then by coordinates.
+func sortGeometries(geoms []Geometry) {
+	sort.SliceStable(geoms, func(i, j int) bool {
+		return compareGeometry(geoms[i], geoms[j]) < 0
+	})
+}
+
+// compareGeometry returns -1, 0 or 1 as a is less than, equal to or greater than b in canonical order.
+func compareGeometry(a, b Geometry) int {
+	if a == nil || b == nil {
+		return compareInt(boolToInt(a != nil), boolToInt(b != nil))
+	}
+	if c := compareInt(typeOrder[a.GeoJSONType()], typeOrder[b.GeoJSONType()]); c != 0 {
+		return c
+	}
+	return compareSteric(a.ToMatrix(), b.ToMatrix())
+}
+
+// compareSteric returns -1, 0 or 1 as a is less than, equal to or greater than b,
+// comparing the kinds of steric and then their coordinates in lexicographic order.
+func compareSteric(a, b matrix.Steric) int {
+	switch m := a.(type) {
+	case matrix.Matrix:
+		if n, ok := b.(matrix.Matrix); ok {
+			return comparePoint(m, n)
+		}
+	case matrix.LineMatrix:
+		if n, ok := b.(matrix.LineMatrix); ok {
+			return compareLine(m, n)
+		}
+	case matrix.PolygonMatrix:
+		if n, ok := b.(matrix.PolygonMatrix); ok {
+			for i := 0; i < len(m) && i < len(n); i++ {
+				if c := compareLine(m[i], n[i]); c != 0 {
+					return c
+				}
+			}
+			return compareInt(len(m), len(n))
+		}
+	case matrix.MultiPolygonMatrix:
+		if n, ok := b.(matrix.MultiPolygonMatrix); ok {
+			for i := 0; i < len(m) && i < len(n); i++ {
+				if c := compareSteric(matrix.PolygonMatrix(m[i]), matrix.PolygonMatrix(n[i])); c != 0 {
+					return c
+				}
+			}
+			return compareInt(len(m), len(n))
+		}
+	case matrix.Collection:
+		if n, ok := b.(matrix.Collection); ok {
+			for i := 0; i < len(m) && i < len(n); i++ {
+				if c := compareSteric(m[i], n[i]); c != 0 {
+					return c
+				}
+			}
+			return compareInt(len(m), len(n))
+		}
+	}
+	return compareInt(stericOrder(a), stericOrder(b))
+}
+
+// stericOrder returns the order of the kind of steric.
+func stericOrder(s matrix.Steric) int {
+	switch s.(type) {
+	case matrix.Matrix:
+		return 0
+	case matrix.LineMatrix:
+		return 1
+	case matrix.PolygonMatrix:
+		return 2
+	case matrix.MultiPolygonMatrix:
+		return 3
+	case matrix.Collection:
+		return 4
+	}
+	return 5
+}
+
+// compareLine compares the lines vertex by vertex, a shorter line is less than its extensions.
+func compareLine(a, b matrix.LineMatrix) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := comparePoint(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+// comparePoint compares the points ordinate by ordinate.
+func comparePoint(a, b []float64) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+package space
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGeometry_Force(t *testing.T) {
+	ccw := Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+	cw := Polygon{{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}, {{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}}
+	tests := []struct {
+		name    string
+		geom    Geometry
+		wantCCW Geometry
+		wantCW  Geometry
+	}{
+		{name: "polygon ccw", geom: ccw, wantCCW: ccw, wantCW: cw},
+		{name: "polygon cw", geom: cw, wantCCW: ccw, wantCW: cw},
+		{name: "ring", geom: Ring(cw[0]), wantCCW: Ring(ccw[0]), wantCW: Ring(cw[0])},
+		{name: "multi polygon", geom: MultiPolygon{cw, ccw}, wantCCW: MultiPolygon{ccw, ccw}, wantCW: MultiPolygon{cw, cw}},
+		{name: "collection", geom: Collection{Point{1, 1}, cw}, wantCCW: Collection{Point{1, 1}, ccw},
+			wantCW: Collection{Point{1, 1}, cw}},
+		{name: "line", geom: LineString{{0, 0}, {1, 1}}, wantCCW: LineString{{0, 0}, {1, 1}},
+			wantCW: LineString{{0, 0}, {1, 1}}},
+		{name: "point", geom: Point{1, 2}, wantCCW: Point{1, 2}, wantCW: Point{1, 2}},
+		{name: "multi point", geom: MultiPoint{{1, 2}, {3, 4}}, wantCCW: MultiPoint{{1, 2}, {3, 4}},
+			wantCW: MultiPoint{{1, 2}, {3, 4}}},
+		{name: "multi line", geom: MultiLineString{{{0, 0}, {1, 1}}}, wantCCW: MultiLineString{{{0, 0}, {1, 1}}},
+			wantCW: MultiLineString{{{0, 0}, {1, 1}}}},
+		{name: "collapsed", geom: Polygon{{{0, 0}, {1, 1}, {0, 0}}}, wantCCW: Polygon{{{0, 0}, {1, 1}, {0, 0}}},
+			wantCW: Polygon{{{0, 0}, {1, 1}, {0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geom.ForceCCW(); !reflect.DeepEqual(got, tt.wantCCW) {
+				t.Errorf("ForceCCW() = %v, want %v", got, tt.wantCCW)
+			}
+			if got := tt.geom.ForceCW(); !reflect.DeepEqual(got, tt.wantCW) {
+				t.Errorf("ForceCW() = %v, want %v", got, tt.wantCW)
+			}
+			if got := tt.geom.ForceRHR(); !reflect.DeepEqual(got, tt.wantCW) {
+				t.Errorf("ForceRHR() = %v, want %v", got, tt.wantCW)
+			}
+		})
+	}
+}
+
+func TestGeometry_Reverse(t *testing.T) {
+	tests := []struct {
+		name string
+		geom Geometry
+		want Geometry
+	}{
+		{name: "point", geom: Point{1, 2}, want: Point{1, 2}},
+		{name: "line", geom: LineString{{0, 0}, {1, 1}, {2, 0}}, want: LineString{{2, 0}, {1, 1}, {0, 0}}},
+		{name: "polygon", geom: Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, want: Polygon{{{0, 0}, {1, 1}, {1, 0}, {0, 0}}}},
+		{name: "multi line", geom: MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}},
+			want: MultiLineString{{{1, 1}, {0, 0}}, {{3, 3}, {2, 2}}}},
+		{name: "collection", geom: Collection{LineString{{0, 0}, {1, 1}}}, want: Collection{LineString{{1, 1}, {0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geom.Reverse(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reverse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		geom Geometry
+		want Geometry
+	}{
+		{name: "line", geom: LineString{{2, 0}, {1, 1}, {0, 0}}, want: LineString{{0, 0}, {1, 1}, {2, 0}}},
+		{name: "ring", geom: Ring{{10, 10}, {0, 10}, {0, 0}, {10, 0}, {10, 10}},
+			want: Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+		{name: "polygon", geom: Polygon{{{10, 10}, {10, 0}, {0, 0}, {0, 10}, {10, 10}},
+			{{6, 6}, {8, 6}, {8, 8}, {6, 6}}, {{2, 2}, {4, 4}, {2, 4}, {2, 2}}},
+			want: Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {2, 4}, {4, 4}, {2, 2}}, {{6, 6}, {8, 8}, {8, 6}, {6, 6}}}},
+		{name: "multi point", geom: MultiPoint{{1, 1}, {0, 5}, {0, 1}}, want: MultiPoint{{0, 1}, {0, 5}, {1, 1}}},
+		{name: "multi line", geom: MultiLineString{{{3, 3}, {2, 2}}, {{0, 0}, {1, 1}}},
+			want: MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}},
+		{name: "multi polygon", geom: MultiPolygon{{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}}, {{{1, 1}, {0, 0}, {1, 0}, {1, 1}}}},
+			want: MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, {{{5, 5}, {6, 5}, {6, 6}, {5, 5}}}}},
+		{name: "collection", geom: Collection{Polygon{{{0, 0}, {1, 1}, {1, 0}, {0, 0}}}, LineString{{1, 1}, {0, 0}}, Point{5, 5}, Point{1, 1}},
+			want: Collection{Point{1, 1}, Point{5, 5}, LineString{{0, 0}, {1, 1}}, Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}},
+		{name: "empty", geom: Polygon{}, want: Polygon{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.geom.Normalize(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+			if got := tt.geom.Reverse().Normalize(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Reverse().Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeometry_NormalizeCopy(t *testing.T) {
+	poly := Polygon{{{1, 1}, {0, 0}, {1, 0}, {1, 1}}}
+	got := poly.Normalize().(Polygon)
+	got[0][0][0] = 9
+	if want := (Polygon{{{1, 1}, {0, 0}, {1, 0}, {1, 1}}}); !reflect.DeepEqual(poly, want) {
+		t.Errorf("Normalize() changed the input %v, want %v", poly, want)
+	}
+}
+
+func TestGeometry_ForceCopy(t *testing.T) {
+	tests := []struct {
+		name string
+		geom func() Geometry
+		set  func(g Geometry)
+	}{
+		{name: "point", geom: func() Geometry { return Point{1, 2} }, set: func(g Geometry) { g.(Point)[0] = 9 }},
+		{name: "line", geom: func() Geometry { return LineString{{0, 0}, {1, 1}} },
+			set: func(g Geometry) { g.(LineString)[0][0] = 9 }},
+		{name: "multi point", geom: func() Geometry { return MultiPoint{{1, 2}, {3, 4}} },
+			set: func(g Geometry) { g.(MultiPoint)[0][0] = 9 }},
+		{name: "multi line", geom: func() Geometry { return MultiLineString{{{0, 0}, {1, 1}}} },
+			set: func(g Geometry) { g.(MultiLineString)[0][0][0] = 9 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, force := range []func(Geometry) Geometry{Geometry.ForceCCW, Geometry.ForceCW, Geometry.ForceRHR} {
+				geom := tt.geom()
+				tt.set(force(geom))
+				if want := tt.geom(); !reflect.DeepEqual(geom, want) {
+					t.Errorf("Force changed the input %v, want %v", geom, want)
+				}
+			}
+		})
+	}
+}
@@ -68,29 +68,28 @@ func (p Point) EqualsPoint(point Point) bool {
 
 // Equals checks if the point represents the same Geometry or vector.
 func (p Point) Equals(g Geometry) bool {
-	if g.GeoJSONType() != p.GeoJSONType() {
-		return false
-	}
-	return p.EqualsPoint(g.(Point))
+	point, ok := underlying(g).(Point)
+	return ok && p.EqualsPoint(point)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (p Point) EqualsExact(g Geometry, tolerance float64) bool {
-	if g.GeoJSONType() != p.GeoJSONType() {
+	point, ok := underlying(g).(Point)
+	if !ok {
 		return false
 	}
-	if p.IsEmpty() && g.IsEmpty() {
+	if p.IsEmpty() && point.IsEmpty() {
 		return true
 	}
-	if p.IsEmpty() != g.IsEmpty() {
+	if p.IsEmpty() != point.IsEmpty() {
 		return false
 	}
 	if tolerance == 0 {
-		return p.Equals(g)
+		return p.EqualsPoint(point)
 	}
-	return measure.PlanarDistance(matrix.Matrix(p), matrix.Matrix(g.(Point))) <= tolerance
+	return measure.PlanarDistance(matrix.Matrix(p), matrix.Matrix(point)) <= tolerance
 }
 
 // Generate implements the Generator interface for Points
@@ -169,13 +168,13 @@ func (p Point) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (p Point) Buffer(width float64, quadsegs int) Geometry {
+func (p Point) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(p, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (p Point) BufferInMeter(width float64, quadsegs int) Geometry {
+func (p Point) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(p, width, quadsegs)
 }
 
@@ -230,6 +229,7 @@ func (p Point) CoordinateSystem() int {
 
 // Filter Performs an operation with the provided .
 func (p Point) Filter(f matrix.Filter) Geometry {
+	f.Filter(matrix.Matrix(p))
 	return p
 }
 
@@ -237,3 +237,30 @@ func (p Point) Filter(f matrix.Filter) Geometry {
 func (p Point) Geom() Geometry {
 	return p
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+// A point has no rings, a copy is returned.
+func (p Point) ForceCCW() Geometry {
+	return append(Point(nil), p...)
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+// A point has no rings, a copy is returned.
+func (p Point) ForceCW() Geometry {
+	return append(Point(nil), p...)
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (p Point) ForceRHR() Geometry {
+	return p.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (p Point) Reverse() Geometry {
+	return append(Point(nil), p...)
+}
+
+// Normalize returns the geometry in canonical form.
+func (p Point) Normalize() Geometry {
+	return append(Point(nil), p...)
+}
@@ -38,7 +38,11 @@ func TestPoint_BufferInMeter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotGeometry := tt.p.BufferInMeter(tt.args.width, tt.args.quadsegs)
+			gotGeometry, err := tt.p.BufferInMeter(tt.args.width, tt.args.quadsegs)
+			if err != nil {
+				t.Errorf("Point.BufferInMeter() error = %v", err)
+				return
+			}
 			isEqual := gotGeometry.EqualsExact(tt.want, 0.000001)
 			if !isEqual {
 				t.Errorf("Point.BufferInMeter() = %v, want %v", gotGeometry, tt.want)
@@ -98,24 +98,22 @@ func (p Polygon) EqualsPolygon(polygon Polygon) bool {
 
 // Equals checks if the Polygon represents the same Geometry or vector.
 func (p Polygon) Equals(g Geometry) bool {
-	if g.GeoJSONType() != p.GeoJSONType() {
-		return false
-	}
-	return p.EqualsPolygon(g.(Polygon))
+	pol, ok := underlying(g).(Polygon)
+	return ok && p.EqualsPolygon(pol)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (p Polygon) EqualsExact(g Geometry, tolerance float64) bool {
-	if p.GeoJSONType() != g.GeoJSONType() {
+	pol, ok := underlying(g).(Polygon)
+	if !ok {
 		return false
 	}
-	pol := g.(Polygon)
-	if p.IsEmpty() && g.IsEmpty() {
+	if p.IsEmpty() && pol.IsEmpty() {
 		return true
 	}
-	if p.IsEmpty() != g.IsEmpty() {
+	if p.IsEmpty() != pol.IsEmpty() {
 		return false
 	}
 	if len(p) != len(pol) {
@@ -237,13 +235,13 @@ func (p Polygon) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (p Polygon) Buffer(width float64, quadsegs int) Geometry {
+func (p Polygon) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return bufferInOriginal(p, width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (p Polygon) BufferInMeter(width float64, quadsegs int) Geometry {
+func (p Polygon) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return bufferInMeter(p, width, quadsegs)
 }
 
@@ -333,3 +331,28 @@ func (p Polygon) Filter(f matrix.Filter) Geometry {
 func (p Polygon) Geom() Geometry {
 	return p
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (p Polygon) ForceCCW() Geometry {
+	return orientPolygon(p, true)
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (p Polygon) ForceCW() Geometry {
+	return orientPolygon(p, false)
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (p Polygon) ForceRHR() Geometry {
+	return p.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (p Polygon) Reverse() Geometry {
+	return reversePolygon(p)
+}
+
+// Normalize returns the geometry in canonical form.
+func (p Polygon) Normalize() Geometry {
+	return canonicalPolygon(p)
+}
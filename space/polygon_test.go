@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestPolygon_Buffer(t *testing.T) {
@@ -13,10 +14,11 @@ func TestPolygon_Buffer(t *testing.T) {
 		quadsegs int
 	}
 	tests := []struct {
-		name string
-		p    Polygon
-		args args
-		want Geometry
+		name    string
+		p       Polygon
+		args    args
+		want    Geometry
+		wantErr error
 	}{
 		{name: "polygon buffer", p: Polygon{{{122.993197, 41.117725}, {122.999399, 41.115696}, {122.99573, 41.109516},
 			{122.987146, 41.106994}, {122.984775, 41.107699}, {122.990687, 41.117878}, {122.993197, 41.117725}}},
@@ -33,10 +35,19 @@ func TestPolygon_Buffer(t *testing.T) {
 				{122.99325784324384, 41.118723147333654}},
 			},
 		},
+		{name: "empty shell", p: Polygon{{}}, args: args{width: 0.001, quadsegs: 4}, wantErr: spaceerr.ErrEmptyGeometry},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.p.Buffer(tt.args.width, tt.args.quadsegs); !reflect.DeepEqual(got, tt.want) {
+			got, err := tt.p.Buffer(tt.args.width, tt.args.quadsegs)
+			if err != tt.wantErr {
+				t.Errorf("Polygon.Buffer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Polygon.Buffer() = %v, want %v", got, tt.want)
 			}
 		})
@@ -43,20 +43,15 @@ func (r Ring) EqualsRing(ring Ring) bool {
 
 // Equals checks if the Ring represents the same Geometry or vector.
 func (r Ring) Equals(g Geometry) bool {
-	if g.GeoJSONType() != r.GeoJSONType() {
-		return false
-	}
-	return r.EqualsRing(g.(Ring))
+	line, ok := asLineString(g)
+	return ok && LineString(r).EqualsLineString(line)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (r Ring) EqualsExact(g Geometry, tolerance float64) bool {
-	if r.GeoJSONType() != g.GeoJSONType() {
-		return false
-	}
-	return LineString(r).Equals(LineString(g.(Ring)))
+	return LineString(r).EqualsExact(g, tolerance)
 }
 
 // Area returns the area of a polygonal geometry.
@@ -135,13 +130,13 @@ func (r Ring) SimplifyP(tolerance float64) Geometry {
 
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (r Ring) Buffer(width float64, quadsegs int) Geometry {
+func (r Ring) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return LineString(r).Buffer(width, quadsegs)
 }
 
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (r Ring) BufferInMeter(width float64, quadsegs int) Geometry {
+func (r Ring) BufferInMeter(width float64, quadsegs int) (Geometry, error) {
 	return LineString(r).BufferInMeter(width, quadsegs)
 }
 
@@ -196,6 +191,9 @@ func (r Ring) CoordinateSystem() int {
 func (r Ring) Filter(f matrix.Filter) Geometry {
 	line := LineString(r).Filter(f)
 	if f.IsChanged() {
+		if line.IsEmpty() {
+			return Ring{}
+		}
 		return append(Ring(line.(LineString)), line.(LineString)[0])
 	}
 	return r
@@ -205,3 +203,28 @@ func (r Ring) Filter(f matrix.Filter) Geometry {
 func (r Ring) Geom() Geometry {
 	return r
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (r Ring) ForceCCW() Geometry {
+	return Ring(orientRing(matrix.LineMatrix(r), true))
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (r Ring) ForceCW() Geometry {
+	return Ring(orientRing(matrix.LineMatrix(r), false))
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (r Ring) ForceRHR() Geometry {
+	return r.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (r Ring) Reverse() Geometry {
+	return Ring(reverseLine(matrix.LineMatrix(r)))
+}
+
+// Normalize returns the geometry in canonical form.
+func (r Ring) Normalize() Geometry {
+	return Ring(canonicalRing(matrix.LineMatrix(r), true))
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 
 	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Circle describes a circle Valid
@@ -22,7 +23,15 @@ func CreateCircle(centre Point, radius float64) (*Circle, error) {
 // CreateCircleWithSegments Returns valid circle.
 func CreateCircleWithSegments(centre Point, radius float64, segments int) (*Circle, error) {
 	circle := &Circle{Centre: centre, Radius: radius, Segments: segments}
-	circle.Polygon = centre.Buffer(radius, segments).(Polygon)
+	geom, err := centre.Buffer(radius, segments)
+	if err != nil {
+		return nil, err
+	}
+	poly, ok := geom.(Polygon)
+	if !ok || poly.IsEmpty() {
+		return nil, spaceerr.ErrNotValidGeometry
+	}
+	circle.Polygon = poly
 	return circle, nil
 }
 
@@ -43,20 +52,16 @@ func (c *Circle) EqualsCircle(circle *Circle) bool {
 
 // Equals checks if the Circle represents the same Geometry or vector.
 func (c *Circle) Equals(g Geometry) bool {
-	if g.GeoJSONType() != c.GeoJSONType() {
-		return false
-	}
-	return c.EqualsCircle(g.(*Circle))
+	circle, ok := g.(*Circle)
+	return ok && circle != nil && c.EqualsCircle(circle)
 }
 
 // EqualsExact Returns true if the two Geometries are exactly equal,
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (c *Circle) EqualsExact(g Geometry, tolerance float64) bool {
-	if c.GeoJSONType() != g.GeoJSONType() {
-		return false
-	}
-	return c.Centre.EqualsExact((g.(*Circle)).Centre, tolerance)
+	circle, ok := g.(*Circle)
+	return ok && circle != nil && c.Centre.EqualsExact(circle.Centre, tolerance)
 }
 
 // Area returns the area of a circle geometry.
@@ -82,7 +87,7 @@ func (c *Circle) Centroid() Point {
 
 // Buffer sReturns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
-func (c *Circle) Buffer(width float64, quadsegs int) Geometry {
+func (c *Circle) Buffer(width float64, quadsegs int) (Geometry, error) {
 	return c.Centre.Buffer(width+c.Radius, quadsegs)
 }
 
@@ -113,3 +118,33 @@ func (c *Circle) IsValid() bool {
 func (c *Circle) Geom() Geometry {
 	return c
 }
+
+// ForceCCW returns the geometry with the exterior rings oriented counter-clockwise and the interior rings clockwise.
+func (c *Circle) ForceCCW() Geometry {
+	return c.withPolygon(c.Polygon.ForceCCW().(Polygon))
+}
+
+// ForceCW returns the geometry with the exterior rings oriented clockwise and the interior rings counter-clockwise.
+func (c *Circle) ForceCW() Geometry {
+	return c.withPolygon(c.Polygon.ForceCW().(Polygon))
+}
+
+// ForceRHR returns the geometry oriented by the right-hand rule, as ForceCW.
+func (c *Circle) ForceRHR() Geometry {
+	return c.ForceCW()
+}
+
+// Reverse returns the geometry with the vertex order of its components reversed.
+func (c *Circle) Reverse() Geometry {
+	return c.withPolygon(c.Polygon.Reverse().(Polygon))
+}
+
+// Normalize returns the geometry in canonical form.
+func (c *Circle) Normalize() Geometry {
+	return c.withPolygon(c.Polygon.Normalize().(Polygon))
+}
+
+// withPolygon returns a copy of the circle with the polygon.
+func (c *Circle) withPolygon(p Polygon) *Circle {
+	return &Circle{Polygon: p, Centre: c.Centre, Radius: c.Radius, Segments: c.Segments}
+}
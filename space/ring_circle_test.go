@@ -18,6 +18,8 @@ func TestCreateCircle(t *testing.T) {
 		wantErr bool
 	}{
 		{"create circle", args{Point{10, 10}, 5.5}, &Circle{Centre: Point{10, 10}, Radius: 5.5, Segments: calc.QuadrantSegments}, false},
+		{"zero radius", args{Point{10, 10}, 0}, nil, true},
+		{"empty centre", args{Point{}, 5.5}, nil, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -26,9 +28,37 @@ func TestCreateCircle(t *testing.T) {
 				t.Errorf("CreateCircle() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if err != nil {
+				return
+			}
 			if !got.Equals(tt.want) {
 				t.Errorf("CreateCircle() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestCircle_Equals(t *testing.T) {
+	circle, _ := CreateCircle(Point{10, 10}, 5.5)
+	var nilCircle *Circle
+	tests := []struct {
+		name string
+		g    Geometry
+		want bool
+	}{
+		{"circle", &Circle{Centre: Point{10, 10}, Radius: 5.5, Segments: calc.QuadrantSegments}, true},
+		{"nil circle", nilCircle, false},
+		{"nil geometry", nil, false},
+		{"point", Point{10, 10}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := circle.Equals(tt.g); got != tt.want {
+				t.Errorf("Circle.Equals() = %v, want %v", got, tt.want)
+			}
+			if got := circle.EqualsExact(tt.g, 0); got != tt.want {
+				t.Errorf("Circle.EqualsExact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,6 +8,12 @@ import (
 // ErrNilGeometry ...
 var ErrNilGeometry = fmt.Errorf("Geometry is nil")
 
+// ErrEmptyGeometry ...
+var ErrEmptyGeometry = fmt.Errorf("Geometry is empty")
+
+// ErrUnsupportedType ...
+var ErrUnsupportedType = fmt.Errorf("Geometry type is not supported")
+
 // ErrNotValidGeometry ...
 var ErrNotValidGeometry = fmt.Errorf("Geometry is not valid")
 
@@ -23,6 +29,12 @@ var ErrNotSupportBound = fmt.Errorf("Operation does not support Bound arguments"
 // ErrNotSupportGeometry ...
 var ErrNotSupportGeometry = fmt.Errorf("Operation does not support arguments")
 
+// ErrInvalidBoundaryNodeRule ...
+var ErrInvalidBoundaryNodeRule = fmt.Errorf("Boundary node rule is not valid")
+
+// ErrNegativeDistance ...
+var ErrNegativeDistance = fmt.Errorf("Distance must be non-negative")
+
 // ErrWrongUsageFunc ...
 var ErrWrongUsageFunc = fmt.Errorf("Wrong usage function")
 
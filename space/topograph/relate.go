@@ -2,7 +2,9 @@ package topograph
 
 import (
 	"github.com/spatial-go/geoos/algorithm/graph/de9im"
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Topograph Computes the Intersection Matrix for the spatial relationship
@@ -13,8 +15,27 @@ type Topograph struct {
 // Relate Computes the  Intersection Matrix for the spatial relationship
 // between two geometries, using the default (OGC SFS) Boundary Node Rule
 func (t *Topograph) Relate(A, B space.Geometry) (string, error) {
-	return de9im.Relate(A.ToMatrix(), B.ToMatrix()), nil
+	return t.RelateWithRule(A, B, relate.Mod2)
+}
 
+// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+// between two geometries matches the pattern, such as "T*F**F***".
+// The matrix is the one of Relate, using the default (OGC SFS) Boundary Node Rule.
+func (t *Topograph) RelatePattern(A, B space.Geometry, pattern string) (bool, error) {
+	im, err := de9im.IMWithRule(A.ToMatrix(), B.ToMatrix(), relate.Mod2)
+	if err != nil {
+		return false, err
+	}
+	return im.Matches(pattern)
+}
+
+// RelateWithRule Computes the  Intersection Matrix for the spatial relationship
+// between two geometries, using the Boundary Node Rule.
+func (t *Topograph) RelateWithRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	if !rule.IsValid() {
+		return "", spaceerr.ErrInvalidBoundaryNodeRule
+	}
+	return de9im.RelateWithRule(A.ToMatrix(), B.ToMatrix(), rule)
 }
 
 // Within returns TRUE if geometry A is completely inside geometry B.
@@ -139,7 +160,7 @@ func AInB(A, B space.Geometry) (isIntersect, isAInB, isSure bool) {
 		return
 	}
 	//optimization for rectangle arguments
-	if B.GeoJSONType() == space.TypePolygon && B.(space.Polygon).IsRectangle() {
+	if poly, ok := B.(space.Polygon); ok && poly.IsRectangle() {
 		isIntersect, isAInB, isSure = false, B.Bound().ContainsBound(A.Bound()), true
 		return
 	}
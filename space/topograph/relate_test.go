@@ -3,6 +3,7 @@ package topograph
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -248,3 +249,35 @@ func TestTopological_Overlaps(t *testing.T) {
 		})
 	}
 }
+
+func TestTopograph_Relate(t *testing.T) {
+	square := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"line crossing polygon", args{space.LineString{{-5, 5}, {15, 5}}, square}, "101FF0212"},
+		{"closed line", args{space.LineString{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, space.Point{0, 0}}, "0F1FFFFF2"},
+		{"multi line endpoint", args{space.MultiLineString{{{0, 0}, {10, 0}}, {{10, 0}, {10, 10}}}, space.Point{0, 0}}, "FF10F0FF2"},
+		{"multi line node", args{space.MultiLineString{{{0, 0}, {10, 0}}, {{10, 0}, {10, 10}}}, space.Point{10, 0}}, "0F1FF0FF2"},
+		{"polygons", args{square, space.Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}, "212101212"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.Relate(tt.args.A, tt.args.B)
+			if err != nil {
+				t.Fatalf("Topograph.Relate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Topograph.Relate() = %v, want %v", got, tt.want)
+			}
+			if withRule, _ := tr.RelateWithRule(tt.args.A, tt.args.B, relate.Mod2); withRule != got {
+				t.Errorf("Topograph.RelateWithRule() = %v, want %v", withRule, got)
+			}
+			if matches, err := tr.RelatePattern(tt.args.A, tt.args.B, tt.want); err != nil || !matches {
+				t.Errorf("Topograph.RelatePattern() = %v, %v, want true", matches, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package topograph
 import (
 	"sync"
 
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -37,6 +38,14 @@ type Relationship interface {
 	// between two geometries, using the default (OGC SFS) Boundary Node Rule
 	Relate(A, B space.Geometry) (string, error)
 
+	// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+	// between two geometries matches the pattern, such as "T*F**F***".
+	RelatePattern(A, B space.Geometry, pattern string) (bool, error)
+
+	// RelateWithRule Computes the  Intersection Matrix for the spatial relationship
+	// between two geometries, using the Boundary Node Rule, relate.EndPoint for networks.
+	RelateWithRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error)
+
 	// Within returns TRUE if geometry A is completely inside geometry B.
 	// For this function to make sense, the source geometries must both be of the same coordinate projection,
 	// having the same SRID.
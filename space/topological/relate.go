@@ -4,6 +4,7 @@ package topological
 import (
 	"sync"
 
+	"github.com/spatial-go/geoos/algorithm/graph/de9im"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
@@ -39,6 +40,25 @@ func (t *Topological) Relate(A, B space.Geometry) (string, error) {
 	return im.ToString(), nil
 }
 
+// RelatePattern returns TRUE if the Intersection Matrix for the spatial relationship
+// between two geometries matches the pattern, such as "T*F**F***".
+func (t *Topological) RelatePattern(A, B space.Geometry, pattern string) (bool, error) {
+	if A.IsCollection() || B.IsCollection() {
+		return false, spaceerr.ErrNotSupportCollection
+	}
+	im := relate.IM(A.ToMatrix(), B.ToMatrix(), A.Bound().IntersectsBound(B.Bound()))
+	return im.Matches(pattern)
+}
+
+// RelateWithRule Computes the  Intersection Matrix for the spatial relationship
+// between two geometries, using the Boundary Node Rule, by the noded linework of the geometries.
+func (t *Topological) RelateWithRule(A, B space.Geometry, rule relate.BoundaryNodeRule) (string, error) {
+	if !rule.IsValid() {
+		return "", spaceerr.ErrInvalidBoundaryNodeRule
+	}
+	return de9im.RelateWithRule(A.ToMatrix(), B.ToMatrix(), rule)
+}
+
 // Within returns TRUE if geometry A is completely inside geometry B.
 // For this func (t *Topological)tion to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
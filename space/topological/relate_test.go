@@ -3,6 +3,7 @@ package topological
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/space"
 	"github.com/spatial-go/geoos/space/topograph"
 )
@@ -246,3 +247,58 @@ func TestTopological_Overlaps(t *testing.T) {
 		})
 	}
 }
+
+func TestTopological_RelatePattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    args
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		{"within", args{space.Point{2, 2}, space.LineString{{1, 1}, {3, 3}}}, "T*F**F***", true, false},
+		{"disjoint", args{space.Point{2, 2}, space.LineString{{1, 1}, {3, 3}}}, "FF*FF****", false, false},
+		{"collection", args{space.Collection{space.Point{2, 2}}, space.LineString{{1, 1}, {3, 3}}}, "T*F**F***", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.RelatePattern(tt.args.A, tt.args.B, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Topological.RelatePattern() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Topological.RelatePattern() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopological_RelateWithRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    args
+		rule    relate.BoundaryNodeRule
+		want    string
+		wantErr bool
+	}{
+		{"mod2", args{space.Point{1, 0}, space.MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}}}, relate.Mod2, "0FFFFF102", false},
+		{"endpoint", args{space.Point{1, 0}, space.MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}}}, relate.EndPoint, "F0FFFF102", false},
+		{"multivalent", args{space.Point{0, 0}, space.MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}}}, relate.MultivalentEndPoint, "0FFFFF102", false},
+		{"rule", args{space.Point{1, 0}, space.LineString{{0, 0}, {1, 0}}}, relate.BoundaryNodeRule(-1), "", true},
+		{"overlay error", args{space.Collection{space.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}}}, space.LineString{{5, 5}, {6, 6}}},
+			space.Polygon{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}}}, relate.EndPoint, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tr.RelateWithRule(tt.args.A, tt.args.B, tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Topological.RelateWithRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Topological.RelateWithRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
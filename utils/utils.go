@@ -81,7 +81,7 @@ func IsUTF8(data []byte) bool {
 		} else if num := preNUm(data[i]); num > 2 {
 			i++
 			for j := 0; j < num-1; j++ {
-				if (data[i] & 0xc0) != 0x80 {
+				if i >= len(data) || (data[i]&0xc0) != 0x80 {
 					return false
 				}
 				i++